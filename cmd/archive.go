@@ -0,0 +1,201 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"strconv"
+	"time"
+
+	"github.com/0xjuanma/golazo/internal/api"
+	"github.com/0xjuanma/golazo/internal/archive"
+	"github.com/0xjuanma/golazo/internal/data"
+	"github.com/0xjuanma/golazo/internal/fotmob"
+	"github.com/0xjuanma/golazo/internal/reddit"
+	"github.com/spf13/cobra"
+)
+
+// archiveMatchTimeout bounds archiving one match, goal link lookups included.
+const archiveMatchTimeout = 2 * time.Minute
+
+var (
+	archiveOutFlag     string
+	archiveZipFlag     bool
+	archiveNoLinksFlag bool
+	archiveForceFlag   bool
+)
+
+var archiveCmd = &cobra.Command{
+	Use:         "archive <match-id>...",
+	Short:       "Store everything about matches for offline reference",
+	Annotations: map[string]string{credentialsAnnotation: credentialsPrompt},
+	Long: `Fetch everything golazo knows about matches and store each in a self-contained directory:
+a Markdown summary, the parsed details with their events, statistics, line-ups and shot map
+as JSON, the commentary, the resolved goal links and the raw FotMob response, listed with
+their SHA-256 checksums in manifest.json.
+
+Archives go to ~/.golazo/archives/match-<id> unless --out gives another directory; with --zip
+they are written as match-<id>.zip instead. Goal links are looked up on Reddit unless
+--no-links or privacy mode is on. Find match IDs in their FotMob URLs.
+
+Runs are resumable: matches whose archive is complete and passes its checksums are skipped
+(unless --force), and progress.json in the output directory records archived and failed
+matches, so an interrupted run picks up where it stopped.`,
+	Args: cobra.MinimumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		matchIDs := make([]int, len(args))
+		for i, arg := range args {
+			id, err := strconv.Atoi(arg)
+			if err != nil || id <= 0 {
+				return fmt.Errorf("invalid match ID %q", arg)
+			}
+			matchIDs[i] = id
+		}
+
+		dir := archiveOutFlag
+		if dir == "" {
+			configDir, err := data.ConfigDir()
+			if err != nil {
+				return err
+			}
+			dir = filepath.Join(configDir, "archives")
+		}
+		if err := os.MkdirAll(dir, 0755); err != nil {
+			return fmt.Errorf("create %s: %w", dir, err)
+		}
+		progressPath := filepath.Join(dir, archive.ProgressFileName)
+		progress, err := archive.LoadProgress(progressPath)
+		if err != nil {
+			return err
+		}
+
+		ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+		defer stop()
+		client := fotmob.NewClient()
+		var archived, skipped, failed int
+		for i, matchID := range matchIDs {
+			if ctx.Err() != nil {
+				break
+			}
+			path := filepath.Join(dir, fmt.Sprintf("match-%d", matchID))
+			if archiveZipFlag {
+				path += ".zip"
+			}
+			prefix := fmt.Sprintf("[%d/%d] %d", i+1, len(matchIDs), matchID)
+			if _, err := archive.Verify(path); err == nil && !archiveForceFlag {
+				progress.MarkDone(matchID, path)
+				skipped++
+				fmt.Fprintf(os.Stderr, "%s: already archived, skipped\n", prefix)
+				continue
+			}
+
+			notes, err := archiveMatch(ctx, client, matchID, path)
+			switch {
+			case errors.Is(err, context.Canceled):
+				// Interrupted: the match is retried on the next run
+			case err != nil:
+				progress.MarkFailed(matchID, err)
+				failed++
+				fmt.Fprintf(os.Stderr, "%s: %v\n", prefix, err)
+			default:
+				progress.MarkDone(matchID, path)
+				archived++
+				for _, note := range notes {
+					fmt.Fprintf(os.Stderr, "%s: archived without %s\n", prefix, note)
+				}
+				fmt.Println(path)
+			}
+			// Save after every match, so an interruption loses at most the current one
+			if err := progress.Save(progressPath); err != nil {
+				return fmt.Errorf("save progress: %w", err)
+			}
+		}
+
+		if ctx.Err() != nil {
+			return fmt.Errorf("interrupted after %d of %d matches; run again to resume", archived+skipped+failed, len(matchIDs))
+		}
+		if len(matchIDs) > 1 {
+			fmt.Fprintf(os.Stderr, "%d archived, %d skipped, %d failed\n", archived, skipped, failed)
+		}
+		if failed > 0 {
+			return fmt.Errorf("%d of %d matches failed; run again to retry them", failed, len(matchIDs))
+		}
+		return nil
+	},
+}
+
+// archiveMatch fetches everything about a match and writes its archive to path: a
+// directory, or a zip when path ends in ".zip". Returns notes on what is missing.
+func archiveMatch(ctx context.Context, client *fotmob.Client, matchID int, path string) ([]string, error) {
+	ctx, cancel := context.WithTimeout(ctx, archiveMatchTimeout)
+	defer cancel()
+
+	details, err := client.MatchDetails(ctx, matchID)
+	if err != nil {
+		return nil, err
+	}
+	opts := archive.Options{Version: Version, Details: details, ArchivedAt: time.Now()}
+	if opts.RawResponse, err = client.RawMatchDetails(ctx, matchID); err != nil {
+		opts.Notes = append(opts.Notes, "raw response: "+err.Error())
+	}
+	if opts.Commentary, err = client.Commentary(ctx, matchID); err != nil {
+		opts.Notes = append(opts.Notes, "commentary: "+err.Error())
+	}
+	switch {
+	case archiveNoLinksFlag:
+	case data.PrivacyMode():
+		opts.Notes = append(opts.Notes, "goal links: skipped in privacy mode")
+	default:
+		links, err := archiveGoalLinks(ctx, details)
+		if err != nil {
+			opts.Notes = append(opts.Notes, "goal links: "+err.Error())
+		}
+		opts.GoalLinks = links
+	}
+	if ctx.Err() != nil {
+		return nil, ctx.Err()
+	}
+
+	files, err := archive.Build(opts)
+	if err != nil {
+		return nil, err
+	}
+	if filepath.Ext(path) != ".zip" {
+		return opts.Notes, archive.WriteDir(path, files)
+	}
+	var zipped bytes.Buffer
+	if err := archive.WriteZip(&zipped, fmt.Sprintf("match-%d", matchID), files); err != nil {
+		return nil, err
+	}
+	if err := data.WriteFileAtomic(path, zipped.Bytes(), 0644); err != nil {
+		return nil, fmt.Errorf("write %s: %w", path, err)
+	}
+	return opts.Notes, nil
+}
+
+// archiveGoalLinks resolves the clips of a match's goals on Reddit.
+func archiveGoalLinks(ctx context.Context, details *api.MatchDetails) ([]*reddit.GoalLink, error) {
+	client, err := reddit.NewClient()
+	if err != nil {
+		return nil, err
+	}
+	var links []*reddit.GoalLink
+	for _, link := range client.GoalLinks(ctx, reddit.GoalInfos(details)) {
+		if link != nil && !reddit.IsNotFound(link) {
+			links = append(links, link)
+		}
+	}
+	return links, nil
+}
+
+func init() {
+	archiveCmd.Flags().StringVarP(&archiveOutFlag, "out", "o", "", "Directory to write the archives to (default ~/.golazo/archives)")
+	archiveCmd.Flags().BoolVar(&archiveZipFlag, "zip", false, "Write zip files instead of directories")
+	archiveCmd.Flags().BoolVar(&archiveNoLinksFlag, "no-links", false, "Don't look up goal links on Reddit")
+	archiveCmd.Flags().BoolVar(&archiveForceFlag, "force", false, "Archive matches again even when a verified archive exists")
+	rootCmd.AddCommand(archiveCmd)
+}
@@ -0,0 +1,88 @@
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/0xjuanma/golazo/internal/reddit"
+	"github.com/spf13/cobra"
+)
+
+var cacheOutFlag string
+
+var cacheCmd = &cobra.Command{
+	Use:   "cache",
+	Short: "Back up or share resolved goal replay links",
+}
+
+var cacheExportCmd = &cobra.Command{
+	Use:   "export",
+	Short: "Export cached goal links as JSON lines",
+	Long: `Export the cached goal replay links as JSON lines (one link per line), e.g. to back them
+up or copy them to another machine with "golazo cache import". Writes to stdout unless --out is set.
+"Not found" markers and expired links are not exported.`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cache, err := reddit.NewGoalLinkCache()
+		if err != nil {
+			return err
+		}
+
+		var w io.Writer = os.Stdout
+		if cacheOutFlag != "" && cacheOutFlag != "-" {
+			f, err := os.Create(cacheOutFlag)
+			if err != nil {
+				return fmt.Errorf("create %s: %w", cacheOutFlag, err)
+			}
+			defer func() { _ = f.Close() }()
+			w = f
+		}
+
+		n, err := cache.Export(w)
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(os.Stderr, "Exported %d goal link(s)\n", n)
+		return nil
+	},
+}
+
+var cacheImportCmd = &cobra.Command{
+	Use:   "import [file]",
+	Short: "Import goal links exported with \"golazo cache export\"",
+	Long: `Merge goal links from a JSON lines file written by "golazo cache export" into the local
+cache. Reads stdin when no file (or "-") is given. A cached link is only replaced by a more
+recently fetched one, and links older than the cache's maximum age are skipped.`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cache, err := reddit.NewGoalLinkCache()
+		if err != nil {
+			return err
+		}
+
+		var r io.Reader = os.Stdin
+		if len(args) == 1 && args[0] != "-" {
+			f, err := os.Open(args[0])
+			if err != nil {
+				return fmt.Errorf("open %s: %w", args[0], err)
+			}
+			defer func() { _ = f.Close() }()
+			r = f
+		}
+
+		n, err := cache.Import(r)
+		if err != nil {
+			return err
+		}
+		fmt.Printf("Imported %d goal link(s)\n", n)
+		return nil
+	},
+}
+
+func init() {
+	cacheExportCmd.Flags().StringVarP(&cacheOutFlag, "out", "o", "", "File to write to (default stdout)")
+	cacheCmd.AddCommand(cacheExportCmd)
+	cacheCmd.AddCommand(cacheImportCmd)
+	rootCmd.AddCommand(cacheCmd)
+}
@@ -0,0 +1,131 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"slices"
+	"time"
+
+	"github.com/0xjuanma/golazo/internal/api"
+	"github.com/0xjuanma/golazo/internal/data"
+	"github.com/0xjuanma/golazo/internal/fotmob"
+	"github.com/spf13/cobra"
+)
+
+var catalogueLeaguesFlag []int
+
+var catalogueCmd = &cobra.Command{
+	Use:   "catalogue",
+	Short: "Manage the league and team metadata catalogue",
+}
+
+var catalogueUpdateCmd = &cobra.Command{
+	Use:         "update",
+	Short:       "Refresh the league and team catalogue from FotMob",
+	Annotations: map[string]string{credentialsAnnotation: credentialsPrompt},
+	Long: `Refresh the league and team catalogue shipped with golazo (aliases, colors, IDs) from
+FotMob: renamed competitions, and teams new to a league after promotion or relegation. Updates
+are saved to catalogue.json in the config directory and merged over the shipped catalogue.
+
+By default the leagues of catalogued teams and your selected leagues are refreshed; use
+--leagues to choose others.`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		current := data.CurrentCatalogue()
+		overlay, err := data.LoadCatalogueOverlay()
+		if err != nil {
+			return err
+		}
+
+		leagueIDs := catalogueLeaguesFlag
+		if len(leagueIDs) == 0 {
+			leagueIDs = defaultCatalogueLeagues(current)
+		}
+
+		client := fotmob.NewClient()
+		update := &data.Catalogue{Version: current.Version}
+		var renamed, added, moved, failed int
+		for _, leagueID := range leagueIDs {
+			league, ok := current.League(leagueID)
+			if !ok {
+				fmt.Fprintf(os.Stderr, "Skipping league %d: not supported\n", leagueID)
+				failed++
+				continue
+			}
+
+			ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
+			details, err := client.LeagueDetails(ctx, leagueID)
+			var table []api.LeagueTableEntry
+			if err == nil && league.Domestic() {
+				// Teams only belong to their national league, not to the cups they play in
+				table, err = client.Standings(ctx, leagueID)
+			}
+			cancel()
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "%s: %v\n", league.Name, err)
+				failed++
+				continue
+			}
+
+			if details.Name != league.Name {
+				update.Leagues = append(update.Leagues, data.CatalogueLeague{ID: leagueID, Name: details.Name})
+				renamed++
+			}
+			for _, entry := range table {
+				team := data.CatalogueTeam{
+					ID:        entry.Team.ID,
+					Name:      entry.Team.Name,
+					ShortName: entry.Team.ShortName,
+					Country:   league.Country,
+					LeagueID:  leagueID,
+				}
+				existing, ok := current.Team(api.Team{ID: team.ID})
+				switch {
+				case !ok:
+					added++
+				case existing.LeagueID != leagueID:
+					moved++
+				case existing.Name == team.Name && (team.ShortName == "" || existing.ShortName == team.ShortName):
+					continue // Unchanged
+				}
+				update.Teams = append(update.Teams, team)
+			}
+		}
+
+		if failed == len(leagueIDs) {
+			return fmt.Errorf("no league could be refreshed")
+		}
+		if len(update.Leagues) > 0 || len(update.Teams) > 0 {
+			overlay.Merge(update)
+			if err := data.SaveCatalogueOverlay(overlay); err != nil {
+				return err
+			}
+		}
+		fmt.Printf("Catalogue updated: %d renamed league(s), %d new team(s), %d team(s) changed league\n", renamed, added, moved)
+		return nil
+	},
+}
+
+// defaultCatalogueLeagues returns the leagues refreshed by "golazo catalogue update" without
+// --leagues: those of catalogued teams, then the selected leagues.
+func defaultCatalogueLeagues(catalogue *data.Catalogue) []int {
+	var ids []int
+	for _, team := range catalogue.Teams {
+		if !slices.Contains(ids, team.LeagueID) {
+			ids = append(ids, team.LeagueID)
+		}
+	}
+	for _, id := range data.ActiveLeagueIDs() {
+		if !slices.Contains(ids, id) {
+			ids = append(ids, id)
+		}
+	}
+	return ids
+}
+
+func init() {
+	catalogueUpdateCmd.Flags().IntSliceVar(&catalogueLeaguesFlag, "leagues", nil, "FotMob league IDs to refresh (default: catalogued and selected leagues)")
+	catalogueCmd.AddCommand(catalogueUpdateCmd)
+	rootCmd.AddCommand(catalogueCmd)
+}
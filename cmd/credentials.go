@@ -0,0 +1,221 @@
+package cmd
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/0xjuanma/golazo/internal/data"
+	"github.com/0xjuanma/golazo/internal/fotmob"
+	"github.com/spf13/cobra"
+	"golang.org/x/term"
+)
+
+// stdinReader reads piped secrets; shared so one prompt's buffering doesn't swallow the next line.
+var stdinReader = bufio.NewReader(os.Stdin)
+
+// knownSecrets are the secrets golazo reads; the OS keyring can't list its entries, so
+// these are the names "credentials list" looks up there.
+var knownSecrets = []string{"proxy", fotmob.SigningKeyCredential}
+
+// credentialsFileOnly keeps "credentials set" from using the OS keyring.
+var credentialsFileOnly bool
+
+var credentialsCmd = &cobra.Command{
+	Use:   "credentials",
+	Short: "Manage secrets stored in the OS keyring or encrypted with a passphrase",
+	Long: `Store secrets in the OS keyring (macOS Keychain, Windows Credential Manager, Secret
+Service on Linux) instead of plaintext settings or environment variables. Where no keyring is
+available, secrets go to an encrypted file in the config directory (credentials.enc, AES-256-GCM
+with a key derived from your passphrase); golazo asks for its passphrase once per run when the
+file exists. Set ` + data.PassphraseEnv + ` to skip the prompt. Commands running unattended
+("golazo tmux", "quick", "mini" and "rpc") only unlock the file with ` + data.PassphraseEnv + ` set.
+
+Secrets are read from the keyring, then the encrypted file, then environment variables.
+
+Secrets in use:
+  proxy        Proxy URL with credentials, used when settings.yaml has no proxy
+               (falls back to HTTPS_PROXY / ALL_PROXY)
+  fotmob_key   Key for signing FotMob requests locally (x-mas header; falls back to
+               ` + fotmob.SigningKeyEnv + `)`,
+	// The store is opened by each subcommand, not unlocked for the session
+	PersistentPreRun: func(cmd *cobra.Command, args []string) {},
+}
+
+var credentialsSetCmd = &cobra.Command{
+	Use:   "set <name>",
+	Short: "Store a secret (the value is read without echo, or from stdin)",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		value, err := readSecret(fmt.Sprintf("Value for %s: ", args[0]))
+		if err != nil {
+			return err
+		}
+		if value == "" {
+			return errors.New("empty value, nothing stored")
+		}
+		if !credentialsFileOnly {
+			err := data.SetKeyringCredential(args[0], value)
+			if err == nil {
+				fmt.Printf("Stored %s in the OS keyring\n", args[0])
+				return nil
+			}
+			fmt.Fprintf(os.Stderr, "OS keyring unavailable (%v), using the encrypted file\n", err)
+		}
+
+		creds, err := openCredentialsStore(!data.CredentialsExist())
+		if err != nil {
+			return err
+		}
+		creds.Set(args[0], value)
+		if err := creds.Save(); err != nil {
+			return err
+		}
+		fmt.Printf("Stored %s in the encrypted file\n", args[0])
+		return nil
+	},
+}
+
+var credentialsListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List the names of the stored secrets",
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		found := false
+		for _, name := range knownSecrets {
+			if _, ok := data.KeyringCredential(name); ok {
+				fmt.Printf("%s (keyring)\n", name)
+				found = true
+			}
+		}
+		if data.CredentialsExist() {
+			creds, err := openCredentialsStore(false)
+			if err != nil {
+				return err
+			}
+			for _, name := range creds.Names() {
+				fmt.Printf("%s (encrypted file)\n", name)
+				found = true
+			}
+		}
+		if !found {
+			fmt.Println("No credentials stored")
+		}
+		return nil
+	},
+}
+
+var credentialsRemoveCmd = &cobra.Command{
+	Use:   "remove <name>",
+	Short: "Remove a stored secret from the keyring and the encrypted file",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		removed, err := data.DeleteKeyringCredential(args[0])
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "OS keyring unavailable: %v\n", err)
+		}
+		if data.CredentialsExist() {
+			creds, err := openCredentialsStore(false)
+			if err != nil {
+				return err
+			}
+			if creds.Delete(args[0]) {
+				if err := creds.Save(); err != nil {
+					return err
+				}
+				removed = true
+			}
+		}
+		if !removed {
+			return fmt.Errorf("no secret named %s", args[0])
+		}
+		fmt.Printf("Removed %s\n", args[0])
+		return nil
+	},
+}
+
+// openCredentialsStore opens the store with a passphrase from the environment or a
+// prompt. A new store's passphrase is asked for twice.
+func openCredentialsStore(create bool) (*data.Credentials, error) {
+	passphrase, ok := os.LookupEnv(data.PassphraseEnv)
+	if !ok {
+		var err error
+		if passphrase, err = readSecret("Passphrase: "); err != nil {
+			return nil, err
+		}
+		if create {
+			confirm, err := readSecret("Confirm passphrase: ")
+			if err != nil {
+				return nil, err
+			}
+			if confirm != passphrase {
+				return nil, errors.New("passphrases do not match")
+			}
+		}
+	}
+	if passphrase == "" {
+		return nil, errors.New("empty passphrase")
+	}
+	return data.OpenCredentials(passphrase)
+}
+
+// readSecret reads a line without echo from the terminal, or from stdin when piped.
+func readSecret(prompt string) (string, error) {
+	fd := int(os.Stdin.Fd())
+	if !term.IsTerminal(fd) {
+		line, err := stdinReader.ReadString('\n')
+		if err != nil && line == "" {
+			return "", fmt.Errorf("read %s: %w", strings.TrimSuffix(prompt, ": "), err)
+		}
+		return strings.TrimRight(line, "\r\n"), nil
+	}
+	fmt.Fprint(os.Stderr, prompt)
+	secret, err := term.ReadPassword(fd)
+	fmt.Fprintln(os.Stderr)
+	if err != nil {
+		return "", err
+	}
+	return string(secret), nil
+}
+
+// Commands using stored secrets (the proxy, FotMob signing) say how they unlock the
+// store with this annotation; commands without it never touch the store.
+const (
+	credentialsAnnotation = "credentials"
+	credentialsPrompt     = "prompt" // Asks for the passphrase on a terminal
+	credentialsEnv        = "env"    // Runs unattended: unlocks only with PassphraseEnv set
+)
+
+// unlockCredentials unlocks the credentials store for this run as mode says, asking for
+// the passphrase on a terminal in credentialsPrompt mode. Nothing happens when no store
+// exists, or in credentialsEnv mode without the passphrase in the environment. A failure
+// leaves the secrets unavailable but doesn't stop golazo.
+func unlockCredentials(mode string) {
+	if mode == "" || !data.CredentialsExist() {
+		return
+	}
+	var prompt func() (string, error)
+	switch mode {
+	case credentialsEnv:
+		if _, ok := os.LookupEnv(data.PassphraseEnv); !ok {
+			return
+		}
+	case credentialsPrompt:
+		if term.IsTerminal(int(os.Stdin.Fd())) {
+			prompt = func() (string, error) { return readSecret("golazo credentials passphrase: ") }
+		}
+	}
+	if err := data.UnlockSession(prompt); err != nil {
+		fmt.Fprintf(os.Stderr, "Stored credentials unavailable: %v\n", err)
+	}
+}
+
+func init() {
+	credentialsSetCmd.Flags().BoolVar(&credentialsFileOnly, "file", false, "Store in the encrypted file even when an OS keyring is available")
+	credentialsCmd.AddCommand(credentialsSetCmd)
+	credentialsCmd.AddCommand(credentialsListCmd)
+	credentialsCmd.AddCommand(credentialsRemoveCmd)
+	rootCmd.AddCommand(credentialsCmd)
+}
@@ -0,0 +1,88 @@
+package cmd
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/0xjuanma/golazo/internal/eventlog"
+	"github.com/spf13/cobra"
+)
+
+var (
+	exportFormatFlag string
+	exportLeagueFlag string
+	exportFromFlag   string
+	exportToFlag     string
+	exportOutFlag    string
+)
+
+var exportCmd = &cobra.Command{
+	Use:   "export",
+	Short: "Export locally archived data for analysis in external tools",
+}
+
+var exportHistoryCmd = &cobra.Command{
+	Use:   "history",
+	Short: "Export the match event archive as CSV or Parquet (matches, events, stats)",
+	Long: `Export the local match history recorded by the event log (event_log: true in settings.yaml)
+as CSV or Parquet tables: matches (latest score/status per match), events (one row per event) and
+stats (goals, own goals, cards and substitutions per team and match).
+
+Filters:
+  --league   case-insensitive substring of the league name
+  --from     first day to include (YYYY-MM-DD, by time the event was recorded)
+  --to       last day to include (YYYY-MM-DD)`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if exportFormatFlag != eventlog.FormatCSV && exportFormatFlag != eventlog.FormatParquet {
+			return fmt.Errorf("unknown format %q (expected csv or parquet)", exportFormatFlag)
+		}
+
+		filter := eventlog.Filter{League: exportLeagueFlag}
+		if exportFromFlag != "" {
+			from, err := time.ParseInLocation("2006-01-02", exportFromFlag, time.Local)
+			if err != nil {
+				return fmt.Errorf("parse --from (want YYYY-MM-DD): %w", err)
+			}
+			filter.From = from
+		}
+		if exportToFlag != "" {
+			to, err := time.ParseInLocation("2006-01-02", exportToFlag, time.Local)
+			if err != nil {
+				return fmt.Errorf("parse --to (want YYYY-MM-DD): %w", err)
+			}
+			filter.To = to.AddDate(0, 0, 1) // Include the whole day
+		}
+
+		sink, err := eventlog.NewSink()
+		if err != nil {
+			return err
+		}
+		entries, err := sink.ReadEntries(filter)
+		if err != nil {
+			return err
+		}
+		if len(entries) == 0 {
+			return fmt.Errorf("no archived events found in %s (enable event_log in settings.yaml)", sink.Path())
+		}
+
+		paths, err := eventlog.ExportTables(entries, exportOutFlag, exportFormatFlag)
+		if err != nil {
+			return err
+		}
+		for _, path := range paths {
+			fmt.Println(path)
+		}
+		return nil
+	},
+}
+
+func init() {
+	exportHistoryCmd.Flags().StringVar(&exportFormatFlag, "format", "csv", "Output format (csv or parquet)")
+	exportHistoryCmd.Flags().StringVar(&exportLeagueFlag, "league", "", "Only include leagues whose name contains this text")
+	exportHistoryCmd.Flags().StringVar(&exportFromFlag, "from", "", "First day to include (YYYY-MM-DD)")
+	exportHistoryCmd.Flags().StringVar(&exportToFlag, "to", "", "Last day to include (YYYY-MM-DD)")
+	exportHistoryCmd.Flags().StringVarP(&exportOutFlag, "out", "o", ".", "Directory to write the tables to")
+	exportCmd.AddCommand(exportHistoryCmd)
+	rootCmd.AddCommand(exportCmd)
+}
@@ -0,0 +1,70 @@
+package cmd
+
+import (
+	"context"
+	"slices"
+	"sort"
+	"time"
+
+	"github.com/0xjuanma/golazo/internal/api"
+	"github.com/0xjuanma/golazo/internal/data"
+	"github.com/0xjuanma/golazo/internal/fotmob"
+)
+
+// cachedLiveMatches returns live matches, preferring the shared snapshot from a running TUI.
+// Falls back to a single API fetch (and shares the result) when the snapshot is stale.
+// Used by companion commands (golazo tmux, golazo quick) that run once and exit.
+func cachedLiveMatches(useMockData bool) []api.Match {
+	if useMockData {
+		return data.MockLiveMatches()
+	}
+
+	if matches, ok := fotmob.SharedLiveMatches(fotmob.SharedLiveMaxAge); ok {
+		return matches
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	matches, err := fotmob.NewClient().LiveMatches(ctx)
+	if err != nil {
+		return nil
+	}
+
+	// Share so the next invocation (and other commands) reuse this fetch
+	_ = fotmob.SaveSharedLiveMatches(matches)
+	return matches
+}
+
+// liveOnly filters matches down to those currently in play.
+func liveOnly(matches []api.Match) []api.Match {
+	var live []api.Match
+	for _, match := range matches {
+		if match.Status == api.MatchStatusLive {
+			live = append(live, match)
+		}
+	}
+	return live
+}
+
+// sortByLeagueOrder sorts matches in place by the position of their league in
+// leagueOrder (unlisted leagues last), then by kickoff time.
+func sortByLeagueOrder(matches []api.Match, leagueOrder []int) {
+	rank := func(m api.Match) int {
+		if idx := slices.Index(leagueOrder, m.League.ID); idx >= 0 {
+			return idx
+		}
+		return len(leagueOrder)
+	}
+
+	sort.SliceStable(matches, func(i, j int) bool {
+		ri, rj := rank(matches[i]), rank(matches[j])
+		if ri != rj {
+			return ri < rj
+		}
+		if matches[i].MatchTime != nil && matches[j].MatchTime != nil {
+			return matches[i].MatchTime.Before(*matches[j].MatchTime)
+		}
+		return false
+	})
+}
@@ -0,0 +1,43 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+
+	"github.com/0xjuanma/golazo/internal/app"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/spf13/cobra"
+)
+
+var miniMockFlag bool
+
+var miniCmd = &cobra.Command{
+	Use:         "mini [match-id]",
+	Short:       "Show a tiny always-on-top scoreboard for one match",
+	Annotations: map[string]string{credentialsAnnotation: credentialsEnv},
+	Long:        `Render a 3-line box with the score and minute of a single match, optimized for a small floating terminal window. Follows the first live match when no match ID is given.`,
+	Args:        cobra.MaximumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		matchID := 0
+		if len(args) == 1 {
+			id, err := strconv.Atoi(args[0])
+			if err != nil {
+				return fmt.Errorf("invalid match ID %q: %w", args[0], err)
+			}
+			matchID = id
+		}
+
+		p := tea.NewProgram(app.NewMini(matchID, miniMockFlag))
+		if _, err := p.Run(); err != nil {
+			fmt.Fprintf(os.Stderr, "Error running mini scoreboard: %v\n", err)
+			os.Exit(1)
+		}
+		return nil
+	},
+}
+
+func init() {
+	miniCmd.Flags().BoolVar(&miniMockFlag, "mock", false, "Use mock data instead of real API data")
+	rootCmd.AddCommand(miniCmd)
+}
@@ -0,0 +1,91 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/0xjuanma/golazo/internal/api"
+	"github.com/0xjuanma/golazo/internal/data"
+	"github.com/0xjuanma/golazo/internal/fotmob"
+	"github.com/spf13/cobra"
+)
+
+var (
+	noteDateFlag string
+	noteMockFlag bool
+)
+
+var noteCmd = &cobra.Command{
+	Use:         "note",
+	Short:       "Append followed teams' results to your daily Markdown note",
+	Annotations: map[string]string{credentialsAnnotation: credentialsPrompt},
+	Long: `Append finished results of your followed teams to a daily Markdown note
+(e.g., an Obsidian daily note). Configure it in settings.yaml:
+
+  followed_teams: ["Arsenal", "Barcelona"]
+  daily_note_path: "~/notes/daily/{{date}}.md"
+
+{{date}} is replaced with the match date (YYYY-MM-DD). Results already in the note are skipped,
+so it is safe to run repeatedly (e.g., from cron). The TUI also appends results as it loads them.`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		settings, err := data.LoadSettings()
+		if err != nil {
+			return fmt.Errorf("load settings: %w", err)
+		}
+		if len(settings.FollowedTeams) == 0 {
+			return fmt.Errorf("no followed_teams set in settings.yaml")
+		}
+
+		date := time.Now()
+		if noteDateFlag != "" {
+			date, err = time.ParseInLocation("2006-01-02", noteDateFlag, time.Local)
+			if err != nil {
+				return fmt.Errorf("parse --date (want YYYY-MM-DD): %w", err)
+			}
+		}
+
+		finished, err := noteFinishedMatches(date, noteMockFlag)
+		if err != nil {
+			return err
+		}
+
+		appended, err := data.AppendDailyNotes(settings.DailyNotePath, settings.FollowedMatches(finished))
+		if err != nil {
+			return err
+		}
+
+		fmt.Printf("Appended %d result(s)\n", appended)
+		return nil
+	},
+}
+
+// noteFinishedMatches returns the finished matches for a date across active leagues.
+func noteFinishedMatches(date time.Time, useMockData bool) ([]api.Match, error) {
+	if useMockData {
+		return data.MockFinishedMatches(), nil
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	matches, err := fotmob.NewClient().MatchesByDateWithTabs(ctx, date, []string{"results"})
+	if err != nil {
+		return nil, fmt.Errorf("fetch results: %w", err)
+	}
+
+	var finished []api.Match
+	for _, match := range matches {
+		if match.Status == api.MatchStatusFinished {
+			finished = append(finished, match)
+		}
+	}
+	return finished, nil
+}
+
+func init() {
+	noteCmd.Flags().StringVar(&noteDateFlag, "date", "", "Date to record results for (YYYY-MM-DD, default today)")
+	noteCmd.Flags().BoolVar(&noteMockFlag, "mock", false, "Use mock data instead of real API data")
+	rootCmd.AddCommand(noteCmd)
+}
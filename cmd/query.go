@@ -0,0 +1,63 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/0xjuanma/golazo/internal/eventlog"
+	"github.com/spf13/cobra"
+)
+
+var queryCmd = &cobra.Command{
+	Use:   `query "SELECT ..."`,
+	Short: "Run read-only SQL against the local match history",
+	Long: `Run a read-only SQL query against the local match history recorded by the event log
+(event_log: true in settings.yaml). The history is loaded into an in-memory SQLite database.
+
+Tables:
+  matches    match_id, league, home_team, away_team, home_score, away_score, status, last_logged_at
+  events     match_id, event_id, minute, display_minute, type, event_type, team, player, assist, own_goal, logged_at
+
+Views:
+  results    finished matches with the winner
+  scorers    goals per player (own goals excluded)
+  team_form  played/won/drawn/lost/goals/points per team across finished matches
+
+Example:
+  golazo query "SELECT * FROM scorers LIMIT 10"`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		sink, err := eventlog.NewSink()
+		if err != nil {
+			return err
+		}
+		entries, err := sink.ReadEntries(eventlog.Filter{})
+		if err != nil {
+			return err
+		}
+
+		db, err := eventlog.OpenDB(entries)
+		if err != nil {
+			return err
+		}
+		defer db.Close()
+
+		columns, rows, err := eventlog.Query(db, args[0])
+		if err != nil {
+			return err
+		}
+
+		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+		fmt.Fprintln(w, strings.Join(columns, "\t"))
+		for _, row := range rows {
+			fmt.Fprintln(w, strings.Join(row, "\t"))
+		}
+		return w.Flush()
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(queryCmd)
+}
@@ -0,0 +1,149 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strconv"
+
+	"github.com/0xjuanma/golazo/internal/api"
+	"github.com/0xjuanma/golazo/internal/constants"
+	"github.com/0xjuanma/golazo/internal/fotmob"
+	"github.com/spf13/cobra"
+)
+
+var (
+	quickFormatFlag string
+	quickMockFlag   bool
+)
+
+var quickCmd = &cobra.Command{
+	Use:         "quick",
+	Short:       "Print live matches for launchers (Raycast, Alfred) or plain text",
+	Annotations: map[string]string{credentialsAnnotation: credentialsEnv},
+	Long: `Print the current live matches in a format launchers can consume.
+
+Formats:
+  text     One line per match (default)
+  raycast  JSON items with title, subtitle and an open-url action
+  alfred   Alfred Script Filter JSON (arg is the FotMob match URL)
+
+Reuses the live matches snapshot written by a running golazo TUI when it is fresh.`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		matches := liveOnly(cachedLiveMatches(quickMockFlag))
+		sortByLeagueOrder(matches, fotmob.ActiveLeagues())
+
+		switch quickFormatFlag {
+		case "text":
+			for _, match := range matches {
+				fmt.Println(quickTitle(match) + "  " + quickSubtitle(match))
+			}
+			return nil
+		case "raycast":
+			return writeQuickJSON(raycastItems(matches))
+		case "alfred":
+			return writeQuickJSON(alfredItems(matches))
+		default:
+			return fmt.Errorf("unknown format %q (expected text, raycast or alfred)", quickFormatFlag)
+		}
+	},
+}
+
+// raycastItem is a single Raycast list item.
+type raycastItem struct {
+	Title    string          `json:"title"`
+	Subtitle string          `json:"subtitle"`
+	Actions  []raycastAction `json:"actions,omitempty"`
+}
+
+// raycastAction is an action attached to a Raycast list item.
+type raycastAction struct {
+	Type string `json:"type"`
+	URL  string `json:"url"`
+}
+
+// alfredItem is a single Alfred Script Filter item.
+// See https://www.alfredapp.com/help/workflows/inputs/script-filter/json/
+type alfredItem struct {
+	UID      string `json:"uid,omitempty"`
+	Title    string `json:"title"`
+	Subtitle string `json:"subtitle"`
+	Arg      string `json:"arg,omitempty"`
+	Valid    bool   `json:"valid"`
+}
+
+// raycastItems converts matches to Raycast list items.
+// Returns a single informational item when there are no live matches.
+func raycastItems(matches []api.Match) map[string][]raycastItem {
+	items := []raycastItem{}
+	for _, match := range matches {
+		items = append(items, raycastItem{
+			Title:    quickTitle(match),
+			Subtitle: quickSubtitle(match),
+			Actions:  []raycastAction{{Type: "open-url", URL: fotmob.MatchURL(match.ID)}},
+		})
+	}
+	if len(items) == 0 {
+		items = append(items, raycastItem{Title: constants.EmptyNoLiveMatches})
+	}
+	return map[string][]raycastItem{"items": items}
+}
+
+// alfredItems converts matches to Alfred Script Filter items.
+// Returns a single non-actionable item when there are no live matches.
+func alfredItems(matches []api.Match) map[string][]alfredItem {
+	items := []alfredItem{}
+	for _, match := range matches {
+		items = append(items, alfredItem{
+			UID:      strconv.Itoa(match.ID),
+			Title:    quickTitle(match),
+			Subtitle: quickSubtitle(match),
+			Arg:      fotmob.MatchURL(match.ID),
+			Valid:    true,
+		})
+	}
+	if len(items) == 0 {
+		items = append(items, alfredItem{Title: constants.EmptyNoLiveMatches, Valid: false})
+	}
+	return map[string][]alfredItem{"items": items}
+}
+
+// writeQuickJSON writes v to stdout as JSON.
+func writeQuickJSON(v any) error {
+	enc := json.NewEncoder(os.Stdout)
+	if err := enc.Encode(v); err != nil {
+		return fmt.Errorf("encode output: %w", err)
+	}
+	return nil
+}
+
+// quickTitle formats the launcher title: "Arsenal 2 - 1 Chelsea".
+func quickTitle(match api.Match) string {
+	homeScore, awayScore := 0, 0
+	if match.HomeScore != nil {
+		homeScore = *match.HomeScore
+	}
+	if match.AwayScore != nil {
+		awayScore = *match.AwayScore
+	}
+	return fmt.Sprintf("%s %d - %d %s", match.HomeTeam.Name, homeScore, awayScore, match.AwayTeam.Name)
+}
+
+// quickSubtitle formats the launcher subtitle: "67' · Premier League".
+func quickSubtitle(match api.Match) string {
+	minute := constants.StatusLive
+	if match.LiveTime != nil && *match.LiveTime != "" {
+		minute = *match.LiveTime
+	}
+	if match.League.Name == "" {
+		return minute
+	}
+	return minute + " · " + match.League.Name
+}
+
+func init() {
+	quickCmd.Flags().StringVar(&quickFormatFlag, "format", "text", "Output format: text, raycast or alfred")
+	quickCmd.Flags().BoolVar(&quickMockFlag, "mock", false, "Use mock data instead of real API data")
+	rootCmd.AddCommand(quickCmd)
+}
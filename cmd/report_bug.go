@@ -0,0 +1,66 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/0xjuanma/golazo/internal/fotmob"
+	"github.com/0xjuanma/golazo/internal/report"
+	"github.com/spf13/cobra"
+)
+
+var reportMatchFlag int
+var reportOutFlag string
+
+var reportBugCmd = &cobra.Command{
+	Use:         "report-bug",
+	Short:       "Bundle diagnostics into a zip to attach to a GitHub issue",
+	Annotations: map[string]string{credentialsAnnotation: credentialsPrompt},
+	Long: `Collect a diagnostics bundle to attach to a GitHub issue: version and platform,
+settings.yaml with proxy credentials redacted, the end of the debug log (golazo --debug),
+a listing of the config and cache files, and whether they still decode.
+
+With --match, the raw FotMob response for that match is included and checked too. Find the
+match ID in its FotMob URL, or export the response from the app with Ctrl+E.
+Home directory paths are replaced with "~" throughout.`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		opts := report.Options{Version: Version, MatchID: reportMatchFlag}
+		if reportMatchFlag != 0 {
+			ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
+			body, err := fotmob.NewClient().RawMatchDetails(ctx, reportMatchFlag)
+			cancel()
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "Could not fetch match %d, bundling without it: %v\n", reportMatchFlag, err)
+			}
+			opts.RawResponse = body
+		}
+
+		out := reportOutFlag
+		if out == "" {
+			out = fmt.Sprintf("golazo-report-%s.zip", time.Now().Format("20060102-150405"))
+		}
+		f, err := os.Create(out)
+		if err != nil {
+			return fmt.Errorf("create %s: %w", out, err)
+		}
+		if err := report.Write(f, opts); err != nil {
+			_ = f.Close()
+			return err
+		}
+		if err := f.Close(); err != nil {
+			return fmt.Errorf("write %s: %w", out, err)
+		}
+
+		fmt.Printf("Wrote %s - please check it before attaching it to an issue at https://github.com/0xjuanma/golazo/issues\n", out)
+		return nil
+	},
+}
+
+func init() {
+	reportBugCmd.Flags().IntVarP(&reportMatchFlag, "match", "m", 0, "FotMob match ID the report is about")
+	reportBugCmd.Flags().StringVarP(&reportOutFlag, "out", "o", "", "Zip file to write (default golazo-report-<time>.zip)")
+	rootCmd.AddCommand(reportBugCmd)
+}
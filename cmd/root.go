@@ -1,15 +1,22 @@
 package cmd
 
 import (
+	"errors"
 	"fmt"
+	"net"
 	"os"
 	"os/exec"
 	"path/filepath"
 	"runtime"
 	"strings"
+	"time"
 
 	"github.com/0xjuanma/golazo/internal/app"
+	"github.com/0xjuanma/golazo/internal/companion"
 	"github.com/0xjuanma/golazo/internal/data"
+	"github.com/0xjuanma/golazo/internal/httpx"
+	"github.com/0xjuanma/golazo/internal/trace"
+	"github.com/0xjuanma/golazo/internal/ui"
 	"github.com/0xjuanma/golazo/internal/version"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/spf13/cobra"
@@ -22,11 +29,27 @@ var mockFlag bool
 var updateFlag bool
 var versionFlag bool
 var debugFlag bool
+var privateFlag bool
+var chaosFlag bool
+var chaosLatencyFlag time.Duration
+var chaosErrorsFlag float64
+var chaosMalformedFlag float64
+var serveFlag string
+var joinFlag string
 
 var rootCmd = &cobra.Command{
-	Use:   "golazo",
-	Short: "The beautiful game in your terminal",
-	Long:  `A minimal TUI for following football matches in real-time. Get live match updates, finished match statistics, and minute-by-minute events directly in your terminal.`,
+	Use:         "golazo",
+	Short:       "The beautiful game in your terminal",
+	Long:        `A minimal TUI for following football matches in real-time. Get live match updates, finished match statistics, and minute-by-minute events directly in your terminal.`,
+	Annotations: map[string]string{credentialsAnnotation: credentialsPrompt},
+	PersistentPreRun: func(cmd *cobra.Command, args []string) {
+		// Unlock stored secrets before the TUI takes over the terminal
+		if versionFlag || updateFlag || cmd.Name() == "help" || (cmd.HasParent() && cmd.Parent().Name() == "completion") {
+			return
+		}
+		enablePrivacyMode()
+		unlockCredentials(cmd.Annotations[credentialsAnnotation])
+	},
 	Run: func(cmd *cobra.Command, args []string) {
 		if versionFlag {
 			version.Print(Version)
@@ -38,6 +61,15 @@ var rootCmd = &cobra.Command{
 			return
 		}
 
+		// Fault injection must be configured before any API client is created
+		if chaos, enabled := chaosFromFlags(cmd); enabled {
+			if chaos.ErrorRate < 0 || chaos.ErrorRate > 1 || chaos.MalformedRate < 0 || chaos.MalformedRate > 1 {
+				fmt.Fprintln(os.Stderr, "--chaos-errors and --chaos-malformed must be between 0 and 1")
+				os.Exit(1)
+			}
+			data.SetChaos(chaos)
+		}
+
 		// Determine banner conditions
 		isDevBuild := Version == "dev"
 		newVersionAvailable := false
@@ -50,8 +82,11 @@ var rootCmd = &cobra.Command{
 			}
 		}
 
-		// Check for updates in background (non-blocking)
+		// Check for updates in background (non-blocking), never in privacy mode
 		go func() {
+			if data.PrivacyMode() {
+				return
+			}
 			// Check immediately if current version is older than stored, OR do daily check
 			shouldCheck := data.ShouldCheckVersion()
 			if !shouldCheck && storedLatestVersion != "" && !isDevBuild {
@@ -65,14 +100,82 @@ var rootCmd = &cobra.Command{
 			}
 		}()
 
-		p := tea.NewProgram(app.New(mockFlag, debugFlag, isDevBuild, newVersionAvailable, Version), tea.WithAltScreen())
-		if _, err := p.Run(); err != nil {
+		// Validate settings up front so typos are reported before the TUI starts
+		if settings, err := data.LoadSettings(); err == nil {
+			if err := app.ValidateSettings(settings); err != nil {
+				fmt.Fprintf(os.Stderr, "Invalid %v\n", err)
+				os.Exit(1)
+			}
+			if err := ui.SetMatchItemTemplate(settings.ListItemTemplate); err != nil {
+				fmt.Fprintf(os.Stderr, "Invalid list_item_template in settings.yaml: %v\n", err)
+				os.Exit(1)
+			}
+		}
+
+		// Export provider call spans when an OpenTelemetry collector is configured
+		if !data.PrivacyMode() {
+			trace.EnableOTLP(os.Getenv("OTEL_EXPORTER_OTLP_ENDPOINT"))
+		}
+
+		var model tea.Model = app.New(mockFlag, debugFlag, isDevBuild, newVersionAvailable, Version)
+		if joinFlag != "" {
+			switch {
+			case serveFlag != "":
+				fmt.Fprintln(os.Stderr, "--join and --serve can't be combined: the host's --serve already runs the party")
+				os.Exit(1)
+			case data.PrivacyMode():
+				fmt.Fprintln(os.Stderr, "--join contacts the party host, which privacy mode doesn't allow")
+				os.Exit(1)
+			}
+			model = app.WithParty(model, app.JoinParty(joinFlag))
+		}
+		if serveFlag != "" {
+			// Listen before the TUI starts so a busy address is reported in the terminal
+			ln, err := net.Listen("tcp", serveFlag)
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "Cannot serve the web companion: %v\n", err)
+				os.Exit(1)
+			}
+			server := companion.New()
+			go func() { _ = server.Serve(ln) }()
+			model = app.WithCompanion(app.WithParty(model, app.HostParty(server)), server)
+		}
+
+		p := tea.NewProgram(model, tea.WithAltScreen())
+		final, err := p.Run()
+		if err == nil || errors.Is(err, tea.ErrInterrupted) {
+			// Quit, ctrl+c or SIGTERM: flush state and mark the session as cleanly shut down
+			app.Shutdown(final)
+		}
+		if err != nil && !errors.Is(err, tea.ErrInterrupted) {
 			fmt.Fprintf(os.Stderr, "Error running application: %v\n", err)
 			os.Exit(1)
 		}
 	},
 }
 
+// enablePrivacyMode turns privacy mode on from --private or the privacy_mode setting,
+// before any API client is created.
+func enablePrivacyMode() {
+	private := privateFlag
+	if settings, err := data.LoadSettings(); err == nil && settings.PrivacyMode {
+		private = true
+	}
+	data.SetPrivacyMode(private)
+}
+
+// chaosFromFlags returns the fault injection settings; enabled when --chaos or any
+// --chaos-* flag is set.
+func chaosFromFlags(cmd *cobra.Command) (httpx.Chaos, bool) {
+	flags := cmd.Flags()
+	enabled := chaosFlag || flags.Changed("chaos-latency") || flags.Changed("chaos-errors") || flags.Changed("chaos-malformed")
+	return httpx.Chaos{
+		MaxLatency:    chaosLatencyFlag,
+		ErrorRate:     chaosErrorsFlag,
+		MalformedRate: chaosMalformedFlag,
+	}, enabled
+}
+
 // runUpdate executes the appropriate update method based on installation detection.
 func runUpdate() {
 	installMethod := detectInstallationMethod()
@@ -173,6 +276,13 @@ func Execute() {
 func init() {
 	rootCmd.Flags().BoolVar(&mockFlag, "mock", false, "Use mock data for all views instead of real API data")
 	rootCmd.Flags().BoolVar(&debugFlag, "debug", false, "Enable debug logging to ~/.golazo/golazo_debug.log")
+	rootCmd.PersistentFlags().BoolVar(&privateFlag, "private", false, "Privacy mode: only contact FotMob and log every host contacted to ~/.golazo/"+data.OutboundLogFileName)
 	rootCmd.Flags().BoolVarP(&updateFlag, "update", "u", false, "Update golazo to the latest version")
 	rootCmd.Flags().BoolVarP(&versionFlag, "version", "v", false, "Display version information")
+	rootCmd.Flags().StringVar(&serveFlag, "serve", "", "Serve a second-screen web companion with live scores and the selected match's feed on this address (e.g. :8080)")
+	rootCmd.Flags().StringVar(&joinFlag, "join", "", "Join the watch party of a golazo serving on this URL (e.g. http://192.168.1.20:8080)")
+	rootCmd.Flags().BoolVar(&chaosFlag, "chaos", false, "Inject latency, 429/500 errors and malformed JSON into API responses, or latency and failures into --mock data (development)")
+	rootCmd.Flags().DurationVar(&chaosLatencyFlag, "chaos-latency", 2*time.Second, "Maximum random latency added to each request in chaos mode")
+	rootCmd.Flags().Float64Var(&chaosErrorsFlag, "chaos-errors", 0.2, "Share of requests failed with 429/500 in chaos mode (0-1)")
+	rootCmd.Flags().Float64Var(&chaosMalformedFlag, "chaos-malformed", 0.1, "Share of responses replaced with malformed JSON in chaos mode (0-1)")
 }
@@ -0,0 +1,72 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"time"
+
+	"github.com/0xjuanma/golazo/internal/api"
+	"github.com/0xjuanma/golazo/internal/data"
+	"github.com/0xjuanma/golazo/internal/fotmob"
+	"github.com/0xjuanma/golazo/internal/rpc"
+	"github.com/spf13/cobra"
+)
+
+var (
+	rpcMockFlag    bool
+	rpcMsgpackFlag bool
+)
+
+var rpcCmd = &cobra.Command{
+	Use:         "rpc",
+	Short:       "Drive golazo headless with JSON over stdin/stdout",
+	Annotations: map[string]string{credentialsAnnotation: credentialsEnv},
+	Long: `Read JSON requests from stdin, one per line, and write JSON responses and match events to stdout, one per line, for editor plugins and custom GUIs running golazo as a subprocess.
+
+Methods: live, matches {"date": "YYYY-MM-DD"}, details {"match_id": N}, subscribe {"match_id": N} and unsubscribe {"match_id": N}. Exits when stdin closes.
+
+With --msgpack, speaks msgpack-rpc for Neovim job control instead (see docs/NEOVIM.md).`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		var source rpc.Source = fotmob.NewClient()
+		if rpcMockFlag {
+			source = mockSource{}
+		}
+
+		ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+		defer stop()
+		server := rpc.New(source)
+		serve := server.Serve
+		if rpcMsgpackFlag {
+			serve = server.ServeMsgpack
+		}
+		if err := serve(ctx, os.Stdin, os.Stdout); err != nil {
+			return fmt.Errorf("read requests: %w", err)
+		}
+		return nil
+	},
+}
+
+// mockSource serves mock data to golazo rpc --mock. Every date has the mock finished
+// matches.
+type mockSource struct{}
+
+func (mockSource) LiveMatches(context.Context) ([]api.Match, error) {
+	return data.MockLiveMatches(), nil
+}
+
+func (mockSource) MatchesByDate(context.Context, time.Time) ([]api.Match, error) {
+	return data.MockFinishedMatches(), nil
+}
+
+func (mockSource) MatchDetails(_ context.Context, matchID int) (*api.MatchDetails, error) {
+	return data.MockMatchDetails(matchID)
+}
+
+func init() {
+	rpcCmd.Flags().BoolVar(&rpcMockFlag, "mock", false, "Use mock data instead of real API data")
+	rpcCmd.Flags().BoolVar(&rpcMsgpackFlag, "msgpack", false, "Speak msgpack-rpc (Neovim job control) instead of JSON Lines")
+	rootCmd.AddCommand(rpcCmd)
+}
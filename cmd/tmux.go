@@ -0,0 +1,77 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/0xjuanma/golazo/internal/api"
+	"github.com/0xjuanma/golazo/internal/fotmob"
+	"github.com/spf13/cobra"
+)
+
+var tmuxMockFlag bool
+
+var tmuxCmd = &cobra.Command{
+	Use:         "tmux",
+	Short:       "Print a tmux status segment for the top live match",
+	Annotations: map[string]string{credentialsAnnotation: credentialsEnv},
+	Long: `Print a tmux-formatted status segment (with tmux color codes) for the highest-priority live match.
+Priority follows the order of your selected leagues. Prints nothing when no match is live.
+
+Reuses the live matches snapshot written by a running golazo TUI, so no extra API calls are made
+while the TUI is open. Add it to tmux with: set -g status-right '#(golazo tmux)'`,
+	Args: cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		matches := cachedLiveMatches(tmuxMockFlag)
+		if match, ok := topLiveMatch(matches, fotmob.ActiveLeagues()); ok {
+			fmt.Println(formatTmuxSegment(match))
+		}
+	},
+}
+
+// topLiveMatch picks the highest-priority live match.
+// Matches are ranked by the position of their league in leagueOrder, then by kickoff time.
+func topLiveMatch(matches []api.Match, leagueOrder []int) (api.Match, bool) {
+	live := liveOnly(matches)
+	if len(live) == 0 {
+		return api.Match{}, false
+	}
+
+	sortByLeagueOrder(live, leagueOrder)
+
+	return live[0], true
+}
+
+// formatTmuxSegment renders a match as a tmux status segment.
+// Format: "67' ARS 2-1 CHE" with tmux #[fg=...] color codes.
+func formatTmuxSegment(match api.Match) string {
+	home := match.HomeTeam.ShortName
+	if home == "" {
+		home = match.HomeTeam.Name
+	}
+	away := match.AwayTeam.ShortName
+	if away == "" {
+		away = match.AwayTeam.Name
+	}
+
+	minute := "LIVE"
+	if match.LiveTime != nil && *match.LiveTime != "" {
+		minute = *match.LiveTime
+	}
+
+	homeScore, awayScore := 0, 0
+	if match.HomeScore != nil {
+		homeScore = *match.HomeScore
+	}
+	if match.AwayScore != nil {
+		awayScore = *match.AwayScore
+	}
+
+	// colour196 = red (live minute), colour51 = cyan (teams) - matches the TUI palette
+	return fmt.Sprintf("#[fg=colour196,bold]%s#[default] #[fg=colour51]%s#[default] #[bold]%d-%d#[default] #[fg=colour51]%s#[default]",
+		minute, home, homeScore, awayScore, away)
+}
+
+func init() {
+	tmuxCmd.Flags().BoolVar(&tmuxMockFlag, "mock", false, "Use mock data instead of real API data")
+	rootCmd.AddCommand(tmuxCmd)
+}
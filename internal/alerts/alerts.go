@@ -0,0 +1,139 @@
+// Package alerts is the milestone alert engine: built-in rule templates (first goal,
+// late goal, hat-trick, a team reaching three goals) that turn a new goal into extra
+// notifications, each toggled in the alerts setting.
+package alerts
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/0xjuanma/golazo/internal/api"
+	"github.com/0xjuanma/golazo/internal/constants"
+	"github.com/0xjuanma/golazo/internal/data"
+)
+
+// Goal is a new goal with the match as it stood right after it.
+type Goal struct {
+	Event     api.MatchEvent
+	Details   *api.MatchDetails
+	HomeScore int // Score the goal made
+	AwayScore int
+}
+
+// Alert is a milestone notification.
+type Alert struct {
+	Title   string
+	Message string
+}
+
+// template is a built-in alert rule.
+type template struct {
+	title   string
+	enabled func(settings *data.AlertSettings) bool
+	matches func(settings *data.AlertSettings, goal Goal) bool
+	message func(goal Goal) string
+}
+
+// templates are the built-in rules, in the order their alerts are sent.
+var templates = []template{
+	{
+		title:   constants.NotificationTitleFirstGoal,
+		enabled: func(s *data.AlertSettings) bool { return s.FirstGoal },
+		matches: func(_ *data.AlertSettings, g Goal) bool { return g.HomeScore+g.AwayScore == 1 },
+		message: func(g Goal) string {
+			return fmt.Sprintf("%s opens the scoring %d'", scorer(g), g.Event.Minute)
+		},
+	},
+	{
+		title:   constants.NotificationTitleLateGoal,
+		enabled: func(s *data.AlertSettings) bool { return s.LateGoal },
+		matches: func(s *data.AlertSettings, g Goal) bool { return g.Event.Minute >= s.LateGoalMinuteOrDefault() },
+		message: func(g Goal) string {
+			return fmt.Sprintf("%s scores at %d'", scorer(g), g.Event.Minute)
+		},
+	},
+	{
+		title:   constants.NotificationTitleHatTrick,
+		enabled: func(s *data.AlertSettings) bool { return s.HatTrick },
+		matches: func(_ *data.AlertSettings, g Goal) bool { return playerGoals(g) == 3 },
+		message: func(g Goal) string {
+			return fmt.Sprintf("%s completes a hat-trick %d'", scorer(g), g.Event.Minute)
+		},
+	},
+	{
+		title:   constants.NotificationTitleThreeGoals,
+		enabled: func(s *data.AlertSettings) bool { return s.ThreeGoals },
+		matches: func(_ *data.AlertSettings, g Goal) bool { return teamScore(g) == 3 },
+		message: func(g Goal) string {
+			return fmt.Sprintf("%s reach three goals %d'", teamName(g.Event.Team), g.Event.Minute)
+		},
+	},
+}
+
+// Evaluate returns the alerts a goal triggers under the enabled rules; none when
+// settings is nil.
+func Evaluate(settings *data.AlertSettings, goal Goal) []Alert {
+	if settings == nil || goal.Details == nil {
+		return nil
+	}
+	var alerts []Alert
+	for _, t := range templates {
+		if t.enabled(settings) && t.matches(settings, goal) {
+			alerts = append(alerts, Alert{Title: t.title, Message: t.message(goal) + "\n" + scoreLine(goal)})
+		}
+	}
+	return alerts
+}
+
+// isOwnGoal reports whether a goal event is an own goal.
+func isOwnGoal(event api.MatchEvent) bool {
+	return event.OwnGoal != nil && *event.OwnGoal
+}
+
+// playerGoals counts the goals the scorer of goal has scored up to it; own goals
+// count for nobody.
+func playerGoals(g Goal) int {
+	if g.Event.Player == nil || *g.Event.Player == "" || isOwnGoal(g.Event) {
+		return 0
+	}
+	count := 0
+	for _, event := range g.Details.Events {
+		if strings.ToLower(event.Type) != "goal" || isOwnGoal(event) || event.Team.ID != g.Event.Team.ID ||
+			event.Player == nil || *event.Player != *g.Event.Player {
+			continue
+		}
+		if event.Minute < g.Event.Minute || (event.Minute == g.Event.Minute && !event.Timestamp.After(g.Event.Timestamp)) {
+			count++
+		}
+	}
+	return count
+}
+
+// teamScore returns the score of the team credited with goal.
+func teamScore(g Goal) int {
+	if g.Event.Team.ID == g.Details.HomeTeam.ID {
+		return g.HomeScore
+	}
+	return g.AwayScore
+}
+
+// scorer returns "Player (Team)", or the team alone when the scorer is unknown.
+func scorer(g Goal) string {
+	if g.Event.Player == nil || *g.Event.Player == "" {
+		return teamName(g.Event.Team)
+	}
+	return fmt.Sprintf("%s (%s)", *g.Event.Player, teamName(g.Event.Team))
+}
+
+// scoreLine returns "Home 2 - 1 Away".
+func scoreLine(g Goal) string {
+	return fmt.Sprintf("%s %d - %d %s", teamName(g.Details.HomeTeam), g.HomeScore, g.AwayScore, teamName(g.Details.AwayTeam))
+}
+
+// teamName returns the short team name, falling back to the full name.
+func teamName(team api.Team) string {
+	if team.ShortName != "" {
+		return team.ShortName
+	}
+	return team.Name
+}
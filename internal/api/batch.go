@@ -0,0 +1,94 @@
+package api
+
+import (
+	"context"
+	"sync"
+)
+
+// Capabilities describes optional features of a provider.
+type Capabilities struct {
+	// BatchDetails is set when the provider can return details for several fixtures
+	// in one request (e.g., api-sports fixtures?ids=1-2-3). FotMob cannot.
+	BatchDetails bool
+
+	// MaxBatchSize caps the fixtures per batch request (0 = no limit).
+	MaxBatchSize int
+}
+
+// CapabilityReporter is implemented by clients that advertise optional provider features.
+type CapabilityReporter interface {
+	Capabilities() Capabilities
+}
+
+// DetailsBatcher is implemented by clients that can fetch several fixtures per request.
+// The returned map may omit IDs the provider did not return.
+type DetailsBatcher interface {
+	MatchDetailsBatch(ctx context.Context, matchIDs []int) (map[int]*MatchDetails, error)
+}
+
+// FetchDetails retrieves details for several matches using the fewest requests the
+// provider allows: batched in chunks of MaxBatchSize when the client advertises
+// BatchDetails, otherwise one concurrent MatchDetails call per match. Matches a batch
+// fails for or leaves out are fetched with MatchDetails.
+// Returns a map of matchID -> details (nil if the fetch failed).
+func FetchDetails(ctx context.Context, c Client, matchIDs []int) map[int]*MatchDetails {
+	results := make(map[int]*MatchDetails, len(matchIDs))
+
+	batcher, ok := c.(DetailsBatcher)
+	reporter, hasCaps := c.(CapabilityReporter)
+	if !ok || !hasCaps || !reporter.Capabilities().BatchDetails {
+		fetchEach(ctx, c, matchIDs, results)
+		return results
+	}
+
+	size := reporter.Capabilities().MaxBatchSize
+	if size <= 0 {
+		size = len(matchIDs)
+	}
+	for start := 0; start < len(matchIDs); start += size {
+		chunk := matchIDs[start:min(start+size, len(matchIDs))]
+		batch, err := batcher.MatchDetailsBatch(ctx, chunk)
+		if err != nil {
+			// Fall back to per-match calls so one bad batch doesn't lose the chunk
+			fetchEach(ctx, c, chunk, results)
+			continue
+		}
+		// IDs the batch left out are fetched one by one
+		var missing []int
+		for _, id := range chunk {
+			if details := batch[id]; details != nil {
+				results[id] = details
+			} else {
+				missing = append(missing, id)
+			}
+		}
+		if len(missing) > 0 {
+			fetchEach(ctx, c, missing, results)
+		}
+	}
+	return results
+}
+
+// fetchEach fetches each match concurrently into results.
+func fetchEach(ctx context.Context, c Client, matchIDs []int, results map[int]*MatchDetails) {
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+
+	for _, id := range matchIDs {
+		wg.Add(1)
+		go func(matchID int) {
+			defer wg.Done()
+
+			details, err := c.MatchDetails(ctx, matchID)
+			if err != nil {
+				details = nil // Store nil for failed fetches
+			}
+
+			mu.Lock()
+			results[matchID] = details
+			mu.Unlock()
+		}(id)
+	}
+
+	wg.Wait()
+}
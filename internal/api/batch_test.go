@@ -0,0 +1,84 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+)
+
+// fakeBatcher answers batches with the IDs in returned and counts single fetches.
+type fakeBatcher struct {
+	mu       sync.Mutex
+	returned []int // IDs a batch returns details for
+	batchErr error
+	batches  [][]int
+	singles  []int
+}
+
+func (f *fakeBatcher) Capabilities() Capabilities {
+	return Capabilities{BatchDetails: true, MaxBatchSize: 2}
+}
+
+func (f *fakeBatcher) MatchDetailsBatch(_ context.Context, matchIDs []int) (map[int]*MatchDetails, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.batches = append(f.batches, matchIDs)
+	if f.batchErr != nil {
+		return nil, f.batchErr
+	}
+	batch := make(map[int]*MatchDetails)
+	for _, id := range matchIDs {
+		if slices.Contains(f.returned, id) {
+			batch[id] = &MatchDetails{Match: Match{ID: id}}
+		}
+	}
+	return batch, nil
+}
+
+func (f *fakeBatcher) MatchDetails(_ context.Context, matchID int) (*MatchDetails, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.singles = append(f.singles, matchID)
+	return &MatchDetails{Match: Match{ID: matchID}}, nil
+}
+
+func (f *fakeBatcher) MatchesByDate(context.Context, time.Time) ([]Match, error) { return nil, nil }
+func (f *fakeBatcher) Leagues(context.Context) ([]League, error)                 { return nil, nil }
+func (f *fakeBatcher) LeagueMatches(context.Context, int) ([]Match, error)       { return nil, nil }
+func (f *fakeBatcher) LeagueTable(context.Context, int, string) ([]LeagueTableEntry, error) {
+	return nil, nil
+}
+
+func TestFetchDetailsBatched(t *testing.T) {
+	tests := []struct {
+		name        string
+		batcher     *fakeBatcher
+		wantSingles []int
+	}{
+		{"full batches", &fakeBatcher{returned: []int{1, 2, 3}}, nil},
+		{"partial batch", &fakeBatcher{returned: []int{1, 3}}, []int{2}},
+		{"failed batches", &fakeBatcher{batchErr: errors.New("503")}, []int{1, 2, 3}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			results := FetchDetails(t.Context(), tt.batcher, []int{1, 2, 3})
+
+			for _, id := range []int{1, 2, 3} {
+				if details := results[id]; details == nil || details.ID != id {
+					t.Errorf("details for %d = %+v; want them fetched", id, details)
+				}
+			}
+			if len(tt.batcher.batches) != 2 {
+				t.Errorf("sent %d batches; want 2 of at most 2 IDs", len(tt.batcher.batches))
+			}
+			slices.Sort(tt.batcher.singles)
+			if !slices.Equal(tt.batcher.singles, tt.wantSingles) {
+				t.Errorf("fetched %v one by one; want %v", tt.batcher.singles, tt.wantSingles)
+			}
+		})
+	}
+}
@@ -0,0 +1,116 @@
+package api
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Period is the part of a live match being played.
+type Period string
+
+const (
+	PeriodFirstHalf      Period = "first_half"
+	PeriodHalfTime       Period = "half_time"
+	PeriodSecondHalf     Period = "second_half"
+	PeriodExtraTimeBreak Period = "extra_time_break" // Before extra time or at its half-time
+	PeriodExtraTime      Period = "extra_time"
+	PeriodPenalties      Period = "penalties"
+)
+
+// Regulation and extra time period lengths, in minutes.
+const (
+	HalfMinutes      = 45
+	ExtraHalfMinutes = 15
+)
+
+// Clock is the clock of a live match.
+type Clock struct {
+	Period    Period `json:"period"`
+	Minute    int    `json:"minute,omitempty"`     // Minute of play, up to the end of the period (45 for "45+3'"); 0 in breaks
+	AddedTime int    `json:"added_time,omitempty"` // Stoppage minute being played (3 for "45+3'")
+	Announced int    `json:"announced,omitempty"`  // Added time announced for the period, 0 until shown
+}
+
+// ParseClock parses a live time as shown by providers: "67'", "45+3'", "90 + 2", "HT",
+// "ET", "Pen". Returns nil for anything else, including "FT".
+func ParseClock(liveTime string) *Clock {
+	text := strings.TrimSpace(strings.TrimRight(strings.TrimSpace(liveTime), "'’"))
+	switch strings.ToUpper(text) {
+	case "HT", "HALF-TIME", "HALF TIME":
+		return &Clock{Period: PeriodHalfTime}
+	case "ET", "BREAK", "ET HT", "ETHT":
+		return &Clock{Period: PeriodExtraTimeBreak}
+	case "PEN", "PENS", "PEN.", "PENALTIES":
+		return &Clock{Period: PeriodPenalties}
+	}
+
+	base, added, hasAdded := strings.Cut(text, "+")
+	minute, err := strconv.Atoi(strings.TrimSpace(base))
+	if err != nil || minute <= 0 {
+		return nil
+	}
+	clock := &Clock{Minute: minute}
+	if hasAdded {
+		clock.AddedTime, err = strconv.Atoi(strings.TrimSpace(added))
+		if err != nil || clock.AddedTime < 0 {
+			return nil
+		}
+	}
+	switch {
+	case minute <= HalfMinutes:
+		clock.Period = PeriodFirstHalf
+	case minute <= 2*HalfMinutes:
+		clock.Period = PeriodSecondHalf
+	default:
+		clock.Period = PeriodExtraTime
+	}
+	return clock
+}
+
+// InStoppageTime reports whether added time is being played.
+func (c Clock) InStoppageTime() bool {
+	return c.AddedTime > 0
+}
+
+// String returns the clock as shown in lists and details: "67'", "45+3'", "HT",
+// "ET 97'", "ET 105+1'", "ET break" or "PEN".
+func (c Clock) String() string {
+	switch c.Period {
+	case PeriodHalfTime:
+		return "HT"
+	case PeriodExtraTimeBreak:
+		return "ET break"
+	case PeriodPenalties:
+		return "PEN"
+	}
+	minute := strconv.Itoa(c.Minute)
+	if c.AddedTime > 0 {
+		minute = fmt.Sprintf("%d+%d", c.Minute, c.AddedTime)
+	}
+	if c.Period == PeriodExtraTime {
+		return "ET " + minute + "'"
+	}
+	return minute + "'"
+}
+
+// Detail returns the clock with the announced added time during stoppage time or once
+// it is shown, e.g. "90+2' (+5)".
+func (c Clock) Detail() string {
+	if c.Announced > 0 && c.Minute > 0 {
+		return fmt.Sprintf("%s (+%d)", c, c.Announced)
+	}
+	return c.String()
+}
+
+// ClockText returns the live clock for display: the structured clock when known, else
+// the raw live time, else "".
+func (m Match) ClockText() string {
+	if m.Clock != nil {
+		return m.Clock.String()
+	}
+	if m.LiveTime != nil {
+		return *m.LiveTime
+	}
+	return ""
+}
@@ -41,8 +41,24 @@ type Match struct {
 	HomeScore *int        `json:"home_score,omitempty"`
 	AwayScore *int        `json:"away_score,omitempty"`
 	MatchTime *time.Time  `json:"match_time,omitempty"`
-	LiveTime  *string     `json:"live_time,omitempty"` // e.g., "45+2", "HT", "FT"
+	LiveTime  *string     `json:"live_time,omitempty"` // Raw from the provider, e.g., "45+2", "HT", "FT"
+	Clock     *Clock      `json:"clock,omitempty"`     // Parsed live clock, nil unless live
 	Round     string      `json:"round,omitempty"`
+	Shootout  *Shootout   `json:"shootout,omitempty"` // nil unless the match went to penalties
+}
+
+// Shootout is a penalty shootout.
+type Shootout struct {
+	HomeScore int            `json:"home_score"`
+	AwayScore int            `json:"away_score"`
+	Kicks     []ShootoutKick `json:"kicks,omitempty"` // In the order taken; empty when only the score is known
+}
+
+// ShootoutKick is a kick of a penalty shootout.
+type ShootoutKick struct {
+	Home   bool   `json:"home"` // Taken by the home team
+	Player string `json:"player,omitempty"`
+	Scored bool   `json:"scored"`
 }
 
 // MatchEvent represents an event in a match (goal, card, substitution, etc.)
@@ -56,7 +72,7 @@ type MatchEvent struct {
 	Assist        *string   `json:"assist,omitempty"`
 	EventType     *string   `json:"event_type,omitempty"` // "yellow", "red", "in", "out", etc.
 	OwnGoal       *bool     `json:"own_goal,omitempty"`   // Indicates if this is an own goal
-	Timestamp     time.Time `json:"timestamp"`
+	Timestamp     time.Time `json:"timestamp"`            // When the event happened (from the provider's half kickoff times when available)
 }
 
 // MatchStatistic represents a single match statistic (possession, shots, etc.)
@@ -74,14 +90,39 @@ type PlayerInfo struct {
 	Number   int    `json:"number,omitempty"`
 	Position string `json:"position,omitempty"`
 	Rating   string `json:"rating,omitempty"` // Player rating (e.g., "7.2")
+	Captain  bool   `json:"captain,omitempty"`
+}
+
+// Lineup is a team's line-up for a match, available shortly before kick-off.
+type Lineup struct {
+	TeamID    int          `json:"team_id"`
+	Formation string       `json:"formation,omitempty"` // e.g., "4-3-3"
+	Starting  []PlayerInfo `json:"starting"`            // Starting XI
+	Bench     []PlayerInfo `json:"bench,omitempty"`
+	Coach     string       `json:"coach,omitempty"`
+}
+
+// Captain returns the team captain, or nil if unknown.
+func (l *Lineup) Captain() *PlayerInfo {
+	if l == nil {
+		return nil
+	}
+	for i := range l.Starting {
+		if l.Starting[i].Captain {
+			return &l.Starting[i]
+		}
+	}
+	return nil
 }
 
 // MatchDetails contains detailed information about a match
 type MatchDetails struct {
 	Match
 	Events     []MatchEvent `json:"events"`
-	HomeLineup []string     `json:"home_lineup,omitempty"`
-	AwayLineup []string     `json:"away_lineup,omitempty"`
+	HomeLineup *Lineup      `json:"home_lineup,omitempty"` // nil until line-ups are announced
+	AwayLineup *Lineup      `json:"away_lineup,omitempty"`
+
+	LineupsPredicted bool `json:"lineups_predicted,omitempty"` // Line-ups are FotMob's prediction, not the announced ones
 
 	// Additional match information
 	HalfTimeScore *struct {
@@ -89,16 +130,14 @@ type MatchDetails struct {
 		Away *int `json:"away,omitempty"`
 	} `json:"half_time_score,omitempty"`
 	Venue         string  `json:"venue,omitempty"`          // Stadium name
+	VenueCity     string  `json:"venue_city,omitempty"`     // City of the stadium
 	Winner        *string `json:"winner,omitempty"`         // "home" or "away"
 	MatchDuration int     `json:"match_duration,omitempty"` // 90, 120, etc.
 	ExtraTime     bool    `json:"extra_time,omitempty"`     // If match went to extra time
-	Penalties     *struct {
-		Home *int `json:"home,omitempty"`
-		Away *int `json:"away,omitempty"`
-	} `json:"penalties,omitempty"`
 
 	// Extended statistics
-	Statistics []MatchStatistic `json:"statistics,omitempty"` // Match statistics (possession, shots, etc.)
+	Statistics          []MatchStatistic `json:"statistics,omitempty"`            // Match statistics (possession, shots, etc.)
+	FirstHalfStatistics []MatchStatistic `json:"first_half_statistics,omitempty"` // Statistics of the first half only
 
 	// Match context
 	Referee    string `json:"referee,omitempty"`    // Referee name
@@ -116,12 +155,174 @@ type MatchDetails struct {
 	HomeSubstitutes []PlayerInfo `json:"home_substitutes,omitempty"`
 	AwaySubstitutes []PlayerInfo `json:"away_substitutes,omitempty"`
 
+	// Player of the match (0 when not awarded yet)
+	PlayerOfTheMatchID int `json:"player_of_the_match_id,omitempty"`
+
 	// Momentum/xG data (if available)
 	HomeXG *float64 `json:"home_xg,omitempty"` // Expected goals for home team
 	AwayXG *float64 `json:"away_xg,omitempty"` // Expected goals for away team
+	Shots  []Shot   `json:"shots,omitempty"`   // Every shot with its xG, in match order
+
+	// Attacking momentum through the match, in minute order
+	Momentum []MomentumPoint `json:"momentum,omitempty"`
+
+	// TV stations showing the match in the country setting (set by the app)
+	Broadcasts []string `json:"broadcasts,omitempty"`
 
 	// Highlight video (if available)
 	Highlight *MatchHighlight `json:"highlight,omitempty"` // Official highlight video link
+
+	// Previous meetings between the two teams, newest first
+	HeadToHead []PastMeeting `json:"head_to_head,omitempty"`
+
+	// Group-stage table of tournament matches, adjusted with live scores (set by the app)
+	Group *GroupTable `json:"group,omitempty"`
+
+	// What each result settles for both teams' qualification (set by the app)
+	Scenarios []QualificationScenario `json:"scenarios,omitempty"`
+}
+
+// LineupsConfirmed reports whether the announced line-ups are known.
+func (d MatchDetails) LineupsConfirmed() bool {
+	return (d.HomeLineup != nil || d.AwayLineup != nil) && !d.LineupsPredicted
+}
+
+// VenueText returns the stadium with its city when known, e.g. "Emirates Stadium, London".
+func (d MatchDetails) VenueText() string {
+	if d.Venue != "" && d.VenueCity != "" {
+		return d.Venue + ", " + d.VenueCity
+	}
+	return d.Venue
+}
+
+// MatchOutcome is a match result from the home team's point of view.
+type MatchOutcome int
+
+const (
+	OutcomeHomeWin MatchOutcome = iota
+	OutcomeDraw
+	OutcomeAwayWin
+)
+
+// QualificationScenario is what one result of a match settles for one of its teams.
+type QualificationScenario struct {
+	Outcome MatchOutcome `json:"outcome"`
+	TeamID  int          `json:"team_id"`
+	Zone    string       `json:"zone,omitempty"` // Qualification zone secured, e.g. "Round of 16"
+	Out     bool         `json:"out,omitempty"`  // Every qualification zone is out of reach
+}
+
+// Shot is a shot attempt with its expected goals (xG) value.
+type Shot struct {
+	Minute  int     `json:"minute"`
+	TeamID  int     `json:"team_id"`
+	Player  string  `json:"player,omitempty"`
+	XG      float64 `json:"xg"`
+	Outcome string  `json:"outcome"` // "goal", "saved", "missed", "blocked" or "post"
+	X       float64 `json:"x"`       // Distance along the pitch (0-105 m), towards the goal attacked
+	Y       float64 `json:"y"`       // Distance across the pitch (0-68 m)
+}
+
+// ShotsXG sums the xG of a team's shots.
+func ShotsXG(shots []Shot, teamID int) float64 {
+	var xg float64
+	for _, s := range shots {
+		if s.TeamID == teamID {
+			xg += s.XG
+		}
+	}
+	return xg
+}
+
+// MomentumPoint is the attacking pressure at a point of the match, from -100
+// (away team dominating) to 100 (home team dominating).
+type MomentumPoint struct {
+	Minute float64 `json:"minute"`
+	Value  float64 `json:"value"`
+}
+
+// PastMeeting is a previous match between the same two teams.
+type PastMeeting struct {
+	Date      time.Time `json:"date"`
+	League    string    `json:"league,omitempty"`
+	HomeTeam  Team      `json:"home_team"`
+	AwayTeam  Team      `json:"away_team"`
+	HomeScore int       `json:"home_score"`
+	AwayScore int       `json:"away_score"`
+}
+
+// TeamPage is a team's overview: league position, recent form, upcoming fixtures and squad.
+type TeamPage struct {
+	Team     Team         `json:"team"`
+	League   string       `json:"league,omitempty"`   // League of Position
+	Position int          `json:"position,omitempty"` // 0 when the team has no table position
+	Form     []FormResult `json:"form,omitempty"`     // Oldest first
+	Fixtures []Match      `json:"fixtures,omitempty"` // Not yet finished, soonest first
+	Squad    []SquadGroup `json:"squad,omitempty"`
+}
+
+// FormResult is one of a team's recent results.
+type FormResult struct {
+	Result   string `json:"result"` // "W", "D" or "L"
+	Score    string `json:"score"`  // Home team first, e.g. "2-1"
+	Opponent string `json:"opponent"`
+	Home     bool   `json:"home"`
+}
+
+// SquadGroup is a section of a squad, e.g. "Goalkeepers" or "Coach".
+type SquadGroup struct {
+	Title   string        `json:"title"`
+	Members []SquadMember `json:"members"`
+}
+
+// SquadMember is a player or coach of a squad.
+type SquadMember struct {
+	ID      int    `json:"id"`
+	Name    string `json:"name"`
+	Number  int    `json:"number,omitempty"` // Shirt number, 0 when unknown
+	Country string `json:"country,omitempty"`
+	Age     int    `json:"age,omitempty"`
+}
+
+// PlayerPage is a player's profile: position, age, season stats, recent match ratings
+// and injury status.
+type PlayerPage struct {
+	ID       int           `json:"id"`
+	Name     string        `json:"name"`
+	Team     string        `json:"team,omitempty"`
+	Position string        `json:"position,omitempty"` // e.g. "Right Winger"
+	Age      int           `json:"age,omitempty"`
+	Country  string        `json:"country,omitempty"`
+	Season   string        `json:"season,omitempty"` // League and season of Stats, e.g. "Premier League 2025/2026"
+	Stats    []PlayerStat  `json:"stats,omitempty"`
+	Recent   []PlayerMatch `json:"recent,omitempty"` // Newest first
+	Injury   string        `json:"injury,omitempty"` // Empty when fit, e.g. "Hamstring injury"
+	Return   string        `json:"return,omitempty"` // Expected return from injury, e.g. "Late October"
+}
+
+// PlayerStat is a season statistic of a player, e.g. "Goals": "12".
+type PlayerStat struct {
+	Label string `json:"label"`
+	Value string `json:"value"`
+}
+
+// PlayerMatch is a recent match of a player with their rating.
+type PlayerMatch struct {
+	Date     time.Time `json:"date"`
+	Opponent string    `json:"opponent"`
+	Home     bool      `json:"home"`
+	Score    string    `json:"score,omitempty"`  // Home team first, e.g. "2-1"
+	Rating   string    `json:"rating,omitempty"` // e.g. "7.4"; empty when not rated
+	Minutes  int       `json:"minutes,omitempty"`
+	Goals    int       `json:"goals,omitempty"`
+	Assists  int       `json:"assists,omitempty"`
+}
+
+// CommentaryEntry is one line of minute-by-minute text commentary.
+type CommentaryEntry struct {
+	Minute string `json:"minute,omitempty"` // e.g. "45+2'"; empty before kick-off and at breaks
+	Type   string `json:"type,omitempty"`   // e.g. "goal", "card", "substitution"; empty for plain comments
+	Text   string `json:"text"`
 }
 
 // MatchHighlight represents an official highlight video for a match
@@ -132,6 +333,12 @@ type MatchHighlight struct {
 	Title  string `json:"title,omitempty"`  // Video title (optional)
 }
 
+// GroupTable is one table of a competition, e.g. a tournament group.
+type GroupTable struct {
+	Name    string             `json:"name,omitempty"` // e.g., "Group A"; empty for a league's only table
+	Entries []LeagueTableEntry `json:"entries"`
+}
+
 // LeagueTableEntry represents a team's position in the league table
 type LeagueTableEntry struct {
 	Position       int  `json:"position"`
@@ -144,4 +351,8 @@ type LeagueTableEntry struct {
 	GoalsAgainst   int  `json:"goals_against"`
 	GoalDifference int  `json:"goal_difference"`
 	Points         int  `json:"points"`
+
+	// Qualification/relegation zone of the position, e.g. "Champions League" or "Relegation"
+	Zone      string `json:"zone,omitempty"`
+	ZoneColor string `json:"zone_color,omitempty"` // Hex color of the zone, e.g. "#2AD572"
 }
@@ -0,0 +1,82 @@
+package app
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/0xjuanma/golazo/internal/api"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// Clutch time ("b" in the live view) polls the displayed live match every
+// BoostPollInterval, as in the closing stages, for BoostDuration, then reverts.
+const (
+	BoostPollInterval = ClosingPollInterval
+	BoostDuration     = 10 * time.Minute
+)
+
+// pollBoost is an active clutch time boost.
+type pollBoost struct {
+	matchID int
+	until   time.Time
+}
+
+// toggleBoost starts clutch time for the displayed live match, or ends it early.
+// The boosted poll runs right away, superseding the scheduled one.
+func (m *model) toggleBoost() tea.Cmd {
+	details := m.matchDetails
+	if details == nil || details.Status != api.MatchStatusLive {
+		return m.showToast("Clutch time is for live matches")
+	}
+
+	m.pollSeq++
+	if m.boostRemaining(details.ID) > 0 {
+		m.boost = nil
+		return tea.Batch(m.showToast("Clutch time off"), m.schedulePoll(details.ID))
+	}
+
+	m.boost = &pollBoost{matchID: details.ID, until: time.Now().Add(BoostDuration)}
+	m.polling = true
+	seq := m.pollSeq
+	poll := func() tea.Msg { return pollTickMsg{matchID: details.ID, seq: seq} }
+	return tea.Batch(m.showToast(fmt.Sprintf("Clutch time: updating every %ds for %dm", int(BoostPollInterval.Seconds()), int(BoostDuration.Minutes()))), poll)
+}
+
+// boostRemaining returns how long clutch time lasts for the match, or 0 when it isn't boosted.
+func (m model) boostRemaining(matchID int) time.Duration {
+	if m.boost == nil || m.boost.matchID != matchID {
+		return 0
+	}
+	if left := time.Until(m.boost.until); left > 0 {
+		return left
+	}
+	return 0
+}
+
+// pollInterval returns the interval between polls of the selected live match: BoostPollInterval
+// in clutch time, LineupPollInterval before kickoff, otherwise selectedPollInterval, falling back to BackgroundPollInterval while
+// FotMob is failing or rate limiting requests (throttled while saving power).
+func (m model) pollInterval(matchID int) time.Duration {
+	if m.fotmobClient != nil && m.fotmobClient.Strained() {
+		return throttle(BackgroundPollInterval, m.powerSaver)
+	}
+	if m.boostRemaining(matchID) > 0 {
+		return BoostPollInterval
+	}
+	if m.matchDetails != nil && m.matchDetails.ID == matchID && m.matchDetails.Status == api.MatchStatusNotStarted {
+		return throttle(LineupPollInterval, m.powerSaver)
+	}
+	interval := PollInterval
+	if m.matchDetails != nil && m.matchDetails.ID == matchID {
+		interval = selectedPollInterval(m.matchDetails.Match)
+	}
+	return throttle(interval, m.powerSaver)
+}
+
+// schedulePoll schedules the next poll of a live match after pollInterval.
+func (m model) schedulePoll(matchID int) tea.Cmd {
+	seq := m.pollSeq
+	return tea.Tick(m.pollInterval(matchID), func(time.Time) tea.Msg {
+		return pollTickMsg{matchID: matchID, seq: seq}
+	})
+}
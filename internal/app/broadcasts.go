@@ -0,0 +1,69 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/0xjuanma/golazo/internal/api"
+	"github.com/0xjuanma/golazo/internal/fotmob"
+	"github.com/0xjuanma/golazo/internal/trace"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// broadcastsMsg carries the TV stations showing a match in a country.
+type broadcastsMsg struct {
+	matchID  int
+	country  string
+	stations []string
+}
+
+// fetchBroadcasts fetches the TV stations showing a match in a country.
+func fetchBroadcasts(client *fotmob.Client, matchID int, country string) tea.Cmd {
+	return func() tea.Msg {
+		ctx, cancel := context.WithTimeout(trace.Start(requestsCtx, fmt.Sprintf("fetch TV listings %d", matchID)), 10*time.Second)
+		defer cancel()
+
+		stations, err := client.Broadcasts(ctx, matchID, country)
+		if err != nil {
+			stations = []string{} // Not retried this session
+		}
+		return broadcastsMsg{matchID: matchID, country: country, stations: stations}
+	}
+}
+
+// watchBroadcasts fetches the TV stations of an upcoming or live match once per session,
+// when the country setting is set.
+func (m model) watchBroadcasts(details *api.MatchDetails) tea.Cmd {
+	if m.useMockData || m.fotmobClient == nil || m.settings == nil || m.settings.Country == "" {
+		return nil
+	}
+	if details.Status != api.MatchStatusNotStarted && details.Status != api.MatchStatusLive {
+		return nil
+	}
+	if _, requested := m.broadcasts[details.ID]; requested {
+		return nil
+	}
+	m.broadcasts[details.ID] = nil
+	return fetchBroadcasts(m.fotmobClient, details.ID, m.settings.Country)
+}
+
+// handleBroadcasts stores a match's TV stations, unless the country changed meanwhile.
+func (m model) handleBroadcasts(msg broadcastsMsg) (tea.Model, tea.Cmd) {
+	if m.settings == nil || msg.country != m.settings.Country {
+		return m, nil
+	}
+	m.broadcasts[msg.matchID] = msg.stations
+	return m, nil
+}
+
+// withBroadcasts returns details with the TV stations showing the match attached, or
+// details unchanged when none are known.
+func (m model) withBroadcasts(details *api.MatchDetails) *api.MatchDetails {
+	if details == nil || len(m.broadcasts[details.ID]) == 0 {
+		return details
+	}
+	withStations := *details
+	withStations.Broadcasts = m.broadcasts[details.ID]
+	return &withStations
+}
@@ -0,0 +1,553 @@
+package app
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/0xjuanma/golazo/internal/api"
+	"github.com/0xjuanma/golazo/internal/data"
+	"github.com/0xjuanma/golazo/internal/ui"
+	"github.com/charmbracelet/bubbles/cursor"
+	"github.com/charmbracelet/bubbles/list"
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// commandSpec is a ":" command. Key actions press their key, so every action of the
+// current view can be typed (and scripted) by name.
+type commandSpec struct {
+	name  string
+	args  string // Argument usage, e.g. "<match-id>"
+	key   string // Key a key action presses
+	views []view // Views a key action applies to; any view when empty
+	focus bool   // Focus the finished view's details panel first (its dialog keys need it)
+}
+
+// commandSpecs are the ":" commands in completion order.
+var commandSpecs = []commandSpec{
+	{name: "watch", args: "<match-id>"},
+	{name: "league", args: "add|remove <league>"},
+	{name: "mute", args: "<league>"},
+	{name: "unmute", args: "<league>"},
+	{name: "links"},
+	{name: "export", args: "md"},
+	{name: "react", args: "<emoji>"},
+	{name: "offset", args: "<duration>"},
+	{name: "live"},
+	{name: "finished"},
+	{name: "settings"},
+	{name: "refresh", key: "r"},
+	{name: "standings", key: "s", views: []view{viewLiveMatches, viewStats}},
+	{name: "table", key: "t", views: []view{viewLiveMatches}},
+	{name: "goals", key: "a", views: []view{viewLiveMatches}},
+	{name: "commentary", key: "c", views: []view{viewLiveMatches}},
+	{name: "boost", key: "b", views: []view{viewLiveMatches}},
+	{name: "pause", key: "p", views: []view{viewLiveMatches}},
+	{name: "replay", key: "p", views: []view{viewStats}},
+	{name: "open", key: "o", views: []view{viewLiveMatches, viewStats}, focus: true},
+	{name: "copy", key: "y", views: []view{viewLiveMatches, viewStats}, focus: true},
+	{name: "mirror", key: "m", views: []view{viewLiveMatches, viewStats}, focus: true},
+	{name: "density", key: "z", views: []view{viewLiveMatches, viewStats}},
+	{name: "formations", key: "f", views: []view{viewStats}, focus: true},
+	{name: "shotmap", key: "a", views: []view{viewStats}, focus: true},
+	{name: "ratings", key: "t", views: []view{viewStats}, focus: true},
+	{name: "statistics", key: "x", views: []view{viewStats}, focus: true},
+	{name: "focus", key: "tab", views: []view{viewStats}},
+	{name: "freeze", key: "ctrl+f"},
+	{name: "dump", key: "ctrl+e", views: []view{viewLiveMatches, viewStats}},
+	{name: "debug", key: "ctrl+g", views: []view{viewLiveMatches, viewStats}},
+	{name: "overlay", key: "ctrl+d"},
+	{name: "back", key: "esc"},
+	{name: "quit", key: "q"},
+}
+
+// matchExportMsg reports a Markdown match export (":export md").
+type matchExportMsg struct {
+	path string
+	err  error
+}
+
+// openCommandLine opens the ":" prompt. The history is loaded on first use.
+func (m model) openCommandLine() (tea.Model, tea.Cmd) {
+	input := textinput.New()
+	input.Prompt = ":"
+	input.Width = max(m.width-2, 10)
+	input.Cursor.SetMode(cursor.CursorStatic)
+	input.Focus()
+	m.commandInput = &input
+
+	if m.commandHistory == nil {
+		m.commandHistory, _ = data.LoadCommandHistory()
+	}
+	m.commandHistoryPos = len(m.commandHistory)
+	return m, nil
+}
+
+// typingFilter reports whether the current view's list is taking filter input.
+func (m model) typingFilter() bool {
+	switch m.currentView {
+	case viewLiveMatches:
+		return m.liveMatchesList.FilterState() == list.Filtering
+	case viewStats:
+		return m.statsMatchesList.FilterState() == list.Filtering
+	case viewSettings:
+		return m.settingsState != nil && m.settingsState.List.FilterState() == list.Filtering
+	}
+	return false
+}
+
+// handleCommandKeys edits the ":" prompt: Enter runs the command, Tab completes it,
+// ↑/↓ browse the history and Esc (or Backspace on an empty line) closes the prompt.
+func (m model) handleCommandKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	input := m.commandInput
+	switch msg.String() {
+	case "esc", "ctrl+c":
+		m.commandInput = nil
+		return m, nil
+	case "backspace":
+		if input.Value() == "" {
+			m.commandInput = nil
+			return m, nil
+		}
+	case "enter":
+		line := strings.TrimSpace(input.Value())
+		m.commandInput = nil
+		if line == "" {
+			return m, nil
+		}
+		m.recordCommand(line)
+		return m.runCommand(line)
+	case "tab":
+		completed, candidates := m.completeCommand(input.Value())
+		input.SetValue(completed)
+		input.CursorEnd()
+		if len(candidates) > 1 {
+			return m, m.showToast(strings.Join(candidates, "  "))
+		}
+		return m, nil
+	case "up":
+		if m.commandHistoryPos > 0 {
+			m.commandHistoryPos--
+			input.SetValue(m.commandHistory[m.commandHistoryPos])
+			input.CursorEnd()
+		}
+		return m, nil
+	case "down":
+		if m.commandHistoryPos < len(m.commandHistory) {
+			m.commandHistoryPos++
+			line := ""
+			if m.commandHistoryPos < len(m.commandHistory) {
+				line = m.commandHistory[m.commandHistoryPos]
+			}
+			input.SetValue(line)
+			input.CursorEnd()
+		}
+		return m, nil
+	}
+
+	updated, cmd := input.Update(msg)
+	*input = updated
+	return m, cmd
+}
+
+// recordCommand appends a command to the history, unless it repeats the last one, and
+// saves the history (best-effort).
+func (m *model) recordCommand(line string) {
+	if n := len(m.commandHistory); n > 0 && m.commandHistory[n-1] == line {
+		return
+	}
+	m.commandHistory = append(m.commandHistory, line)
+	if len(m.commandHistory) > data.CommandHistoryLimit {
+		m.commandHistory = m.commandHistory[len(m.commandHistory)-data.CommandHistoryLimit:]
+	}
+	history := slices.Clone(m.commandHistory)
+	go func() {
+		_ = data.SaveCommandHistory(history)
+	}()
+}
+
+// runCommand runs a ":" command line. Errors are shown in a toast.
+func (m model) runCommand(line string) (tea.Model, tea.Cmd) {
+	updated, cmd, err := m.execCommand(line)
+	if err != nil {
+		return m, m.showToast(err.Error())
+	}
+	return updated, cmd
+}
+
+// execCommand runs a command line, the dispatcher behind ":" and macros. Invalid
+// commands return an error and leave the model unchanged.
+func (m model) execCommand(line string) (tea.Model, tea.Cmd, error) {
+	fields := splitCommandLine(line)
+	if len(fields) == 0 {
+		return m, nil, nil
+	}
+	name, args := fields[0], fields[1:]
+	spec, ok := findCommand(name)
+	if !ok {
+		return m, nil, fmt.Errorf("unknown command: %s (Tab lists commands)", name)
+	}
+
+	if spec.key != "" {
+		if len(spec.views) > 0 && !slices.Contains(spec.views, m.currentView) {
+			return m, nil, fmt.Errorf("%s: not available in this view", spec.name)
+		}
+		if spec.focus && m.currentView == viewStats && m.matchDetails != nil {
+			m.statsRightPanelFocused = true
+		}
+		updated, cmd := m.handleKeyPress(commandKey(spec.key))
+		return updated, cmd, nil
+	}
+
+	var err error
+	switch spec.name {
+	case "watch":
+		if len(args) != 1 {
+			break
+		}
+		return m.watchMatch(args[0])
+	case "league":
+		if len(args) < 2 || (args[0] != "add" && args[0] != "remove") {
+			break
+		}
+		var message string
+		if message, err = m.changeLeague(args[0] == "add", strings.Join(args[1:], " ")); err == nil {
+			return m, m.showToast(message), nil
+		}
+	case "mute", "unmute":
+		if len(args) == 0 {
+			break
+		}
+		var message string
+		if message, err = m.muteLeague(spec.name == "mute", strings.Join(args, " ")); err == nil {
+			return m, m.showToast(message), nil
+		}
+	case "links":
+		if len(args) != 0 {
+			break
+		}
+		switch {
+		case m.matchDetails == nil:
+			err = fmt.Errorf("no match selected")
+		case m.redditClient == nil:
+			err = fmt.Errorf("goal links are off")
+		default:
+			return m, tea.Batch(m.showToast("Fetching goal links..."), fetchGoalLinks(m.redditClient, m.matchDetails)), nil
+		}
+	case "export":
+		if len(args) != 1 {
+			break
+		}
+		if args[0] != "md" {
+			err = fmt.Errorf("unknown format %q (expected md)", args[0])
+			break
+		}
+		if m.matchDetails == nil {
+			err = fmt.Errorf("no match selected")
+			break
+		}
+		return m, exportMatchMarkdown(m.matchDetails), nil
+	case "react":
+		if len(args) != 1 {
+			break
+		}
+		var cmd tea.Cmd
+		if cmd, err = m.reactCommand(args[0]); err == nil {
+			return m, cmd, nil
+		}
+	case "offset":
+		if len(args) != 1 {
+			break
+		}
+		var updated model
+		var cmd tea.Cmd
+		if updated, cmd, err = m.offsetCommand(args[0]); err == nil {
+			return updated, cmd, nil
+		}
+	case "live", "finished", "settings":
+		if len(args) != 0 {
+			break
+		}
+		updated, cmd := m.openMenuItem(map[string]int{"finished": 0, "live": 1, "settings": 2}[spec.name])
+		return updated, cmd, nil
+	}
+	if err == nil {
+		return m, nil, fmt.Errorf("usage: %s", strings.TrimSpace(spec.name+" "+spec.args))
+	}
+	return m, nil, fmt.Errorf("%s: %w", spec.name, err)
+}
+
+// findCommand returns the command with the given name.
+func findCommand(name string) (commandSpec, bool) {
+	for _, spec := range commandSpecs {
+		if spec.name == name {
+			return spec, true
+		}
+	}
+	return commandSpec{}, false
+}
+
+// commandKey returns the key press of a key action.
+func commandKey(key string) tea.KeyMsg {
+	switch key {
+	case "esc":
+		return tea.KeyMsg{Type: tea.KeyEsc}
+	case "tab":
+		return tea.KeyMsg{Type: tea.KeyTab}
+	case "ctrl+d":
+		return tea.KeyMsg{Type: tea.KeyCtrlD}
+	case "ctrl+e":
+		return tea.KeyMsg{Type: tea.KeyCtrlE}
+	case "ctrl+f":
+		return tea.KeyMsg{Type: tea.KeyCtrlF}
+	case "ctrl+g":
+		return tea.KeyMsg{Type: tea.KeyCtrlG}
+	}
+	return tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(key)}
+}
+
+// splitCommandLine splits a command line into words. Double quotes group words, e.g.
+// league add "Serie A".
+func splitCommandLine(line string) []string {
+	var fields []string
+	var field strings.Builder
+	quoted, inField := false, false
+	for _, r := range line {
+		switch {
+		case r == '"':
+			quoted = !quoted
+			inField = true
+		case r == ' ' && !quoted:
+			if inField {
+				fields = append(fields, field.String())
+				field.Reset()
+			}
+			inField = false
+		default:
+			field.WriteRune(r)
+			inField = true
+		}
+	}
+	if inField {
+		fields = append(fields, field.String())
+	}
+	return fields
+}
+
+// openMenuItem opens a main menu item from any view, as if it was picked from the menu.
+func (m model) openMenuItem(index int) (tea.Model, tea.Cmd) {
+	reset, _ := m.resetToMainView()
+	m = reset.(model)
+	m.selected = index
+	return m.handleMainViewKeys(tea.KeyMsg{Type: tea.KeyEnter})
+}
+
+// watchMatch shows a match by FotMob ID in the live or finished view, selecting it in
+// the list when it is there. Matches not in the list are loaded all the same.
+func (m model) watchMatch(arg string) (tea.Model, tea.Cmd, error) {
+	matchID, err := strconv.Atoi(arg)
+	if err != nil || matchID <= 0 {
+		return m, nil, fmt.Errorf("watch: invalid match ID %q", arg)
+	}
+	if m.currentView == viewGoalFeed {
+		m.currentView = viewLiveMatches
+	}
+
+	switch m.currentView {
+	case viewLiveMatches:
+		selectListMatch(&m.liveMatchesList, matchID)
+		for i, match := range m.matches {
+			if match.ID == matchID {
+				m.selected = i
+				break
+			}
+		}
+		updated, cmd := m.loadMatchDetails(matchID)
+		return updated, tea.Batch(cmd, m.fetchSelectedLeagueTable()), nil
+	case viewStats:
+		selectListMatch(&m.statsMatchesList, matchID)
+		updated, cmd := m.loadStatsMatchDetails(matchID)
+		return updated, cmd, nil
+	}
+	return m, nil, fmt.Errorf("watch: open the live or finished view first")
+}
+
+// selectListMatch moves a match list's selection to a match, if it is listed.
+func selectListMatch(matches *list.Model, matchID int) {
+	for i, item := range matches.Items() {
+		if listItem, ok := item.(ui.MatchListItem); ok && listItem.Match.ID == matchID {
+			matches.Select(i)
+			return
+		}
+	}
+}
+
+// changeLeague adds a league to or removes it from the followed leagues. Following
+// none means following the default leagues, so the first change starts from those.
+func (m *model) changeLeague(add bool, name string) (string, error) {
+	league, ok := data.CurrentCatalogue().LeagueByName(name)
+	if !ok {
+		return "", fmt.Errorf("unknown league %q", name)
+	}
+
+	var message string
+	err := m.updateSettings(func(settings *data.Settings) error {
+		selected := settings.SelectedLeagues
+		if len(selected) == 0 {
+			selected = slices.Clone(data.DefaultLeagueIDs)
+		}
+		switch {
+		case add && slices.Contains(selected, league.ID):
+			return fmt.Errorf("%s is already followed", league.Name)
+		case add:
+			selected = append(selected, league.ID)
+			message = league.Name + " added to your leagues"
+		case !slices.Contains(selected, league.ID):
+			return fmt.Errorf("%s is not followed", league.Name)
+		case len(selected) == 1:
+			return fmt.Errorf("%s is the only league followed", league.Name)
+		default:
+			selected = slices.DeleteFunc(selected, func(id int) bool { return id == league.ID })
+			message = league.Name + " removed from your leagues"
+		}
+		settings.SelectedLeagues = selected
+		return nil
+	})
+	return message, err
+}
+
+// muteLeague mutes or unmutes goal notifications of a league.
+func (m *model) muteLeague(mute bool, name string) (string, error) {
+	league, ok := data.CurrentCatalogue().LeagueByName(name)
+	if !ok {
+		return "", fmt.Errorf("unknown league %q", name)
+	}
+
+	err := m.updateSettings(func(settings *data.Settings) error {
+		muted := settings.IsLeagueMuted(league.ID)
+		switch {
+		case mute && muted:
+			return fmt.Errorf("%s is already muted", league.Name)
+		case mute:
+			settings.MutedLeagues = append(settings.MutedLeagues, league.ID)
+		case !muted:
+			return fmt.Errorf("%s is not muted", league.Name)
+		default:
+			settings.MutedLeagues = slices.DeleteFunc(settings.MutedLeagues, func(id int) bool { return id == league.ID })
+		}
+		return nil
+	})
+	if err != nil {
+		return "", err
+	}
+	if mute {
+		return league.Name + " muted: no goal notifications", nil
+	}
+	return league.Name + " unmuted", nil
+}
+
+// updateSettings changes settings.yaml and applies the result. The new modification
+// time is recorded so the settings reload doesn't report the change again.
+func (m *model) updateSettings(change func(*data.Settings) error) error {
+	settings, err := data.ReadSettings()
+	if err != nil {
+		return err
+	}
+	if err := change(settings); err != nil {
+		return err
+	}
+	if err := data.SaveSettings(settings); err != nil {
+		return err
+	}
+	m.settings = settings
+	m.settingsModTime = data.SettingsModTime()
+	return nil
+}
+
+// exportMatchMarkdown writes a Markdown summary of a match to the exports directory.
+func exportMatchMarkdown(details *api.MatchDetails) tea.Cmd {
+	return func() tea.Msg {
+		path, err := data.SaveMatchMarkdown(details)
+		return matchExportMsg{path: path, err: err}
+	}
+}
+
+// completeCommand completes the word at the end of a command line: the command name,
+// then its arguments. Returns the completed line and, when several completions remain,
+// the candidates.
+func (m model) completeCommand(line string) (string, []string) {
+	line = strings.TrimLeft(line, " ")
+	name, rest, hasArgs := strings.Cut(line, " ")
+	if !hasArgs {
+		names := make([]string, len(commandSpecs))
+		for i, spec := range commandSpecs {
+			names[i] = spec.name
+		}
+		return completeWord("", name, names, " ")
+	}
+
+	rest = strings.TrimLeft(rest, " ")
+	switch name {
+	case "watch":
+		var ids []string
+		for _, match := range m.matches {
+			ids = append(ids, strconv.Itoa(match.ID))
+		}
+		return completeWord(name+" ", rest, ids, "")
+	case "league":
+		action, league, hasLeague := strings.Cut(rest, " ")
+		if !hasLeague {
+			return completeWord(name+" ", action, []string{"add", "remove"}, " ")
+		}
+		return completeWord(name+" "+action+" ", strings.TrimLeft(league, " "), leagueNames(), "")
+	case "mute", "unmute":
+		return completeWord(name+" ", rest, leagueNames(), "")
+	case "export":
+		return completeWord(name+" ", rest, []string{"md"}, "")
+	}
+	return line, nil
+}
+
+// completeWord completes word to the options it is a prefix of (case-insensitive):
+// fully when one matches, adding suffix, else up to their common prefix.
+func completeWord(head, word string, options []string, suffix string) (string, []string) {
+	var matches []string
+	for _, option := range options {
+		if strings.HasPrefix(strings.ToLower(option), strings.ToLower(word)) && !slices.Contains(matches, option) {
+			matches = append(matches, option)
+		}
+	}
+	switch len(matches) {
+	case 0:
+		return head + word, nil
+	case 1:
+		return head + matches[0] + suffix, matches
+	}
+
+	first, lower := []rune(matches[0]), []rune(strings.ToLower(matches[0]))
+	n := min(len(first), len(lower))
+	for _, match := range matches[1:] {
+		runes := []rune(strings.ToLower(match))
+		i := 0
+		for i < n && i < len(runes) && lower[i] == runes[i] {
+			i++
+		}
+		n = i
+	}
+	if len([]rune(word)) > n {
+		return head + word, matches
+	}
+	return head + string(first[:n]), matches
+}
+
+// leagueNames returns the names of the catalogue's leagues.
+func leagueNames() []string {
+	catalogue := data.CurrentCatalogue()
+	names := make([]string, 0, len(catalogue.Leagues))
+	for _, league := range catalogue.Leagues {
+		names = append(names, league.Name)
+	}
+	return names
+}
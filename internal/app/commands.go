@@ -2,17 +2,21 @@ package app
 
 import (
 	"context"
+	"fmt"
 	"sync"
 	"time"
 
 	"github.com/0xjuanma/golazo/internal/api"
 	"github.com/0xjuanma/golazo/internal/data"
 	"github.com/0xjuanma/golazo/internal/fotmob"
+	"github.com/0xjuanma/golazo/internal/notify"
 	"github.com/0xjuanma/golazo/internal/reddit"
+	"github.com/0xjuanma/golazo/internal/trace"
 	tea "github.com/charmbracelet/bubbletea"
 )
 
-// LiveRefreshInterval is the interval between automatic live matches list refreshes.
+// LiveRefreshInterval is the interval between automatic live matches list refreshes
+// while matches are live (see liveRefreshDelay for the quieter periods).
 const LiveRefreshInterval = 5 * time.Minute
 
 // LiveBatchSize is the number of leagues to fetch concurrently in each batch.
@@ -31,7 +35,7 @@ func fetchLiveBatchData(client *fotmob.Client, useMockData bool, batchIndex int)
 
 		if useMockData {
 			// Return mock data only on first batch
-			if batchIndex == 0 {
+			if batchIndex == 0 && fotmob.MockRequest(requestsCtx) == nil {
 				return liveBatchDataMsg{
 					batchIndex: batchIndex,
 					isLast:     isLast,
@@ -53,6 +57,10 @@ func fetchLiveBatchData(client *fotmob.Client, useMockData bool, batchIndex int)
 			}
 		}
 
+		// One action for the whole batch so its league requests share a correlation ID
+		action := trace.Start(requestsCtx, fmt.Sprintf("load live batch %d", batchIndex))
+		awaitStartupTurn(action, priorityLiveList)
+
 		// Fetch all leagues in this batch concurrently
 		var wg sync.WaitGroup
 		var mu sync.Mutex
@@ -64,7 +72,7 @@ func fetchLiveBatchData(client *fotmob.Client, useMockData bool, batchIndex int)
 				defer wg.Done()
 
 				leagueID := fotmob.LeagueIDAtIndex(leagueIdx)
-				ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+				ctx, cancel := context.WithTimeout(action, 10*time.Second)
 				defer cancel()
 
 				matches, err := client.LiveMatchesForLeague(ctx, leagueID)
@@ -88,11 +96,14 @@ func fetchLiveBatchData(client *fotmob.Client, useMockData bool, batchIndex int)
 	}
 }
 
-// scheduleLiveRefresh schedules the next live matches refresh after 5 minutes.
+// scheduleLiveRefresh schedules the next live matches refresh after delay.
 // This is used to keep the live matches list current while the user is in the view.
-func scheduleLiveRefresh(client *fotmob.Client, useMockData bool) tea.Cmd {
-	return tea.Tick(LiveRefreshInterval, func(t time.Time) tea.Msg {
+func scheduleLiveRefresh(client *fotmob.Client, useMockData bool, delay time.Duration) tea.Cmd {
+	return tea.Tick(delay, func(t time.Time) tea.Msg {
 		if useMockData {
+			if fotmob.MockRequest(requestsCtx) != nil {
+				return liveRefreshMsg{matches: nil}
+			}
 			return liveRefreshMsg{matches: data.MockLiveMatches()}
 		}
 
@@ -100,7 +111,7 @@ func scheduleLiveRefresh(client *fotmob.Client, useMockData bool) tea.Cmd {
 			return liveRefreshMsg{matches: nil}
 		}
 
-		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		ctx, cancel := context.WithTimeout(trace.Start(requestsCtx, "refresh live list"), 10*time.Second)
 		defer cancel()
 
 		// Force refresh to bypass cache
@@ -114,51 +125,75 @@ func scheduleLiveRefresh(client *fotmob.Client, useMockData bool) tea.Cmd {
 }
 
 // fetchMatchDetails fetches match details from the API.
-// Returns mock data if useMockData is true, otherwise uses real API.
-func fetchMatchDetails(client *fotmob.Client, matchID int, useMockData bool) tea.Cmd {
+// Returns mock data if useMockData is true, otherwise uses real API. parent is the
+// selection's context (see newSelection), cancelled when another match is selected.
+func fetchMatchDetails(parent context.Context, client *fotmob.Client, matchID int, useMockData bool) tea.Cmd {
 	return func() tea.Msg {
 		if useMockData {
+			if fotmob.MockRequest(parent) != nil {
+				return matchDetailsMsg{matchID: matchID, details: nil}
+			}
 			details, _ := data.MockMatchDetails(matchID)
-			return matchDetailsMsg{details: details}
+			return matchDetailsMsg{matchID: matchID, details: details}
 		}
 
-		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		ctx, cancel := context.WithTimeout(trace.Start(parent, fmt.Sprintf("select match %d", matchID)), 10*time.Second)
 		defer cancel()
+		awaitStartupTurn(ctx, prioritySelectedDetails)
 
 		details, err := client.MatchDetails(ctx, matchID)
 		if err != nil {
-			return matchDetailsMsg{details: nil}
+			return matchDetailsMsg{matchID: matchID, details: nil}
 		}
 
-		return matchDetailsMsg{details: details}
+		return matchDetailsMsg{matchID: matchID, details: details}
+	}
+}
+
+// withStaleDetails shows the expired cached details of a match right away while fetch
+// gets fresh ones (stale-while-revalidate), so selecting a match doesn't wait on a
+// slow network. Returns fetch alone when nothing is cached.
+func withStaleDetails(client *fotmob.Client, matchID int, fetch tea.Cmd) tea.Cmd {
+	if client == nil {
+		return fetch
 	}
+	stale := client.StaleMatchDetails(matchID)
+	if stale == nil {
+		return fetch
+	}
+	return tea.Sequence(func() tea.Msg {
+		return matchDetailsMsg{matchID: matchID, details: stale, stale: true}
+	}, fetch)
 }
 
 // fetchMatchDetailsForceRefresh fetches match details with cache bypass.
-// Forces fresh data from the API, ignoring any cached data.
-func fetchMatchDetailsForceRefresh(client *fotmob.Client, matchID int, useMockData bool) tea.Cmd {
+// Forces fresh data from the API, ignoring any cached data. parent as for fetchMatchDetails.
+func fetchMatchDetailsForceRefresh(parent context.Context, client *fotmob.Client, matchID int, useMockData bool) tea.Cmd {
 	return func() tea.Msg {
 		if useMockData {
+			if fotmob.MockRequest(parent) != nil {
+				return matchDetailsMsg{matchID: matchID, details: nil}
+			}
 			details, _ := data.MockMatchDetails(matchID)
-			return matchDetailsMsg{details: details}
+			return matchDetailsMsg{matchID: matchID, details: details}
 		}
 
-		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		ctx, cancel := context.WithTimeout(trace.Start(parent, fmt.Sprintf("refresh match %d", matchID)), 10*time.Second)
 		defer cancel()
 
 		details, err := client.MatchDetailsForceRefresh(ctx, matchID)
 		if err != nil {
-			return matchDetailsMsg{details: nil}
+			return matchDetailsMsg{matchID: matchID, details: nil}
 		}
 
-		return matchDetailsMsg{details: details}
+		return matchDetailsMsg{matchID: matchID, details: details}
 	}
 }
 
-// schedulePollTick schedules the next poll after 90 seconds.
+// schedulePollTick schedules the next poll after interval (see selectedPollInterval).
 // When the tick fires, it sends pollTickMsg which triggers the actual API call.
-func schedulePollTick(matchID int) tea.Cmd {
-	return tea.Tick(90*time.Second, func(t time.Time) tea.Msg {
+func schedulePollTick(matchID int, interval time.Duration) tea.Cmd {
+	return tea.Tick(interval, func(t time.Time) tea.Msg {
 		return pollTickMsg{matchID: matchID}
 	})
 }
@@ -176,23 +211,27 @@ func schedulePollSpinnerHide() tea.Cmd {
 // fetchPollMatchDetails fetches match details for a poll refresh.
 // This is called when pollTickMsg is received, with loading state visible.
 // Uses force refresh to bypass cache and ensure fresh data for live matches.
-func fetchPollMatchDetails(client *fotmob.Client, matchID int, useMockData bool) tea.Cmd {
+// parent as for fetchMatchDetails.
+func fetchPollMatchDetails(parent context.Context, client *fotmob.Client, matchID int, useMockData bool) tea.Cmd {
 	return func() tea.Msg {
 		if useMockData {
+			if fotmob.MockRequest(parent) != nil {
+				return matchDetailsMsg{matchID: matchID, details: nil}
+			}
 			details, _ := data.MockMatchDetails(matchID)
-			return matchDetailsMsg{details: details}
+			return matchDetailsMsg{matchID: matchID, details: details}
 		}
 
-		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		ctx, cancel := context.WithTimeout(trace.Start(parent, fmt.Sprintf("poll match %d", matchID)), 10*time.Second)
 		defer cancel()
 
 		// Force refresh to bypass cache - live matches need fresh data
 		details, err := client.MatchDetailsForceRefresh(ctx, matchID)
 		if err != nil {
-			return matchDetailsMsg{details: nil}
+			return matchDetailsMsg{matchID: matchID, details: nil}
 		}
 
-		return matchDetailsMsg{details: details}
+		return matchDetailsMsg{matchID: matchID, details: details}
 	}
 }
 
@@ -206,7 +245,7 @@ func fetchStatsDayData(client *fotmob.Client, useMockData bool, dayIndex int, to
 		isLast := dayIndex == totalDays-1
 
 		if useMockData {
-			if isToday {
+			if isToday && fotmob.MockRequest(requestsCtx) == nil {
 				return statsDayDataMsg{
 					dayIndex: dayIndex,
 					isToday:  true,
@@ -234,8 +273,9 @@ func fetchStatsDayData(client *fotmob.Client, useMockData bool, dayIndex int, to
 			}
 		}
 
-		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		ctx, cancel := context.WithTimeout(trace.Start(requestsCtx, fmt.Sprintf("load stats day %d", dayIndex)), 30*time.Second)
 		defer cancel()
+		awaitStartupTurn(ctx, priorityLeagueList)
 
 		// Calculate the date for this day
 		today := time.Now().UTC()
@@ -283,95 +323,129 @@ func fetchStatsDayData(client *fotmob.Client, useMockData bool, dayIndex int, to
 }
 
 // fetchStatsMatchDetailsFotmob fetches match details from FotMob API for stats view.
-func fetchStatsMatchDetailsFotmob(client *fotmob.Client, matchID int, useMockData bool) tea.Cmd {
+// parent as for fetchMatchDetails.
+func fetchStatsMatchDetailsFotmob(parent context.Context, client *fotmob.Client, matchID int, useMockData bool) tea.Cmd {
 	return func() tea.Msg {
 		if useMockData {
+			if fotmob.MockRequest(parent) != nil {
+				return matchDetailsMsg{matchID: matchID, details: nil}
+			}
 			details, _ := data.MockFinishedMatchDetails(matchID)
-			return matchDetailsMsg{details: details}
+			return matchDetailsMsg{matchID: matchID, details: details}
 		}
 
 		if client == nil {
-			return matchDetailsMsg{details: nil}
+			return matchDetailsMsg{matchID: matchID, details: nil}
 		}
 
-		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		ctx, cancel := context.WithTimeout(trace.Start(parent, fmt.Sprintf("select finished match %d", matchID)), 30*time.Second)
 		defer cancel()
+		awaitStartupTurn(ctx, prioritySelectedDetails)
 
 		details, err := client.MatchDetails(ctx, matchID)
 		if err != nil {
-			return matchDetailsMsg{details: nil}
+			return matchDetailsMsg{matchID: matchID, details: nil}
 		}
 
-		return matchDetailsMsg{details: details}
+		return matchDetailsMsg{matchID: matchID, details: details}
 	}
 }
 
-// fetchGoalLinks fetches goal replay links from Reddit for all goals in a match.
-// This is called on-demand when match details are loaded/displayed.
-// Links are cached persistently to avoid redundant API calls.
-func fetchGoalLinks(redditClient *reddit.Client, details *api.MatchDetails) tea.Cmd {
-	return func() tea.Msg {
-		if redditClient == nil || details == nil {
-			return goalLinksMsg{matchID: 0, links: nil}
-		}
+// ToastDuration is how long toast confirmations stay visible.
+const ToastDuration = 2 * time.Second
 
-		// Extract goal events from match details
-		var goals []reddit.GoalInfo
-		for _, event := range details.Events {
-			if event.Type != "goal" {
-				continue
-			}
+// Kickoff reminder timing for followed teams.
+const (
+	ReminderLead          = 10 * time.Minute // Remind this long before kickoff
+	ReminderSnooze        = 10 * time.Minute // "Snooze" delays the reminder by this much
+	HalfTimeCheckInterval = 2 * time.Minute  // Polling interval while waiting for HT
+	HalfTimeWatchLimit    = 3 * time.Hour    // Stop waiting for HT this long after kickoff
+	HalfTimeMaxFailures   = 5                // Stop waiting for HT after this many failed checks in a row
+)
 
-			// Debug log goal extraction (will be logged when redditClient.GoalLinks is called)
+// scheduleReminder fires a reminderDueMsg for match after delay.
+func scheduleReminder(match api.Match, delay time.Duration) tea.Cmd {
+	return tea.Tick(delay, func(t time.Time) tea.Msg {
+		return reminderDueMsg{match: match}
+	})
+}
 
-			scorer := ""
-			if event.Player != nil {
-				scorer = *event.Player
-			}
+// sendReminder shows a kickoff reminder and waits for the user's choice.
+// Backends without buttons return immediately with no action.
+func sendReminder(notifier *notify.DesktopNotifier, match api.Match) tea.Cmd {
+	return func() tea.Msg {
+		action, _ := notifier.Reminder(match)
+		return reminderActionMsg{match: match, action: action}
+	}
+}
 
-			// Determine if goal is for home team
-			isHome := event.Team.ID == details.HomeTeam.ID
+// scheduleHalfTimeCheck fires a halfTimeCheckMsg for watch after delay.
+func scheduleHalfTimeCheck(watch halfTimeWatch, delay time.Duration) tea.Cmd {
+	return tea.Tick(delay, func(t time.Time) tea.Msg {
+		return halfTimeCheckMsg{watch: watch}
+	})
+}
 
-			// Get scores at the time of goal (approximate)
-			homeScore := 0
-			awayScore := 0
-			if details.HomeScore != nil {
-				homeScore = *details.HomeScore
-			}
-			if details.AwayScore != nil {
-				awayScore = *details.AwayScore
-			}
+// fetchHalfTimeStatus fetches fresh details for a half-time watch.
+func fetchHalfTimeStatus(client *fotmob.Client, watch halfTimeWatch) tea.Cmd {
+	return func() tea.Msg {
+		ctx, cancel := context.WithTimeout(trace.Start(requestsCtx, fmt.Sprintf("half-time check %d", watch.matchID)), 10*time.Second)
+		defer cancel()
+		details, err := client.MatchDetailsForceRefresh(ctx, watch.matchID)
+		if err != nil {
+			return halfTimeStatusMsg{watch: watch}
+		}
+		return halfTimeStatusMsg{watch: watch, details: details}
+	}
+}
 
-			// Get match time for date-based Reddit search
-			matchTime := time.Now() // Default to now for live matches
-			if details.MatchTime != nil {
-				matchTime = *details.MatchTime
-			}
+// scheduleToastClear hides the toast with the given id after ToastDuration.
+func scheduleToastClear(id int) tea.Cmd {
+	return tea.Tick(ToastDuration, func(t time.Time) tea.Msg {
+		return toastClearMsg{id: id}
+	})
+}
 
-			goals = append(goals, reddit.GoalInfo{
-				MatchID:       details.ID,
-				HomeTeam:      details.HomeTeam.Name,
-				AwayTeam:      details.AwayTeam.Name,
-				HomeTeamShort: details.HomeTeam.ShortName,
-				AwayTeamShort: details.AwayTeam.ShortName,
-				ScorerName:    scorer,
-				Minute:        event.Minute,
-				DisplayMinute: event.DisplayMinute,
-				HomeScore:     homeScore,
-				AwayScore:     awayScore,
-				IsHomeTeam:    isHome,
-				MatchTime:     matchTime,
-			})
+// fetchGoalLinks fetches goal replay links from Reddit for all goals in a match.
+// This is called on-demand when match details are loaded/displayed.
+// Links are cached persistently to avoid redundant API calls.
+// Per-goal progress is streamed as goalLinkProgressMsg before the final goalLinksMsg.
+func fetchGoalLinks(redditClient *reddit.Client, details *api.MatchDetails) tea.Cmd {
+	return func() tea.Msg {
+		if redditClient == nil || details == nil {
+			return goalLinksMsg{matchID: 0, links: nil}
 		}
 
+		goals := reddit.GoalInfos(details)
 		if len(goals) == 0 {
 			return goalLinksMsg{matchID: details.ID, links: nil}
 		}
 
-		// Fetch links for all goals (uses cache internally)
-		links := redditClient.GoalLinks(goals)
+		// Fetch links in the background (uses cache internally), streaming progress.
+		// Buffered so workers never block on the UI reading messages.
+		ch := make(chan tea.Msg, len(goals)+1)
+		ctx := trace.Start(requestsCtx, fmt.Sprintf("fetch goal links %d", details.ID))
+		go func() {
+			defer close(ch)
+			awaitStartupTurn(ctx, priorityGoalLinks)
+			links := redditClient.GoalLinksWithProgress(ctx, goals, func(p reddit.GoalLinkProgress) {
+				ch <- goalLinkProgressMsg{matchID: details.ID, progress: p, ch: ch}
+			})
+			ch <- goalLinksMsg{matchID: details.ID, links: links}
+		}()
+
+		return waitForGoalLinks(ch)()
+	}
+}
 
-		return goalLinksMsg{matchID: details.ID, links: links}
+// waitForGoalLinks waits for the next message from an in-flight goal links fetch.
+func waitForGoalLinks(ch <-chan tea.Msg) tea.Cmd {
+	return func() tea.Msg {
+		msg, ok := <-ch
+		if !ok {
+			return nil
+		}
+		return msg
 	}
 }
 
@@ -385,8 +459,9 @@ func fetchStandings(client *fotmob.Client, leagueID int, leagueName string, pare
 			return standingsMsg{leagueID: leagueID, standings: nil}
 		}
 
-		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		ctx, cancel := context.WithTimeout(trace.Start(requestsCtx, fmt.Sprintf("load standings %d", leagueID)), 10*time.Second)
 		defer cancel()
+		awaitStartupTurn(ctx, priorityStandings)
 
 		standings, err := client.LeagueTableWithParent(ctx, leagueID, leagueName, parentLeagueID)
 		if err != nil {
@@ -402,3 +477,18 @@ func fetchStandings(client *fotmob.Client, leagueID int, leagueName string, pare
 		}
 	}
 }
+
+// exportRawResponse fetches the raw FotMob response for a match and saves it for bug reports.
+func exportRawResponse(client *fotmob.Client, matchID int) tea.Cmd {
+	return func() tea.Msg {
+		ctx, cancel := context.WithTimeout(trace.Start(requestsCtx, fmt.Sprintf("export raw match %d", matchID)), 15*time.Second)
+		defer cancel()
+
+		body, err := client.RawMatchDetails(ctx, matchID)
+		if err != nil {
+			return rawResponseMsg{err: err}
+		}
+		path, err := data.SaveRawResponse(matchID, body)
+		return rawResponseMsg{path: path, err: err}
+	}
+}
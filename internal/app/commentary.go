@@ -0,0 +1,96 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/0xjuanma/golazo/internal/api"
+	"github.com/0xjuanma/golazo/internal/fotmob"
+	"github.com/0xjuanma/golazo/internal/trace"
+	"github.com/0xjuanma/golazo/internal/ui"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// commentaryMsg carries the text commentary of a match (newest first).
+type commentaryMsg struct {
+	matchID int
+	entries []api.CommentaryEntry
+	err     error
+}
+
+// fetchCommentary fetches the text commentary of a match.
+func fetchCommentary(client *fotmob.Client, matchID int) tea.Cmd {
+	return func() tea.Msg {
+		ctx, cancel := context.WithTimeout(trace.Start(requestsCtx, fmt.Sprintf("fetch commentary %d", matchID)), 10*time.Second)
+		defer cancel()
+
+		entries, err := client.Commentary(ctx, matchID)
+		return commentaryMsg{matchID: matchID, entries: entries, err: err}
+	}
+}
+
+// toggleCommentary switches the live details panel between the updates feed and
+// the text commentary tab, fetching the commentary when the tab opens.
+func (m *model) toggleCommentary() tea.Cmd {
+	m.commentaryTab = !m.commentaryTab
+	m.commentaryMatchID = 0
+	if !m.commentaryTab || m.matchDetails == nil {
+		return nil
+	}
+	return m.watchCommentary(m.matchDetails)
+}
+
+// watchCommentary refreshes the commentary of the displayed match while the tab is open.
+// Called on every details load, so live commentary follows the polling loop.
+func (m *model) watchCommentary(details *api.MatchDetails) tea.Cmd {
+	if !m.commentaryTab || m.useMockData || m.fotmobClient == nil {
+		return nil
+	}
+	if m.commentaryMatchID != details.ID {
+		m.commentaryMatchID = details.ID
+		m.commentary = nil
+		m.commentaryOffset = 0
+		m.commentaryLoading = true
+	}
+	return fetchCommentary(m.fotmobClient, details.ID)
+}
+
+// handleCommentary stores fresh commentary. While scrolled back, the offset moves with
+// the new lines so the same entry stays at the top. On error the previous lines stay.
+func (m model) handleCommentary(msg commentaryMsg) (tea.Model, tea.Cmd) {
+	if !m.commentaryTab || msg.matchID != m.commentaryMatchID {
+		return m, nil
+	}
+	m.commentaryLoading = false
+	if msg.err != nil {
+		m.debugLog(fmt.Sprintf("Commentary fetch failed for match %d: %v", msg.matchID, msg.err))
+		return m, nil
+	}
+	if m.commentaryOffset > 0 {
+		m.commentaryOffset = max(m.commentaryOffset+len(msg.entries)-len(m.commentary), 0)
+	}
+	m.commentary = msg.entries
+	return m, nil
+}
+
+// stepCommentary scrolls the commentary: positive steps go back to older lines,
+// negative steps forward to newer ones.
+func (m *model) stepCommentary(delta int) {
+	m.commentaryOffset = min(max(m.commentaryOffset+delta, 0), max(len(m.commentary)-1, 0))
+}
+
+// displayedCommentary returns the commentary tab of the displayed match, or nil when closed.
+func (m model) displayedCommentary() *ui.Commentary {
+	if !m.commentaryTab || m.matchDetails == nil {
+		return nil
+	}
+	if m.matchDetails.ID != m.commentaryMatchID {
+		return &ui.Commentary{Loading: !m.useMockData}
+	}
+	return &ui.Commentary{
+		Entries: m.commentary,
+		Offset:  m.commentaryOffset,
+		Loading: m.commentaryLoading,
+	}
+}
@@ -0,0 +1,65 @@
+package app
+
+import (
+	"github.com/0xjuanma/golazo/internal/api"
+	"github.com/0xjuanma/golazo/internal/companion"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// companionModel publishes what the TUI is tracking to the web companion after every
+// update (golazo --serve).
+type companionModel struct {
+	tea.Model
+	server *companion.Server
+}
+
+// WithCompanion wraps the TUI model so the web companion follows it.
+func WithCompanion(m tea.Model, server *companion.Server) tea.Model {
+	return companionModel{Model: m, server: server}
+}
+
+// Update updates the wrapped model and publishes its snapshot; the server drops
+// unchanged snapshots.
+func (c companionModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	next, cmd := c.Model.Update(msg)
+	c.Model = next
+	if m, ok := next.(model); ok {
+		c.server.Publish(m.companionSnapshot())
+	}
+	return c, cmd
+}
+
+// companionSnapshot returns the live matches and, in the live view, the selected match
+// with its updates feed.
+func (m model) companionSnapshot() companion.Snapshot {
+	snapshot := companion.Snapshot{Live: []companion.Score{}}
+	if m.baseView() != viewLiveMatches {
+		return snapshot
+	}
+	for _, display := range m.matches {
+		snapshot.Live = append(snapshot.Live, companionScore(display.Match))
+	}
+	if details, updates := m.delayedView(); details != nil {
+		snapshot.Selected = &companion.Feed{
+			Score:   companionScore(details.Match),
+			Updates: updates,
+		}
+	}
+	return snapshot
+}
+
+// companionScore returns the companion's view of a match.
+func companionScore(match api.Match) companion.Score {
+	score := companion.Score{
+		ID:        match.ID,
+		League:    match.League.Name,
+		Home:      match.HomeTeam.Name,
+		Away:      match.AwayTeam.Name,
+		HomeScore: match.HomeScore,
+		AwayScore: match.AwayScore,
+	}
+	if match.LiveTime != nil {
+		score.Minute = *match.LiveTime
+	}
+	return score
+}
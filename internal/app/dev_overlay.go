@@ -0,0 +1,72 @@
+package app
+
+import (
+	"runtime"
+	"time"
+
+	"github.com/0xjuanma/golazo/internal/trace"
+	"github.com/0xjuanma/golazo/internal/ui"
+)
+
+// frameWindow is the number of recent frames averaged in the developer overlay.
+const frameWindow = 60
+
+// frameStats records recent render times. Held by pointer so View (value receiver) can record.
+type frameStats struct {
+	times [frameWindow]time.Duration
+	next  int
+	count int
+}
+
+// record adds a frame's render time.
+func (f *frameStats) record(d time.Duration) {
+	f.times[f.next] = d
+	f.next = (f.next + 1) % frameWindow
+	f.count = min(f.count+1, frameWindow)
+}
+
+// summary returns the last, average and slowest of the recent frames.
+func (f *frameStats) summary() (last, avg, slowest time.Duration) {
+	if f.count == 0 {
+		return 0, 0, 0
+	}
+	last = f.times[(f.next+frameWindow-1)%frameWindow]
+	var total time.Duration
+	for _, d := range f.times[:f.count] {
+		total += d
+		if d > slowest {
+			slowest = d
+		}
+	}
+	return last, total / time.Duration(f.count), slowest
+}
+
+// devStats gathers the developer overlay diagnostics.
+func (m model) devStats() ui.DevStats {
+	stats := ui.DevStats{Goroutines: runtime.NumGoroutine()}
+	if m.frames != nil {
+		stats.FrameLast, stats.FrameAvg, stats.FrameMax = m.frames.summary()
+		stats.Frames = m.frames.count
+	}
+
+	now := time.Now()
+	for _, c := range trace.LastCalls() {
+		f := ui.DevFetch{Provider: c.Provider, Duration: c.Duration, Age: now.Sub(c.At)}
+		if c.Err != nil {
+			f.Err = c.Err.Error()
+		}
+		stats.Fetches = append(stats.Fetches, f)
+	}
+
+	if m.fotmobClient != nil {
+		days, details := m.fotmobClient.Cache().Sizes()
+		stats.Caches = append(stats.Caches,
+			ui.DevCount{Label: "match days", Value: days},
+			ui.DevCount{Label: "details", Value: details})
+	}
+	stats.Caches = append(stats.Caches, ui.DevCount{Label: "view cache", Value: len(m.matchDetailsCache)})
+	if m.redditClient != nil {
+		stats.Caches = append(stats.Caches, ui.DevCount{Label: "goal links", Value: m.redditClient.Cache().Stats().Entries})
+	}
+	return stats
+}
@@ -0,0 +1,42 @@
+package app
+
+import (
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// FreezeTimeout is how long the screen stays frozen (ctrl+f) before repainting again.
+const FreezeTimeout = 30 * time.Second
+
+// freezeTimeoutMsg ends the freeze started with the same id.
+type freezeTimeoutMsg struct {
+	id int
+}
+
+// freeze stops repainting so text can be selected in the terminal: View keeps returning
+// the current frame until a key is pressed or FreezeTimeout passes. Updates keep being
+// applied to the model meanwhile.
+func (m *model) freeze() tea.Cmd {
+	m.freezeID++
+	toastCmd := m.showToast("Screen frozen · any key resumes")
+	m.frozenFrame = m.View()
+	id := m.freezeID
+	return tea.Batch(toastCmd, tea.Tick(FreezeTimeout, func(time.Time) tea.Msg {
+		return freezeTimeoutMsg{id: id}
+	}))
+}
+
+// unfreeze resumes repainting.
+func (m *model) unfreeze() {
+	m.frozenFrame = ""
+	m.toast = ""
+}
+
+// handleFreezeTimeout resumes repainting unless the freeze already ended.
+func (m model) handleFreezeTimeout(msg freezeTimeoutMsg) (tea.Model, tea.Cmd) {
+	if msg.id == m.freezeID && m.frozenFrame != "" {
+		m.unfreeze()
+	}
+	return m, nil
+}
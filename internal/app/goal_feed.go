@@ -0,0 +1,302 @@
+package app
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/0xjuanma/golazo/internal/api"
+	"github.com/0xjuanma/golazo/internal/data"
+	"github.com/0xjuanma/golazo/internal/fotmob"
+	"github.com/0xjuanma/golazo/internal/reddit"
+	"github.com/0xjuanma/golazo/internal/trace"
+	"github.com/0xjuanma/golazo/internal/ui"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// GoalFeedRefreshInterval is how often the goal feed refetches the live matches while open.
+const GoalFeedRefreshInterval = time.Minute
+
+// goalFeedMsg carries the details of the live matches shown in the goal feed.
+type goalFeedMsg struct {
+	seq     int
+	details map[int]*api.MatchDetails // nil entries failed to load
+}
+
+// goalFeedTickMsg triggers a refresh of the goal feed.
+type goalFeedTickMsg struct {
+	seq int
+}
+
+// fetchGoalFeed fetches the details of the given matches.
+func fetchGoalFeed(client *fotmob.Client, useMockData bool, seq int, matchIDs []int) tea.Cmd {
+	return func() tea.Msg {
+		if useMockData {
+			details := make(map[int]*api.MatchDetails, len(matchIDs))
+			for _, id := range matchIDs {
+				if fotmob.MockRequest(requestsCtx) != nil {
+					continue
+				}
+				details[id], _ = data.MockMatchDetails(id)
+			}
+			return goalFeedMsg{seq: seq, details: details}
+		}
+
+		ctx, cancel := context.WithTimeout(trace.Start(requestsCtx, "fetch goal feed"), 30*time.Second)
+		defer cancel()
+		return goalFeedMsg{seq: seq, details: client.BatchMatchDetails(ctx, matchIDs)}
+	}
+}
+
+// scheduleGoalFeedRefresh schedules the next refresh of the goal feed.
+func scheduleGoalFeedRefresh(seq int) tea.Cmd {
+	return tea.Tick(GoalFeedRefreshInterval, func(time.Time) tea.Msg {
+		return goalFeedTickMsg{seq: seq}
+	})
+}
+
+// openGoalFeed shows the goals of every live match. Esc returns to the live view, whose
+// polling carries on behind the feed.
+func (m model) openGoalFeed() (tea.Model, tea.Cmd) {
+	if m.currentView != viewLiveMatches {
+		return m, nil
+	}
+	m.currentView = viewGoalFeed
+	m.goalFeedScroll = 0
+	m.goalFeedAway = nil
+	m.goalFeedCatchUp = m.goalFeedSeen != nil
+	return m, m.refreshGoalFeed()
+}
+
+// refreshGoalFeed refetches the live matches of the goal feed, superseding the scheduled
+// refresh.
+func (m *model) refreshGoalFeed() tea.Cmd {
+	m.goalFeedSeq++
+	ids := m.goalFeedMatchIDs()
+	if len(ids) == 0 || (!m.useMockData && m.fotmobClient == nil) {
+		m.goalFeedLoading = false
+		return scheduleGoalFeedRefresh(m.goalFeedSeq)
+	}
+	m.goalFeedLoading = true
+	return fetchGoalFeed(m.fotmobClient, m.useMockData, m.goalFeedSeq, ids)
+}
+
+// goalFeedMatchIDs returns the IDs of the matches of the live view.
+func (m model) goalFeedMatchIDs() []int {
+	ids := make([]int, 0, len(m.matches))
+	for _, match := range m.matches {
+		ids = append(ids, match.ID)
+	}
+	return ids
+}
+
+// handleGoalFeed stores the fetched details, keeping the previous details of matches
+// that failed to load, and schedules the next refresh.
+func (m model) handleGoalFeed(msg goalFeedMsg) (tea.Model, tea.Cmd) {
+	if msg.seq != m.goalFeedSeq || m.currentView != viewGoalFeed {
+		return m, nil
+	}
+	m.goalFeedLoading = false
+
+	feed := make(map[int]*api.MatchDetails, len(msg.details))
+	for _, id := range m.goalFeedMatchIDs() {
+		if details := msg.details[id]; details != nil {
+			feed[id] = details
+		} else if previous := m.goalFeed[id]; previous != nil {
+			feed[id] = previous
+		}
+	}
+	m.goalFeed = feed
+	m.markGoalFeedSeen()
+	return m, scheduleGoalFeedRefresh(msg.seq)
+}
+
+// handleGoalFeedTick refreshes the goal feed while it is open.
+func (m model) handleGoalFeedTick(msg goalFeedTickMsg) (tea.Model, tea.Cmd) {
+	if msg.seq != m.goalFeedSeq || m.currentView != viewGoalFeed {
+		return m, nil
+	}
+	return m, m.refreshGoalFeed()
+}
+
+// markGoalFeedSeen records the goals of the feed as seen. On the first load after the
+// feed is reopened, goals not seen before were scored while the user was on another
+// view and are marked as such.
+func (m *model) markGoalFeedSeen() {
+	if m.goalFeedSeen == nil {
+		m.goalFeedSeen = make(map[goalFeedKey]bool)
+	}
+	for _, details := range m.goalFeed {
+		for _, event := range details.Events {
+			key := feedGoalKey(details.ID, event)
+			if event.Type != "goal" || m.goalFeedSeen[key] {
+				continue
+			}
+			m.goalFeedSeen[key] = true
+			if m.goalFeedCatchUp {
+				if m.goalFeedAway == nil {
+					m.goalFeedAway = make(map[goalFeedKey]bool)
+				}
+				m.goalFeedAway[key] = true
+			}
+		}
+	}
+	m.goalFeedCatchUp = false
+}
+
+// goalFeedKey identifies a goal of the goal feed.
+type goalFeedKey struct {
+	matchID int
+	eventID int
+	minute  int
+}
+
+// feedGoalKey returns the key of a goal event of a match.
+func feedGoalKey(matchID int, event api.MatchEvent) goalFeedKey {
+	return goalFeedKey{matchID: matchID, eventID: event.ID, minute: event.Minute}
+}
+
+// handleGoalFeedKeys scrolls and refreshes the goal feed.
+func (m model) handleGoalFeedKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "up", "k":
+		m.goalFeedScroll = max(m.goalFeedScroll-1, 0)
+	case "down", "j":
+		m.goalFeedScroll = min(m.goalFeedScroll+1, max(len(m.feedGoals())-1, 0))
+	case "r":
+		if !m.goalFeedLoading {
+			return m, m.refreshGoalFeed()
+		}
+	}
+	return m, nil
+}
+
+// displayedGoalFeed returns the state of the goal feed.
+func (m model) displayedGoalFeed() ui.GoalFeedView {
+	return ui.GoalFeedView{
+		Goals:   m.feedGoals(),
+		Matches: len(m.goalFeed),
+		Loading: m.goalFeedLoading,
+		Scroll:  m.goalFeedScroll,
+	}
+}
+
+// feedGoals returns the goals of the goal feed's matches, newest first by when they
+// were scored (not when they were received), so near-simultaneous goals in different
+// matches are in the right order.
+func (m model) feedGoals() []ui.FeedGoal {
+	var goals []ui.FeedGoal
+	for _, details := range m.goalFeed {
+		goals = append(goals, m.matchFeedGoals(details)...)
+	}
+	slices.SortStableFunc(goals, func(a, b ui.FeedGoal) int {
+		if c := b.At.Compare(a.At); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.MatchID, b.MatchID)
+	})
+	return goals
+}
+
+// matchFeedGoals returns the goals of a match in the order they were scored, with the
+// score each of them made.
+func (m model) matchFeedGoals(details *api.MatchDetails) []ui.FeedGoal {
+	var events []api.MatchEvent
+	for _, event := range details.Events {
+		if event.Type == "goal" {
+			events = append(events, event)
+		}
+	}
+	slices.SortStableFunc(events, func(a, b api.MatchEvent) int {
+		if c := a.Timestamp.Compare(b.Timestamp); c != 0 {
+			return c
+		}
+		return cmp.Compare(goalPlayMinutes(a), goalPlayMinutes(b))
+	})
+
+	replays := m.replayStatuses(details.ID)
+
+	goals := make([]ui.FeedGoal, 0, len(events))
+	homeScore, awayScore := 0, 0
+	for _, event := range events {
+		forHome := event.Team.ID == details.HomeTeam.ID
+		if forHome {
+			homeScore++
+		} else {
+			awayScore++
+		}
+		goal := ui.FeedGoal{
+			MatchID:   details.ID,
+			League:    details.League.Name,
+			HomeTeam:  details.HomeTeam.ShortName,
+			AwayTeam:  details.AwayTeam.ShortName,
+			HomeScore: homeScore,
+			AwayScore: awayScore,
+			ForHome:   forHome,
+			OwnGoal:   event.OwnGoal != nil && *event.OwnGoal,
+			Minute:    event.DisplayMinute,
+			At:        event.Timestamp,
+			Replay:    replays[event.Minute],
+			Unseen:    m.goalFeedAway[feedGoalKey(details.ID, event)],
+		}
+		if goal.HomeTeam == "" {
+			goal.HomeTeam = details.HomeTeam.Name
+		}
+		if goal.AwayTeam == "" {
+			goal.AwayTeam = details.AwayTeam.Name
+		}
+		if event.Player != nil {
+			goal.Scorer = *event.Player
+		}
+		if goal.Minute == "" {
+			goal.Minute = fmt.Sprintf("%d'", event.Minute)
+		}
+		goals = append(goals, goal)
+	}
+	return goals
+}
+
+// replayStatuses returns the replay link status of a match's goals by minute, from the
+// links fetched this session and the goal link cache.
+func (m model) replayStatuses(matchID int) map[int]int {
+	statuses := make(map[int]int)
+	if m.redditClient != nil {
+		for _, link := range m.redditClient.Cache().All(matchID) {
+			statuses[link.Minute] = replayStatus(&link)
+		}
+	}
+	for key, link := range m.goalLinks {
+		if key.MatchID == matchID && link != nil {
+			statuses[key.Minute] = replayStatus(link)
+		}
+	}
+	return statuses
+}
+
+// replayStatus returns the feed status of a goal link.
+func replayStatus(link *reddit.GoalLink) int {
+	switch {
+	case reddit.IsNotFound(link):
+		return ui.ReplayNotFound
+	case ui.IsValidReplayURL(link.URL):
+		return ui.ReplayFound
+	default:
+		return ui.ReplayPending
+	}
+}
+
+// goalPlayMinutes returns the minutes played when a goal was scored, including stoppage
+// time ("45+2'" is 47).
+func goalPlayMinutes(event api.MatchEvent) int {
+	minutes := event.Minute
+	if _, added, ok := strings.Cut(event.DisplayMinute, "+"); ok {
+		if n, err := strconv.Atoi(strings.TrimSuffix(strings.TrimSpace(added), "'")); err == nil {
+			minutes += n
+		}
+	}
+	return minutes
+}
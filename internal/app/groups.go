@@ -0,0 +1,154 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/0xjuanma/golazo/internal/api"
+	"github.com/0xjuanma/golazo/internal/fotmob"
+	"github.com/0xjuanma/golazo/internal/rules"
+	"github.com/0xjuanma/golazo/internal/trace"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// groupTablesMsg carries the group tables of a competition (empty when it has no groups),
+// or its league table when it has none.
+type groupTablesMsg struct {
+	leagueID int
+	groups   []api.GroupTable
+	table    []api.LeagueTableEntry
+}
+
+// fetchGroupTables fetches the group tables of a competition, falling back to its league table.
+func fetchGroupTables(client *fotmob.Client, leagueID, tableLeagueID int) tea.Cmd {
+	return func() tea.Msg {
+		ctx, cancel := context.WithTimeout(trace.Start(requestsCtx, fmt.Sprintf("load group tables %d", leagueID)), 10*time.Second)
+		defer cancel()
+		awaitStartupTurn(ctx, priorityStandings)
+
+		groups, err := client.GroupTables(ctx, tableLeagueID)
+		if err != nil || groups == nil {
+			groups = []api.GroupTable{}
+		}
+		msg := groupTablesMsg{leagueID: leagueID, groups: groups}
+		if err == nil && len(groups) == 0 {
+			msg.table, _ = client.Standings(ctx, tableLeagueID)
+		}
+		return msg
+	}
+}
+
+// watchGroupTables fetches the tables of a match's competition once per session.
+func (m model) watchGroupTables(details *api.MatchDetails) tea.Cmd {
+	if m.useMockData || m.fotmobClient == nil {
+		return nil
+	}
+	leagueID := details.League.ID
+	if _, requested := m.groupTables[leagueID]; requested {
+		return nil
+	}
+	m.groupTables[leagueID] = nil
+
+	// Sub-season leagues keep their tables under the parent league
+	tableLeagueID := leagueID
+	if parent := details.League.ParentLeagueID; parent > 0 {
+		tableLeagueID = parent
+	}
+	return fetchGroupTables(m.fotmobClient, leagueID, tableLeagueID)
+}
+
+// withStandings returns details with the match's group table attached, adjusted with the
+// current score of every match in progress in the group, and the qualification scenarios
+// of upcoming and live matches. Returns details unchanged when neither applies.
+func (m model) withStandings(details *api.MatchDetails) *api.MatchDetails {
+	if details == nil {
+		return nil
+	}
+	scenarios := m.qualificationScenarios(details)
+	if len(scenarios) > 0 {
+		withScenarios := *details
+		withScenarios.Scenarios = scenarios
+		details = &withScenarios
+	}
+
+	var group *api.GroupTable
+	for i, g := range m.groupTables[details.League.ID] {
+		if containsTeams(g.Entries, details.HomeTeam.ID, details.AwayTeam.ID) {
+			group = &m.groupTables[details.League.ID][i]
+			break
+		}
+	}
+	if group == nil {
+		return details
+	}
+
+	entries := make([]api.LeagueTableEntry, len(group.Entries))
+	copy(entries, group.Entries)
+	index := make(map[int]int, len(entries))
+	for i, e := range entries {
+		index[e.Team.ID] = i
+	}
+
+	competition := rules.For(details.League.ID)
+	live := m.liveScores()
+	if details.Status == api.MatchStatusLive && !containsMatch(live, details.ID) {
+		live = append(live, details.Match)
+	}
+	for _, match := range live {
+		if match.Status != api.MatchStatusLive || match.HomeScore == nil || match.AwayScore == nil {
+			continue
+		}
+		home, okHome := index[match.HomeTeam.ID]
+		away, okAway := index[match.AwayTeam.ID]
+		if !okHome || !okAway {
+			continue
+		}
+		competition.Apply(&entries[home], *match.HomeScore, *match.AwayScore)
+		competition.Apply(&entries[away], *match.AwayScore, *match.HomeScore)
+	}
+
+	withGroup := *details
+	withGroup.Group = &api.GroupTable{Name: group.Name, Entries: competition.Sort(entries, nil)}
+	return &withGroup
+}
+
+// qualificationScenarios computes what each result of an upcoming or live match settles
+// in its group, or in its league table outside group stages.
+func (m model) qualificationScenarios(details *api.MatchDetails) []api.QualificationScenario {
+	if details.Status != api.MatchStatusNotStarted && details.Status != api.MatchStatusLive {
+		return nil
+	}
+
+	table := m.seasonTables[details.League.ID]
+	for _, g := range m.groupTables[details.League.ID] {
+		if containsTeams(g.Entries, details.HomeTeam.ID, details.AwayTeam.ID) {
+			table = g.Entries
+			break
+		}
+	}
+	if !containsTeams(table, details.HomeTeam.ID, details.AwayTeam.ID) {
+		return nil
+	}
+	return rules.For(details.League.ID).Scenarios(table, details.HomeTeam.ID, details.AwayTeam.ID)
+}
+
+// containsTeams reports whether a table includes both teams.
+func containsTeams(entries []api.LeagueTableEntry, homeTeamID, awayTeamID int) bool {
+	var home, away bool
+	for _, e := range entries {
+		home = home || e.Team.ID == homeTeamID
+		away = away || e.Team.ID == awayTeamID
+	}
+	return home && away
+}
+
+// containsMatch reports whether matches includes the match with the given ID.
+func containsMatch(matches []api.Match, id int) bool {
+	for _, match := range matches {
+		if match.ID == id {
+			return true
+		}
+	}
+	return false
+}
@@ -1,6 +1,7 @@
 package app
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/0xjuanma/golazo/internal/api"
@@ -37,13 +38,17 @@ func (m model) handleMainViewKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 
 		m.mainViewLoading = true
 		m.pendingSelection = m.selected
+		beginStartup()
 
 		// Clear previous view state
 		m.matches = nil
 		m.upcomingMatches = nil
 		m.matchDetails = nil
 		m.liveUpdates = nil
+		m.pausedFeed = nil
+		m.resetMatchThread()
 		m.lastEvents = nil
+		m.overturnedEvents = nil
 		m.lastHomeScore = 0
 		m.lastAwayScore = 0
 		m.polling = false
@@ -152,21 +157,39 @@ func (m model) loadMatchDetails(matchID int) (tea.Model, tea.Cmd) {
 // loadMatchDetailsWithRefresh loads match details for the live matches view with optional cache bypass.
 func (m model) loadMatchDetailsWithRefresh(matchID int, forceRefresh bool) (tea.Model, tea.Cmd) {
 	m.liveUpdates = nil
+	m.pausedFeed = nil
+	m.resetMatchThread()
 	m.lastEvents = nil
+	m.overturnedEvents = nil
 	m.lastHomeScore = 0
 	m.lastAwayScore = 0
 	m.loading = true
 	m.liveViewLoading = true
 	m.polling = false // Reset polling state - this is a new match load, not a poll refresh
 
+	ctx := m.newSelection(matchID)
 	var cmd tea.Cmd
 	if forceRefresh {
-		cmd = fetchMatchDetailsForceRefresh(m.fotmobClient, matchID, m.useMockData)
+		cmd = fetchMatchDetailsForceRefresh(ctx, m.fotmobClient, matchID, m.useMockData)
+	} else if !m.useMockData {
+		cmd = withStaleDetails(m.fotmobClient, matchID, fetchMatchDetails(ctx, m.fotmobClient, matchID, m.useMockData))
 	} else {
-		cmd = fetchMatchDetails(m.fotmobClient, matchID, m.useMockData)
+		cmd = fetchMatchDetails(ctx, m.fotmobClient, matchID, m.useMockData)
 	}
 
-	return m, tea.Batch(m.spinner.Tick, ui.SpinnerTick(), cmd)
+	return m, tea.Batch(m.spinner.Tick, ui.SpinnerTick(), cmd, m.prefetchAdjacent(ctx, m.liveMatchesList, matchID))
+}
+
+// newSelection starts the details requests of a newly selected match: the previous
+// selection's in-flight requests are cancelled, so rapid scrolling doesn't pile up
+// fetches, and their late responses are dropped by handleMatchDetails.
+func (m *model) newSelection(matchID int) context.Context {
+	if m.cancelSelection != nil {
+		m.cancelSelection()
+	}
+	m.selectedMatchID = matchID
+	m.selectionCtx, m.cancelSelection = context.WithCancel(requestsCtx)
+	return m.selectionCtx
 }
 
 // loadStatsMatchDetails loads match details for the stats view.
@@ -178,13 +201,14 @@ func (m model) loadStatsMatchDetails(matchID int) (tea.Model, tea.Cmd) {
 // loadStatsMatchDetailsWithRefresh loads match details with optional cache bypass.
 func (m model) loadStatsMatchDetailsWithRefresh(matchID int, forceRefresh bool) (tea.Model, tea.Cmd) {
 	m.debugLog(fmt.Sprintf("Loading match details for ID: %d (forceRefresh: %v)", matchID, forceRefresh))
+	ctx := m.newSelection(matchID)
 
 	// Check cache unless force refresh is requested
 	if !forceRefresh {
 		if cached, ok := m.matchDetailsCache[matchID]; ok {
 			m.matchDetails = cached
 			m.debugLog(fmt.Sprintf("Using cached match details for ID: %d", matchID))
-			return m, nil
+			return m, m.prefetchAdjacent(ctx, m.statsMatchesList, matchID)
 		}
 	} else {
 		// Clear from cache to force fresh fetch
@@ -196,7 +220,11 @@ func (m model) loadStatsMatchDetailsWithRefresh(matchID int, forceRefresh bool)
 	m.loading = true
 	m.statsViewLoading = true
 	m.debugLog(fmt.Sprintf("Fetching match details from API for ID: %d", matchID))
-	return m, tea.Batch(m.spinner.Tick, ui.SpinnerTick(), fetchStatsMatchDetailsFotmob(m.fotmobClient, matchID, m.useMockData))
+	cmd := fetchStatsMatchDetailsFotmob(ctx, m.fotmobClient, matchID, m.useMockData)
+	if !forceRefresh && !m.useMockData {
+		cmd = withStaleDetails(m.fotmobClient, matchID, cmd)
+	}
+	return m, tea.Batch(m.spinner.Tick, ui.SpinnerTick(), cmd, m.prefetchAdjacent(ctx, m.statsMatchesList, matchID))
 }
 
 // handleSettingsViewKeys processes keyboard input for the settings view.
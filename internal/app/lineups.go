@@ -0,0 +1,41 @@
+package app
+
+import (
+	"time"
+
+	"github.com/0xjuanma/golazo/internal/api"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// Pre-match line-ups. FotMob may list predicted line-ups before kickoff and replaces them
+// with the announced ones about an hour before the match, so the selected upcoming match
+// is polled every LineupPollInterval from LineupWatchWindow before kickoff until they are out.
+const (
+	LineupWatchWindow  = 90 * time.Minute
+	LineupPollInterval = 2 * time.Minute
+	lineupWatchGrace   = 30 * time.Minute // Kickoffs running late keep being watched this long
+)
+
+// awaitingLineups reports whether an upcoming match kicks off soon without confirmed line-ups.
+func awaitingLineups(details *api.MatchDetails) bool {
+	if details.Status != api.MatchStatusNotStarted || details.MatchTime == nil || details.LineupsConfirmed() {
+		return false
+	}
+	untilKickoff := time.Until(*details.MatchTime)
+	return untilKickoff <= LineupWatchWindow && untilKickoff > -lineupWatchGrace
+}
+
+// notifyLineups announces the confirmed line-ups of the selected upcoming match when a poll
+// brings them in place of predicted or missing ones.
+func (m model) notifyLineups(previous, details *api.MatchDetails) tea.Cmd {
+	if previous == nil || previous.ID != details.ID || previous.LineupsConfirmed() || !details.LineupsConfirmed() {
+		return nil
+	}
+	if details.Status != api.MatchStatusNotStarted {
+		return nil
+	}
+	if m.notifier != nil && (m.settings == nil || !m.settings.IsLeagueMuted(details.League.ID)) {
+		_ = m.notifier.Lineups(details)
+	}
+	return m.showToast("Confirmed line-ups are out")
+}
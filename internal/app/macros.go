@@ -0,0 +1,65 @@
+package app
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// reservedMacroKeys can't be bound to macros, so command mode and quitting always work.
+var reservedMacroKeys = []string{":", "ctrl+c"}
+
+// macro returns the commands bound to a key by the macros setting.
+func (m model) macro(key string) ([]string, bool) {
+	if m.settings == nil || m.runningMacro {
+		return nil, false
+	}
+	lines, ok := m.settings.Macros[key]
+	return lines, ok
+}
+
+// runMacro runs a macro's commands in order through the command dispatcher, stopping
+// at the first one that fails. Each command starts once the previous one is issued;
+// it doesn't wait for the previous one's data to arrive.
+func (m model) runMacro(key string, lines []string) (tea.Model, tea.Cmd) {
+	m.runningMacro = true
+	var cmds []tea.Cmd
+	for _, line := range lines {
+		updated, cmd, err := m.execCommand(line)
+		if err != nil {
+			cmds = append(cmds, m.showToast(fmt.Sprintf("Macro %s: %v", key, err)))
+			break
+		}
+		if next, ok := updated.(model); ok {
+			m = next
+		}
+		cmds = append(cmds, cmd)
+	}
+	m.runningMacro = false
+	return m, tea.Batch(cmds...)
+}
+
+// validateMacros returns an error for macros bound to reserved keys, without commands,
+// or with unknown commands.
+func validateMacros(macros map[string][]string) error {
+	for _, key := range slices.Sorted(maps.Keys(macros)) {
+		if slices.Contains(reservedMacroKeys, key) {
+			return fmt.Errorf("%q can't be bound", key)
+		}
+		if len(macros[key]) == 0 {
+			return fmt.Errorf("%s has no commands", key)
+		}
+		for _, line := range macros[key] {
+			fields := splitCommandLine(line)
+			if len(fields) == 0 {
+				return fmt.Errorf("%s has an empty command", key)
+			}
+			if _, ok := findCommand(fields[0]); !ok {
+				return fmt.Errorf("%s: unknown command %q", key, fields[0])
+			}
+		}
+	}
+	return nil
+}
@@ -1,9 +1,12 @@
 package app
 
 import (
+	"time"
+
 	"github.com/0xjuanma/golazo/internal/api"
 	"github.com/0xjuanma/golazo/internal/fotmob"
 	"github.com/0xjuanma/golazo/internal/reddit"
+	tea "github.com/charmbracelet/bubbletea"
 )
 
 // liveUpdateMsg contains a live update string for match events.
@@ -11,9 +14,12 @@ type liveUpdateMsg struct {
 	update string
 }
 
-// matchDetailsMsg contains match details from API response.
+// matchDetailsMsg contains match details from API response. stale is set for expired
+// cached details shown while fresh ones are fetched; the fresh ones follow in another matchDetailsMsg.
 type matchDetailsMsg struct {
+	matchID int // Match requested; responses for a previous selection are dropped
 	details *api.MatchDetails
+	stale   bool
 }
 
 // liveMatchesMsg contains live matches from API response.
@@ -21,7 +27,7 @@ type liveMatchesMsg struct {
 	matches []api.Match
 }
 
-// liveRefreshMsg is sent when live matches are refreshed (periodic 5-min timer).
+// liveRefreshMsg is sent when live matches are refreshed (periodic, kickoff-aware timer).
 type liveRefreshMsg struct {
 	matches []api.Match
 }
@@ -54,6 +60,7 @@ type statsDayDataMsg struct {
 // This triggers the actual API call with loading state visible.
 type pollTickMsg struct {
 	matchID int
+	seq     int // model.pollSeq when scheduled; stale ticks are ignored
 }
 
 // pollDisplayCompleteMsg is sent after minimum display time (1 second) has elapsed.
@@ -67,12 +74,62 @@ type goalLinksMsg struct {
 	links   map[reddit.GoalLinkKey]*reddit.GoalLink
 }
 
+// goalLinkProgressMsg reports a single goal lookup while goal links are being fetched.
+// ch delivers the next progress message and finally the goalLinksMsg.
+type goalLinkProgressMsg struct {
+	matchID  int
+	progress reddit.GoalLinkProgress
+	ch       <-chan tea.Msg
+}
+
+// toastClearMsg hides the toast once its display time has elapsed.
+// id identifies the toast it belongs to; newer toasts are not cleared.
+type toastClearMsg struct {
+	id int
+}
+
 // standingsMsg contains league standings from API response.
-// Used to populate the standings dialog.
+// Used to populate the standings dialog, or the live view's split layout when panel is set.
 type standingsMsg struct {
 	leagueID   int
 	leagueName string
 	standings  []api.LeagueTableEntry
 	homeTeamID int
 	awayTeamID int
+	panel      bool
+}
+
+// reminderDueMsg fires when a kickoff reminder (or its snooze) is due.
+type reminderDueMsg struct {
+	match api.Match
+}
+
+// reminderActionMsg carries the button chosen on a kickoff reminder ("" for none).
+type reminderActionMsg struct {
+	match  api.Match
+	action string
+}
+
+// halfTimeWatch is a match the user asked to be notified about at HT.
+type halfTimeWatch struct {
+	matchID  int
+	deadline time.Time // Polling stops here, HT or not
+	failures int       // Consecutive failed checks
+}
+
+// halfTimeCheckMsg triggers a status check for a half-time watch.
+type halfTimeCheckMsg struct {
+	watch halfTimeWatch
+}
+
+// halfTimeStatusMsg carries fresh details for a half-time watch (nil on fetch error).
+type halfTimeStatusMsg struct {
+	watch   halfTimeWatch
+	details *api.MatchDetails
+}
+
+// rawResponseMsg reports where the raw provider response of a match was saved.
+type rawResponseMsg struct {
+	path string
+	err  error
 }
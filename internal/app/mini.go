@@ -0,0 +1,145 @@
+package app
+
+import (
+	"context"
+	"time"
+
+	"github.com/0xjuanma/golazo/internal/api"
+	"github.com/0xjuanma/golazo/internal/data"
+	"github.com/0xjuanma/golazo/internal/fotmob"
+	"github.com/0xjuanma/golazo/internal/ui"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// miniMatchMsg contains the match resolved and fetched for the mini scoreboard.
+type miniMatchMsg struct {
+	details *api.MatchDetails
+}
+
+// miniModel is a stripped-down application model for the mini scoreboard (golazo mini).
+// It follows a single match and renders only its score and minute.
+type miniModel struct {
+	width       int
+	matchID     int // 0 = pick the first live match
+	details     *api.MatchDetails
+	loading     bool
+	useMockData bool
+	powerSaver  string // power_saver setting
+
+	fotmobClient *fotmob.Client
+}
+
+// NewMini creates the mini scoreboard model.
+// matchID selects the match to follow; 0 follows the first live match found.
+// useMockData determines whether to use mock data instead of real API data.
+func NewMini(matchID int, useMockData bool) tea.Model {
+	settings, _ := data.LoadSettings()
+	return miniModel{
+		matchID:      matchID,
+		loading:      true,
+		useMockData:  useMockData,
+		powerSaver:   settings.PowerSaver,
+		fotmobClient: fotmob.NewClient(),
+	}
+}
+
+// Init starts the first fetch.
+func (m miniModel) Init() tea.Cmd {
+	return fetchMiniMatch(m.fotmobClient, m.matchID, m.useMockData)
+}
+
+// Update handles key presses, fetch results and poll ticks.
+func (m miniModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.WindowSizeMsg:
+		m.width = msg.Width
+		return m, nil
+
+	case tea.KeyMsg:
+		switch msg.String() {
+		case "q", "esc", "ctrl+c":
+			return m, tea.Quit
+		case "r":
+			m.loading = true
+			return m, fetchMiniMatch(m.fotmobClient, m.currentMatchID(), m.useMockData)
+		}
+		return m, nil
+
+	case miniMatchMsg:
+		m.loading = false
+		if msg.details != nil {
+			m.details = msg.details
+		}
+		// Stop polling once the match is over; otherwise keep polling
+		// (also retries until a live match appears when none was found)
+		if m.details != nil && m.details.Status == api.MatchStatusFinished {
+			return m, nil
+		}
+		interval := BackgroundPollInterval
+		if m.details != nil {
+			interval = selectedPollInterval(m.details.Match)
+		}
+		return m, schedulePollTick(m.currentMatchID(), throttle(interval, m.powerSaver))
+
+	case pollTickMsg:
+		return m, fetchMiniMatch(m.fotmobClient, msg.matchID, m.useMockData)
+	}
+
+	return m, nil
+}
+
+// View renders the 3-line scoreboard box.
+func (m miniModel) View() string {
+	width := m.width
+	if width <= 0 {
+		width = 40
+	}
+	return ui.RenderMiniScoreboard(width, m.details, m.loading)
+}
+
+// currentMatchID returns the followed match ID, preferring the resolved details.
+func (m miniModel) currentMatchID() int {
+	if m.details != nil {
+		return m.details.ID
+	}
+	return m.matchID
+}
+
+// fetchMiniMatch fetches details for the followed match.
+// When matchID is 0, the first live match across active leagues is used.
+func fetchMiniMatch(client *fotmob.Client, matchID int, useMockData bool) tea.Cmd {
+	return func() tea.Msg {
+		if useMockData {
+			if matchID == 0 {
+				if live := data.MockLiveMatches(); len(live) > 0 {
+					matchID = live[0].ID
+				}
+			}
+			details, _ := data.MockMatchDetails(matchID)
+			return miniMatchMsg{details: details}
+		}
+
+		if client == nil {
+			return miniMatchMsg{details: nil}
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		defer cancel()
+
+		if matchID == 0 {
+			live, err := client.LiveMatches(ctx)
+			if err != nil || len(live) == 0 {
+				return miniMatchMsg{details: nil}
+			}
+			matchID = live[0].ID
+		}
+
+		// Force refresh to bypass cache - the scoreboard needs fresh data
+		details, err := client.MatchDetailsForceRefresh(ctx, matchID)
+		if err != nil {
+			return miniMatchMsg{details: nil}
+		}
+
+		return miniMatchMsg{details: details}
+	}
+}
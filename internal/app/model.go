@@ -2,22 +2,29 @@
 package app
 
 import (
+	"context"
 	"fmt"
 	"os"
 	"path/filepath"
+	"slices"
 	"time"
 
 	"github.com/0xjuanma/golazo/internal/api"
+	"github.com/0xjuanma/golazo/internal/companion"
 	"github.com/0xjuanma/golazo/internal/constants"
 	"github.com/0xjuanma/golazo/internal/data"
+	"github.com/0xjuanma/golazo/internal/eventlog"
 	"github.com/0xjuanma/golazo/internal/fotmob"
+	"github.com/0xjuanma/golazo/internal/httpx"
 	"github.com/0xjuanma/golazo/internal/notify"
 	"github.com/0xjuanma/golazo/internal/reddit"
+	"github.com/0xjuanma/golazo/internal/trace"
 	"github.com/0xjuanma/golazo/internal/ui"
 	"github.com/0xjuanma/golazo/internal/ui/logo"
 	"github.com/charmbracelet/bubbles/key"
 	"github.com/charmbracelet/bubbles/list"
 	"github.com/charmbracelet/bubbles/spinner"
+	"github.com/charmbracelet/bubbles/textinput"
 	"github.com/charmbracelet/bubbles/viewport"
 	tea "github.com/charmbracelet/bubbletea"
 )
@@ -30,8 +37,18 @@ const (
 	viewLiveMatches
 	viewStats
 	viewSettings
+	viewTeam
+	viewGoalFeed
 )
 
+// goalLinksProgress tracks an in-flight goal links fetch for a match.
+type goalLinksProgress struct {
+	matchID int
+	done    int
+	total   int
+	found   int
+}
+
 // model holds the application state.
 // Fields are organized by concern: display, data, UI components, and configuration.
 type model struct {
@@ -50,9 +67,39 @@ type model struct {
 	matchDetails        *api.MatchDetails
 	matchDetailsCache   map[int]*api.MatchDetails // Cache to avoid repeated API calls
 	liveUpdates         []string
+	pausedFeed          *pausedFeed         // Frozen view of liveUpdates while paused ("p"), nil when following
+	matchThread         *reddit.MatchThread // r/soccer Match Thread of the selected live match
+	threadMatchID       int                 // Match whose thread is being watched (0 = none)
+	threadComments      []reddit.Comment    // Newest Match Thread comments, newest first
 	lastEvents          []api.MatchEvent
-	lastHomeScore       int // Track last known home score for goal notifications
-	lastAwayScore       int // Track last known away score for goal notifications
+	overturnedEvents    []api.MatchEvent // Events of the selected match FotMob stopped reporting (e.g., VAR)
+	lastHomeScore       int              // Track last known home score for goal notifications
+	lastAwayScore       int              // Track last known away score for goal notifications
+
+	// Text commentary tab of the live details panel ("c")
+	commentaryTab     bool
+	commentaryMatchID int                   // Match the commentary belongs to (0 = none)
+	commentary        []api.CommentaryEntry // Newest first
+	commentaryOffset  int                   // Scroll position ([ and ] while the tab is open)
+	commentaryLoading bool
+
+	// Team page, opened with Enter on a team of the standings dialog
+	team           api.Team
+	teamPage       *api.TeamPage // nil while loading or unavailable
+	teamLoading    bool
+	teamScroll     int  // First squad line shown
+	teamReturnView view // View Esc returns to
+
+	// Goal feed, opened with "a" in the live view
+	goalFeed        map[int]*api.MatchDetails // Details of the live matches, by match ID
+	goalFeedLoading bool
+	goalFeedScroll  int                  // First goal shown
+	goalFeedSeq     int                  // Identifies the current refresh loop
+	goalFeedSeen    map[goalFeedKey]bool // Goals loaded into the feed, nil until it is first opened
+	goalFeedAway    map[goalFeedKey]bool // Goals scored while the feed was closed
+	goalFeedCatchUp bool                 // The next load marks unseen goals as scored while away
+
+	backgroundPolls map[int]bool // Unselected live matches being polled (see startBackgroundPolls)
 
 	// Stats data cache - stores 5 days of data, filtered client-side for Today/3d/5d views
 	statsData *fotmob.StatsData
@@ -86,6 +133,15 @@ type model struct {
 	liveViewLoading  bool
 	statsViewLoading bool
 	polling          bool
+	revalidating     bool       // Stale cached details are shown while fresh ones are fetched
+	pollSeq          int        // Incremented to supersede the scheduled poll tick
+	boost            *pollBoost // Clutch time of a live match ("b"); nil when off
+
+	// Selected match whose details are requested, and the context of those requests,
+	// cancelled when the selection changes (see newSelection)
+	selectedMatchID  int
+	selectionCtx     context.Context
+	cancelSelection  context.CancelFunc
 	pendingSelection int // Tracks which view is being preloaded (-1 = none, 0 = stats, 1 = live)
 
 	// Configuration
@@ -109,12 +165,80 @@ type model struct {
 
 	// Goal replay links from Reddit (keyed by matchID:minute)
 	goalLinks map[reddit.GoalLinkKey]*reddit.GoalLink
+	// In-flight goal link fetch progress (shown as "3/8 goal links found")
+	goalLinksProgress *goalLinksProgress
 
 	// Notifications
-	notifier *notify.DesktopNotifier
+	notifier  *notify.DesktopNotifier
+	reminders map[int]bool // Matches with a kickoff reminder scheduled
+
+	// Raw event archive (nil unless event_log is enabled in settings)
+	eventLog *eventlog.Sink
+
+	// External player for goal replays/highlights ("o" key)
+	launcher     *ui.Launcher
+	mediaIndex   int // Next clip to open for the displayed match (cycles)
+	mediaMatchID int // Match the mediaIndex belongs to
+
+	// Extra columns shown on finished list items (finished_columns in settings)
+	finishedColumns []string
+
+	// Accelerated playback of a finished match in the stats view ("p")
+	replay      *replayState
+	replayID    int     // Incremented per replay so stale ticks are ignored
+	replaySpeed float64 // Initial speed in match minutes per second (replay_speed setting)
+
+	// Power saving (power_saver setting: "auto", "on" or "off")
+	powerSaver string
+
+	// List item density per view ("z" cycles comfortable/compact/dense)
+	liveDensity  ui.Density
+	statsDensity ui.Density
+
+	// Short-lived confirmation message (e.g., "Link copied")
+	toast   string
+	toastID int // Incremented per toast so stale clear messages are ignored
 
 	// Logo animation (main view only)
 	animatedLogo *logo.AnimatedLogo
+
+	// Split layout (t in the live view): live league table instead of match details
+	standingsSplit bool
+	leagueTables   map[int][]api.LeagueTableEntry // By league ID; nil entry while loading or without a table
+
+	// Group tables of tournaments by league ID; nil entry while loading, empty without groups
+	groupTables  map[int][]api.GroupTable
+	seasonTables map[int][]api.LeagueTableEntry // League table of competitions without groups
+
+	// TV stations of upcoming and live matches in the country setting, by match ID; nil entry while loading
+	broadcasts map[int][]string
+
+	// Hands-free cycling through views and matches (rotation setting); nil when off
+	rotation *rotationState
+
+	// Settings last applied, reloaded when settings.yaml changes on disk
+	settings        *data.Settings
+	settingsModTime time.Time
+
+	// Hidden developer overlay (Ctrl+D): frame times, fetch stats, goroutines, cache sizes
+	devOverlay bool
+	frames     *frameStats
+
+	// Screen freeze (Ctrl+F) for selecting text: the frame View returns while frozen
+	frozenFrame string
+	freezeID    int
+
+	// ":" command mode: the prompt while it is open, and past commands (oldest first)
+	commandInput      *textinput.Model // nil while the prompt is closed
+	commandHistory    []string
+	commandHistoryPos int  // Position while browsing the history; len(commandHistory) is the new line
+	runningMacro      bool // Set while a macro runs, so the keys its commands press don't start macros
+
+	// Watch party (--serve or --join) and the spoiler offset live updates are delayed by
+	party         *Party          // nil when not in a party
+	partyState    companion.Party // Latest shared state: offset and reactions
+	spoilerOffset time.Duration
+	delayed       []delayedPoll // Recent polls of the selected match, oldest first
 }
 
 // New creates a new application model with default values.
@@ -138,13 +262,18 @@ func New(useMockData bool, debugMode bool, isDevBuild bool, newVersionAvailable
 	pollingSpinner := ui.NewRandomCharSpinner()
 	pollingSpinner.SetWidth(10) // Small spinner for polling indicator
 
-	// Initialize list models with custom delegate
-	delegate := ui.NewMatchListDelegate()
+	settings, _ := data.LoadSettings()
+
+	// Initialize list models with custom delegates (density is per view)
+	liveDensity := ui.ParseDensity(settings.Density[data.DensityLiveView])
+	statsDensity := ui.ParseDensity(settings.Density[data.DensityFinishedView])
+	liveDelegate := ui.NewMatchListDelegateWithDensity(liveDensity)
+	statsDelegate := ui.NewMatchListDelegateWithDensity(statsDensity)
 
 	// Filter input styles matching neon theme
 	filterCursorStyle, filterPromptStyle := ui.FilterInputStyles()
 
-	liveList := list.New([]list.Item{}, delegate, 0, 0)
+	liveList := list.New([]list.Item{}, liveDelegate, 0, 0)
 	liveList.SetShowTitle(false)
 	liveList.SetShowStatusBar(true)
 	liveList.SetFilteringEnabled(true)
@@ -154,7 +283,7 @@ func New(useMockData bool, debugMode bool, isDevBuild bool, newVersionAvailable
 	liveList.FilterInput.PromptStyle = filterPromptStyle
 	liveList.FilterInput.Cursor.Style = filterCursorStyle
 
-	statsList := list.New([]list.Item{}, delegate, 0, 0)
+	statsList := list.New([]list.Item{}, statsDelegate, 0, 0)
 	statsList.SetShowTitle(false)
 	statsList.SetShowStatusBar(true)
 	statsList.SetFilteringEnabled(true)
@@ -173,7 +302,7 @@ func New(useMockData bool, debugMode bool, isDevBuild bool, newVersionAvailable
 	statsDetailsViewport := viewport.New(80, 20) // Will be resized dynamically
 	statsDetailsViewport.MouseWheelEnabled = true
 
-	upcomingList := list.New([]list.Item{}, delegate, 0, 0)
+	upcomingList := list.New([]list.Item{}, liveDelegate, 0, 0)
 	upcomingList.SetShowTitle(false)
 	upcomingList.SetShowStatusBar(true)
 	upcomingList.SetFilteringEnabled(true)
@@ -183,9 +312,11 @@ func New(useMockData bool, debugMode bool, isDevBuild bool, newVersionAvailable
 	upcomingList.FilterInput.PromptStyle = filterPromptStyle
 	upcomingList.FilterInput.Cursor.Style = filterCursorStyle
 
-	// Initialize Reddit client (best-effort, nil if fails)
+	// Initialize Reddit client (best-effort, nil if fails; never in privacy mode)
 	var redditClient *reddit.Client
-	if debugMode {
+	switch {
+	case data.PrivacyMode():
+	case debugMode:
 		redditClient, _ = reddit.NewClientWithDebug(func(message string) {
 			// This will be called by the Reddit client for debug logging
 			// We'll create a model instance to access debugLog, but for now just log directly
@@ -200,9 +331,39 @@ func New(useMockData bool, debugMode bool, isDevBuild bool, newVersionAvailable
 				}
 			}
 		})
-	} else {
+	default:
 		redditClient, _ = reddit.NewClient()
 	}
+	if debugMode {
+		// Correlation IDs tie provider calls in the debug log to the user action that made them
+		trace.SetLogger(func(message string) { model{debugMode: true}.debugLog("trace " + message) })
+	}
+	if redditClient != nil && settings.GoalLinkArchive {
+		redditClient.UseArchive()
+	}
+	if redditClient != nil && len(settings.GoalSearchWindows) > 0 {
+		redditClient.SetSearchWindows(reddit.SearchWindowsFromSettings(settings))
+	}
+	if redditClient != nil && (settings.GoalLinkCacheMaxEntries > 0 || settings.GoalLinkCacheDays > 0) {
+		_ = redditClient.Cache().SetLimits(settings.GoalLinkCacheMaxEntries, time.Duration(settings.GoalLinkCacheDays)*24*time.Hour)
+	}
+
+	// Initialize event log sink when enabled (never for mock data)
+	var eventLog *eventlog.Sink
+	if settings.EventLog && !useMockData {
+		eventLog, _ = eventlog.NewSink()
+	}
+
+	replaySpeed := settings.ReplaySpeed
+	if replaySpeed <= 0 {
+		replaySpeed = DefaultReplaySpeed
+	}
+
+	// Mark the session as running; a previous one that never shut down cleanly crashed
+	var toast string
+	if _, crashed := data.BeginSession(); crashed {
+		toast = CrashNotice
+	}
 
 	// Initialize animated logo for main view
 	animatedLogo := logo.NewAnimatedLogoWithType(appVersion, false, logo.DefaultOpts(), 1200, 1, logo.AnimationWave)
@@ -210,6 +371,9 @@ func New(useMockData bool, debugMode bool, isDevBuild bool, newVersionAvailable
 	return model{
 		currentView:            viewMain,
 		matchDetailsCache:      make(map[int]*api.MatchDetails),
+		groupTables:            make(map[int][]api.GroupTable),
+		seasonTables:           make(map[int][]api.LeagueTableEntry),
+		broadcasts:             make(map[int][]string),
 		useMockData:            useMockData,
 		debugMode:              debugMode,
 		isDevBuild:             isDevBuild,
@@ -220,6 +384,13 @@ func New(useMockData bool, debugMode bool, isDevBuild bool, newVersionAvailable
 		redditClient:           redditClient,
 		goalLinks:              make(map[reddit.GoalLinkKey]*reddit.GoalLink),
 		notifier:               notify.NewDesktopNotifier(),
+		eventLog:               eventLog,
+		launcher:               ui.NewLauncher(settings.PlayerCommand),
+		finishedColumns:        settings.FinishedColumns,
+		liveDensity:            liveDensity,
+		replaySpeed:            replaySpeed,
+		powerSaver:             settings.PowerSaver,
+		statsDensity:           statsDensity,
 		spinner:                s,
 		randomSpinner:          randomSpinner,
 		statsViewSpinner:       statsViewSpinner,
@@ -234,12 +405,21 @@ func New(useMockData bool, debugMode bool, isDevBuild bool, newVersionAvailable
 		pendingSelection:       -1,                    // No pending selection
 		dialogOverlay:          ui.NewDialogOverlay(), // Initialize dialog overlay
 		animatedLogo:           animatedLogo,          // Initialize animated logo
+		frames:                 &frameStats{},
+		rotation:               newRotation(settings.Rotation),
+		toast:                  toast,
+		settings:               settings,
+		settingsModTime:        data.SettingsModTime(),
+		selectionCtx:           requestsCtx,
 	}
 }
 
 // getStatusBannerType returns the appropriate status banner type based on current model state.
-// Priority: Debug > Dev > New Version > None
+// Priority: Degraded > Debug > Dev > New Version > None
 func (m model) getStatusBannerType() constants.StatusBannerType {
+	if len(httpx.OpenCircuits()) > 0 {
+		return constants.StatusBannerDegraded
+	}
 	if m.debugMode {
 		return constants.StatusBannerDebug
 	}
@@ -305,21 +485,42 @@ func (m model) getHeaderContentHeight() int {
 		return 1
 	}
 
-	// Header typically has: title, teams, score, league, venue, date, referee, attendance
-	height := 8 // Base header height
+	// Header typically has: title, teams, score, scorers, league, date, venue/referee/attendance
+	height := 7 // Base header height
 
 	// Add lines for optional fields
-	if m.matchDetails.Referee != "" {
+	if m.matchDetails.Venue != "" || m.matchDetails.Referee != "" || m.matchDetails.Attendance > 0 {
 		height++
 	}
-	if m.matchDetails.Attendance > 0 {
+	if slices.ContainsFunc(m.matchDetails.Events, func(e api.MatchEvent) bool { return e.Type == "goal" }) {
+		height++ // Scorers line
+	}
+	if m.matchDetails.HomeXG != nil {
 		height++
 	}
+	if m.matchDetails.Status == api.MatchStatusNotStarted && (m.matchDetails.HomeLineup != nil || m.matchDetails.AwayLineup != nil) {
+		height++ // Line-ups line
+	}
+	if len(m.broadcasts[m.matchDetails.ID]) > 0 {
+		height++ // Watch on line
+	}
+	if details := m.withStandings(m.matchDetails); details.Group != nil {
+		height += len(details.Group.Entries) + 2 // Blank line and group name
+	}
+	if details := m.withStandings(m.matchDetails); len(details.Scenarios) > 0 {
+		height += len(details.Scenarios) + 2
+	}
 
 	return height
 }
 
 // Init initializes the application.
 func (m model) Init() tea.Cmd {
-	return tea.Batch(m.spinner.Tick, ui.SpinnerTick())
+	cmds := []tea.Cmd{m.spinner.Tick, ui.SpinnerTick(), m.startRotation(), scheduleSettingsCheck(), waitForParty(m.party)}
+	if m.toast != "" {
+		cmds = append(cmds, tea.Tick(CrashNoticeDuration, func(time.Time) tea.Msg {
+			return toastClearMsg{id: m.toastID}
+		}))
+	}
+	return tea.Batch(cmds...)
 }
@@ -0,0 +1,298 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/0xjuanma/golazo/internal/api"
+	"github.com/0xjuanma/golazo/internal/companion"
+	"github.com/0xjuanma/golazo/internal/ui"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// partySendTimeout bounds sending a reaction or offset to the party host.
+const partySendTimeout = 10 * time.Second
+
+// Party connects the TUI to a watch party: the one it hosts with the web companion
+// (golazo --serve) or one hosted by another golazo (golazo --join).
+type Party struct {
+	host   *companion.Server      // Set when hosting
+	guest  *companion.PartyClient // Set when joined
+	states <-chan companion.Party
+}
+
+// HostParty makes the TUI host the watch party of the companion server.
+func HostParty(server *companion.Server) *Party {
+	states, _ := server.SubscribeParty()
+	return &Party{host: server, states: states}
+}
+
+// JoinParty makes the TUI a guest of the watch party served at baseURL.
+func JoinParty(baseURL string) *Party {
+	guest := companion.NewPartyClient(baseURL)
+	states := make(chan companion.Party, 1)
+	go guest.Follow(context.Background(), states)
+	return &Party{guest: guest, states: states}
+}
+
+// WithParty connects the TUI model to a watch party. Apply it before WithCompanion.
+func WithParty(m tea.Model, party *Party) tea.Model {
+	if mm, ok := m.(model); ok {
+		mm.party = party
+		return mm
+	}
+	return m
+}
+
+// partyUpdatedMsg carries a new watch party state.
+type partyUpdatedMsg struct {
+	state companion.Party
+}
+
+// partySentMsg reports sending a reaction or offset to the party.
+type partySentMsg struct {
+	err error
+}
+
+// waitForParty waits for the next watch party state.
+func waitForParty(party *Party) tea.Cmd {
+	if party == nil {
+		return nil
+	}
+	return func() tea.Msg {
+		state, ok := <-party.states
+		if !ok {
+			return nil
+		}
+		return partyUpdatedMsg{state: state}
+	}
+}
+
+// react shares a reaction with the party.
+func (p *Party) react(r companion.Reaction) tea.Cmd {
+	return p.send(func(ctx context.Context) error {
+		if p.host != nil {
+			return p.host.React(r)
+		}
+		return p.guest.React(ctx, r)
+	})
+}
+
+// setOffset shares a spoiler offset with the party.
+func (p *Party) setOffset(offset time.Duration) tea.Cmd {
+	return p.send(func(ctx context.Context) error {
+		if p.host != nil {
+			return p.host.SetOffset(offset)
+		}
+		return p.guest.SetOffset(ctx, offset)
+	})
+}
+
+// send runs a party request in the background.
+func (p *Party) send(request func(ctx context.Context) error) tea.Cmd {
+	return func() tea.Msg {
+		ctx, cancel := context.WithTimeout(context.Background(), partySendTimeout)
+		defer cancel()
+		return partySentMsg{err: request(ctx)}
+	}
+}
+
+// handlePartyUpdated applies a new watch party state: its offset replaces the local one.
+func (m model) handlePartyUpdated(msg partyUpdatedMsg) (tea.Model, tea.Cmd) {
+	cmds := []tea.Cmd{waitForParty(m.party)}
+	offset := msg.state.Offset()
+	if offset != m.spoilerOffset {
+		cmds = append(cmds, m.showToast("Watch party offset: "+formatOffset(offset)))
+	}
+	m.partyState = msg.state
+	m.spoilerOffset = offset
+	return m, tea.Batch(cmds...)
+}
+
+// parseOffset parses a spoiler offset: a duration ("45s", "2m") or seconds ("45").
+func parseOffset(s string) (time.Duration, error) {
+	offset, err := time.ParseDuration(s)
+	if err != nil {
+		seconds, convErr := strconv.Atoi(s)
+		if convErr != nil {
+			return 0, fmt.Errorf("invalid offset %q (e.g. 45s, 2m)", s)
+		}
+		offset = time.Duration(seconds) * time.Second
+	}
+	offset = offset.Truncate(time.Second)
+	if err := companion.ValidateOffset(offset); err != nil {
+		return 0, err
+	}
+	return offset, nil
+}
+
+// formatOffset formats a spoiler offset for display ("off", "45s", "2m30s").
+func formatOffset(offset time.Duration) string {
+	if offset <= 0 {
+		return "off"
+	}
+	s := offset.String()
+	if strings.HasSuffix(s, "m0s") {
+		s = strings.TrimSuffix(s, "0s")
+	}
+	return s
+}
+
+// delayedPoll is a details poll of the selected match, kept to show it behind the
+// spoiler offset.
+type delayedPoll struct {
+	at      time.Time
+	details *api.MatchDetails
+	updates []string
+}
+
+// recordDelayed keeps a details poll of the selected match for the spoiler offset,
+// forgetting those older than the longest offset needs.
+func (m *model) recordDelayed(details *api.MatchDetails) {
+	if len(m.delayed) > 0 && m.delayed[0].details.ID != details.ID {
+		m.delayed = nil
+	}
+	now := time.Now()
+	m.delayed = append(m.delayed, delayedPoll{at: now, details: details, updates: slices.Clone(m.liveUpdates)})
+	cutoff := now.Add(-companion.MaxPartyOffset)
+	for len(m.delayed) > 1 && m.delayed[1].at.Before(cutoff) {
+		m.delayed = m.delayed[1:]
+	}
+}
+
+// delayedView returns the selected match details and live updates to show: the latest
+// poll older than the spoiler offset, or the oldest one kept until one is.
+func (m model) delayedView() (*api.MatchDetails, []string) {
+	if m.spoilerOffset <= 0 || m.matchDetails == nil || len(m.delayed) == 0 || m.delayed[0].details.ID != m.matchDetails.ID {
+		return m.matchDetails, m.liveUpdates
+	}
+	shown := m.delayed[0]
+	cutoff := time.Now().Add(-m.spoilerOffset)
+	for _, poll := range m.delayed[1:] {
+		if poll.at.After(cutoff) {
+			break
+		}
+		shown = poll
+	}
+	return shown.details, shown.updates
+}
+
+// withReactions merges the party's reactions on the match into its live updates (newest
+// first), one row per minute. Reactions past the match clock stay hidden, so a guest
+// further behind isn't spoiled by one further ahead.
+func (m model) withReactions(updates []string, details *api.MatchDetails) []string {
+	if details == nil || len(m.partyState.Reactions) == 0 {
+		return updates
+	}
+	latest := reactionMinute(details)
+	emojis := make(map[int]string)
+	for _, r := range m.partyState.Reactions {
+		if r.MatchID == details.ID && (latest < 0 || r.Minute <= latest) {
+			emojis[r.Minute] += r.Emoji
+		}
+	}
+	if len(emojis) == 0 {
+		return updates
+	}
+	minutes := make([]int, 0, len(emojis))
+	for minute := range emojis {
+		minutes = append(minutes, minute)
+	}
+	slices.Sort(minutes)
+
+	merged := make([]string, 0, len(updates)+len(minutes))
+	for _, update := range updates {
+		// A minute's reactions go above its events: they came after them
+		for n := len(minutes); n > 0; n-- {
+			minute, ok := feedMinute(update)
+			if !ok || minutes[n-1] < minute {
+				break
+			}
+			merged = append(merged, ui.ReactionUpdate(minutes[n-1], emojis[minutes[n-1]]))
+			minutes = minutes[:n-1]
+		}
+		merged = append(merged, update)
+	}
+	for n := len(minutes); n > 0; n-- {
+		merged = append(merged, ui.ReactionUpdate(minutes[n-1], emojis[minutes[n-1]]))
+	}
+	return merged
+}
+
+// feedMinute returns the minute of a live update ("● 67' [GOAL] ...").
+func feedMinute(update string) (int, bool) {
+	_, rest, ok := strings.Cut(update, " ")
+	if !ok {
+		return 0, false
+	}
+	minute, _, ok := strings.Cut(rest, "'")
+	if !ok {
+		return 0, false
+	}
+	n, err := strconv.Atoi(minute)
+	return n, err == nil
+}
+
+// reactionMinute returns the match minute a reaction made now belongs to: the clock's
+// minute, the end of the half during a break, or -1 when the match isn't live.
+func reactionMinute(details *api.MatchDetails) int {
+	if details.Status != api.MatchStatusLive {
+		return -1
+	}
+	clock := details.Clock
+	if clock == nil && details.LiveTime != nil {
+		clock = api.ParseClock(*details.LiveTime)
+	}
+	if clock == nil {
+		return -1
+	}
+	switch clock.Period {
+	case api.PeriodHalfTime:
+		return api.HalfMinutes
+	case api.PeriodExtraTimeBreak:
+		if clock.Minute > 0 {
+			return clock.Minute
+		}
+		return 2 * api.HalfMinutes
+	case api.PeriodPenalties:
+		return 2 * (api.HalfMinutes + api.ExtraHalfMinutes)
+	}
+	return clock.Minute
+}
+
+// reactCommand shares a reaction at the minute of the selected match shown (":react 🔥").
+func (m model) reactCommand(emoji string) (tea.Cmd, error) {
+	if m.party == nil {
+		return nil, fmt.Errorf("not in a watch party (golazo --serve or --join)")
+	}
+	details, _ := m.delayedView()
+	if details == nil {
+		return nil, fmt.Errorf("no match selected")
+	}
+	minute := reactionMinute(details)
+	if minute < 0 {
+		return nil, fmt.Errorf("match is not live")
+	}
+	reaction := companion.Reaction{MatchID: details.ID, Minute: minute, Emoji: emoji}
+	if err := companion.ValidateReaction(reaction); err != nil {
+		return nil, err
+	}
+	return m.party.react(reaction), nil
+}
+
+// offsetCommand sets the spoiler offset (":offset 45s"), for the whole party when in one.
+func (m model) offsetCommand(s string) (model, tea.Cmd, error) {
+	offset, err := parseOffset(s)
+	if err != nil {
+		return m, nil, err
+	}
+	if m.party != nil {
+		return m, m.party.setOffset(offset), nil
+	}
+	m.spoilerOffset = offset
+	return m, m.showToast("Spoiler offset: " + formatOffset(offset)), nil
+}
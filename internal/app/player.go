@@ -0,0 +1,55 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/0xjuanma/golazo/internal/api"
+	"github.com/0xjuanma/golazo/internal/fotmob"
+	"github.com/0xjuanma/golazo/internal/trace"
+	"github.com/0xjuanma/golazo/internal/ui"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// playerPageMsg carries a player's page: profile, season stats, recent ratings and injury status.
+type playerPageMsg struct {
+	playerID int
+	page     *api.PlayerPage
+	err      error
+}
+
+// fetchPlayerPage fetches the page of a player.
+func fetchPlayerPage(client *fotmob.Client, playerID int) tea.Cmd {
+	return func() tea.Msg {
+		ctx, cancel := context.WithTimeout(trace.Start(requestsCtx, fmt.Sprintf("fetch player %d", playerID)), 10*time.Second)
+		defer cancel()
+
+		page, err := client.Player(ctx, playerID)
+		return playerPageMsg{playerID: playerID, page: page, err: err}
+	}
+}
+
+// openPlayerPage opens the player dialog on top of the lineup and fetches the page.
+func (m model) openPlayerPage(player api.PlayerInfo) (tea.Model, tea.Cmd) {
+	dialog := ui.NewPlayerDialog(player)
+	m.dialogOverlay.OpenDialog(dialog)
+	if m.useMockData || m.fotmobClient == nil {
+		dialog.SetPage(nil)
+		return m, nil
+	}
+	return m, fetchPlayerPage(m.fotmobClient, player.ID)
+}
+
+// handlePlayerPage fills in the player dialog, if it is still open.
+func (m model) handlePlayerPage(msg playerPageMsg) (tea.Model, tea.Cmd) {
+	dialog, ok := m.dialogOverlay.FrontDialog().(*ui.PlayerDialog)
+	if !ok || dialog.PlayerID() != msg.playerID {
+		return m, nil
+	}
+	if msg.err != nil {
+		m.debugLog(fmt.Sprintf("Player page fetch failed for player %d: %v", msg.playerID, msg.err))
+	}
+	dialog.SetPage(msg.page)
+	return m, nil
+}
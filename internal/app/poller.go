@@ -0,0 +1,140 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/0xjuanma/golazo/internal/api"
+	"github.com/0xjuanma/golazo/internal/fotmob"
+	"github.com/0xjuanma/golazo/internal/trace"
+	"github.com/0xjuanma/golazo/internal/ui"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// BackgroundPollStagger spreads the first background polls of the live list apart.
+const BackgroundPollStagger = 3 * time.Second
+
+// backgroundPollTickMsg triggers a background poll of an unselected live match.
+type backgroundPollTickMsg struct {
+	matchID int
+}
+
+// backgroundPollMsg carries the details fetched by a background poll.
+type backgroundPollMsg struct {
+	matchID int
+	details *api.MatchDetails // nil if the fetch failed
+}
+
+// scheduleBackgroundPoll schedules the next background poll of a match after delay.
+func scheduleBackgroundPoll(matchID int, delay time.Duration) tea.Cmd {
+	return tea.Tick(delay, func(time.Time) tea.Msg {
+		return backgroundPollTickMsg{matchID: matchID}
+	})
+}
+
+// fetchBackgroundPoll fetches a live match's details behind every other startup request.
+func fetchBackgroundPoll(client *fotmob.Client, matchID int) tea.Cmd {
+	return func() tea.Msg {
+		ctx, cancel := context.WithTimeout(trace.Start(requestsCtx, fmt.Sprintf("background poll %d", matchID)), 10*time.Second)
+		defer cancel()
+		awaitStartupTurn(ctx, priorityPrefetch)
+
+		details, err := client.MatchDetails(ctx, matchID)
+		if err != nil {
+			return backgroundPollMsg{matchID: matchID}
+		}
+		return backgroundPollMsg{matchID: matchID, details: details}
+	}
+}
+
+// startBackgroundPolls starts polling the live matches of the list that aren't polled
+// yet, so their scores stay current between list refreshes.
+func (m *model) startBackgroundPolls() tea.Cmd {
+	if m.useMockData || m.fotmobClient == nil {
+		return nil
+	}
+	if m.backgroundPolls == nil {
+		m.backgroundPolls = make(map[int]bool)
+	}
+	var cmds []tea.Cmd
+	for _, match := range m.matches {
+		if match.Status != api.MatchStatusLive || m.backgroundPolls[match.ID] {
+			continue
+		}
+		m.backgroundPolls[match.ID] = true
+		delay := backgroundPollInterval(match.Match) + time.Duration(len(cmds))*BackgroundPollStagger
+		cmds = append(cmds, scheduleBackgroundPoll(match.ID, throttle(delay, m.powerSaver)))
+	}
+	return tea.Batch(cmds...)
+}
+
+// handleBackgroundPollTick polls an unselected live match. Polling stops once the match
+// leaves the live list or the live view is left; the selected match is polled by
+// handlePollTick instead, so its background poll just waits for the next tick.
+func (m model) handleBackgroundPollTick(msg backgroundPollTickMsg) (tea.Model, tea.Cmd) {
+	match, ok := m.liveListMatch(msg.matchID)
+	if m.baseView() != viewLiveMatches || !ok || match.Status != api.MatchStatusLive {
+		delete(m.backgroundPolls, msg.matchID)
+		return m, nil
+	}
+	if m.selectedMatchID == msg.matchID || m.fotmobClient.Strained() {
+		return m, scheduleBackgroundPoll(msg.matchID, throttle(backgroundPollInterval(match), m.powerSaver))
+	}
+	return m, fetchBackgroundPoll(m.fotmobClient, msg.matchID)
+}
+
+// handleBackgroundPoll updates a live list item with the polled score and minute, and
+// schedules the next poll unless the match has reached full time.
+func (m model) handleBackgroundPoll(msg backgroundPollMsg) (tea.Model, tea.Cmd) {
+	match, ok := m.liveListMatch(msg.matchID)
+	if !ok {
+		delete(m.backgroundPolls, msg.matchID)
+		return m, nil
+	}
+
+	var cmds []tea.Cmd
+	if msg.details != nil {
+		match.HomeScore = msg.details.HomeScore
+		match.AwayScore = msg.details.AwayScore
+		match.LiveTime = msg.details.LiveTime
+		match.Clock = msg.details.Clock
+		match.Status = msg.details.Status
+		cmds = append(cmds, m.updateLiveItem(match))
+	}
+	if match.Status != api.MatchStatusLive {
+		delete(m.backgroundPolls, msg.matchID)
+		return m, tea.Batch(cmds...)
+	}
+	cmds = append(cmds, scheduleBackgroundPoll(msg.matchID, throttle(backgroundPollInterval(match), m.powerSaver)))
+	return m, tea.Batch(cmds...)
+}
+
+// liveListMatch returns the match of the live list with the given ID.
+func (m model) liveListMatch(matchID int) (api.Match, bool) {
+	for _, display := range m.matches {
+		if display.ID == matchID {
+			return display.Match, true
+		}
+	}
+	return api.Match{}, false
+}
+
+// updateLiveItem replaces a match of the live list, keeping the selection and filter.
+func (m *model) updateLiveItem(match api.Match) tea.Cmd {
+	for i := range m.matches {
+		if m.matches[i].ID == match.ID {
+			m.matches[i].Match = match
+		}
+	}
+	for i, item := range m.liveMatchesList.Items() {
+		listItem, ok := item.(ui.MatchListItem)
+		if !ok || listItem.Match.ID != match.ID {
+			continue
+		}
+		listItem.Match = match
+		listItem.Display.Match = match
+		return m.liveMatchesList.SetItem(i, listItem)
+	}
+	return nil
+}
@@ -0,0 +1,95 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/0xjuanma/golazo/internal/fotmob"
+	"github.com/0xjuanma/golazo/internal/trace"
+	"github.com/0xjuanma/golazo/internal/ui"
+	"github.com/charmbracelet/bubbles/list"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// Once a match has been selected for PrefetchDelay, the details of the PrefetchNeighbors
+// matches before and after it in the list are fetched in the background, so moving the
+// cursor shows them from cache.
+const (
+	PrefetchNeighbors = 2
+	PrefetchDelay     = 500 * time.Millisecond
+)
+
+// prefetchAdjacent prefetches the details of the matches around matchID in matchList.
+// The prefetch runs on the selection's context: selecting another match cancels it.
+func (m model) prefetchAdjacent(ctx context.Context, matchList list.Model, matchID int) tea.Cmd {
+	if m.useMockData || m.fotmobClient == nil {
+		return nil
+	}
+	ids := adjacentMatchIDs(matchList, matchID, PrefetchNeighbors)
+	if len(ids) == 0 {
+		return nil
+	}
+	return prefetchMatchDetails(ctx, m.fotmobClient, ids)
+}
+
+// adjacentMatchIDs returns the IDs of the n matches on each side of matchID among the
+// visible list items, nearest first, alternating next and previous.
+func adjacentMatchIDs(matchList list.Model, matchID, n int) []int {
+	var ids []int
+	for _, item := range matchList.VisibleItems() {
+		if match, ok := item.(ui.MatchListItem); ok {
+			ids = append(ids, match.Match.ID)
+		}
+	}
+
+	index := -1
+	for i, id := range ids {
+		if id == matchID {
+			index = i
+			break
+		}
+	}
+	if index < 0 {
+		return nil
+	}
+
+	var adjacent []int
+	for step := 1; step <= n; step++ {
+		if i := index + step; i < len(ids) {
+			adjacent = append(adjacent, ids[i])
+		}
+		if i := index - step; i >= 0 {
+			adjacent = append(adjacent, ids[i])
+		}
+	}
+	return adjacent
+}
+
+// prefetchMatchDetails fetches the details of matchIDs one at a time, after PrefetchDelay,
+// skipping those already cached. Prefetches queue behind every other startup request and
+// stop while FotMob is failing or rate limiting requests. Produces no message.
+func prefetchMatchDetails(ctx context.Context, client *fotmob.Client, matchIDs []int) tea.Cmd {
+	return func() tea.Msg {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(PrefetchDelay):
+		}
+
+		for _, matchID := range matchIDs {
+			if ctx.Err() != nil || client.Strained() {
+				return nil
+			}
+			if client.Cache().HasDetails(matchID) {
+				continue
+			}
+
+			fetchCtx, cancel := context.WithTimeout(trace.Start(ctx, fmt.Sprintf("prefetch match %d", matchID)), 10*time.Second)
+			awaitStartupTurn(fetchCtx, priorityPrefetch)
+			_, _ = client.MatchDetails(fetchCtx, matchID)
+			cancel()
+		}
+		return nil
+	}
+}
@@ -0,0 +1,222 @@
+package app
+
+import (
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/0xjuanma/golazo/internal/data"
+	"github.com/0xjuanma/golazo/internal/eventlog"
+	"github.com/0xjuanma/golazo/internal/fotmob"
+	"github.com/0xjuanma/golazo/internal/reddit"
+	"github.com/0xjuanma/golazo/internal/ui"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// SettingsReloadInterval is how often settings.yaml is checked for changes.
+const SettingsReloadInterval = 2 * time.Second
+
+// settingsCheckMsg checks settings.yaml for changes.
+type settingsCheckMsg struct{}
+
+// settingsReloadedMsg carries settings.yaml after it changed on disk; err is set
+// when the file could not be read or is invalid.
+type settingsReloadedMsg struct {
+	modTime  time.Time
+	settings *data.Settings
+	err      error
+}
+
+// scheduleSettingsCheck checks settings.yaml for changes after SettingsReloadInterval.
+func scheduleSettingsCheck() tea.Cmd {
+	return tea.Tick(SettingsReloadInterval, func(time.Time) tea.Msg {
+		return settingsCheckMsg{}
+	})
+}
+
+// checkSettings reloads settings.yaml when its modification time differs from the one
+// last applied. Returns nil when it is unchanged.
+func checkSettings(applied time.Time) tea.Cmd {
+	return func() tea.Msg {
+		modTime := data.SettingsModTime()
+		if modTime.Equal(applied) {
+			return nil
+		}
+		settings, err := data.ReadSettings()
+		if err == nil {
+			err = ValidateSettings(settings)
+		}
+		if err == nil {
+			err = ui.ValidateMatchItemTemplate(settings.ListItemTemplate)
+		}
+		return settingsReloadedMsg{modTime: modTime, settings: settings, err: err}
+	}
+}
+
+// ValidateSettings returns an error naming the first invalid setting. The list item
+// template is checked separately, by ui.SetMatchItemTemplate.
+func ValidateSettings(settings *data.Settings) error {
+	checks := []struct {
+		key string
+		err error
+	}{
+		{"country", data.ValidateCountry(settings.Country)},
+		{"finished_columns", ui.ValidateFinishedColumns(settings.FinishedColumns)},
+		{"power_saver", data.ValidatePowerSaver(settings.PowerSaver)},
+		{"fotmob_signing", data.ValidateFotmobSigning(settings.FotmobSigning)},
+		{"fotmob_mirrors", data.ValidateFotmobMirrors(settings.FotmobMirrors)},
+		{"rotation", data.ValidateRotation(settings.Rotation)},
+		{"alerts", data.ValidateAlerts(settings.Alerts)},
+		{"cache_ttl", data.ValidateCacheTTL(settings.CacheTTL)},
+		{"goal_search_windows", data.ValidateGoalSearchWindows(settings.GoalSearchWindows)},
+		{"macros", validateMacros(settings.Macros)},
+	}
+	for _, check := range checks {
+		if check.err != nil {
+			return fmt.Errorf("%s in settings.yaml: %w", check.key, check.err)
+		}
+	}
+	return nil
+}
+
+// handleSettingsCheck looks for settings changes and schedules the next check.
+func (m model) handleSettingsCheck() (tea.Model, tea.Cmd) {
+	return m, tea.Batch(checkSettings(m.settingsModTime), scheduleSettingsCheck())
+}
+
+// handleSettingsReloaded applies changed settings without a restart. Invalid settings
+// are reported in a toast and the current ones are kept. Settings read on use (leagues,
+// followed teams, daily note, macros) need no applying; connection settings apply after a restart.
+func (m model) handleSettingsReloaded(msg settingsReloadedMsg) (tea.Model, tea.Cmd) {
+	m.settingsModTime = msg.modTime
+	if msg.err != nil {
+		return m, m.showToast("Settings not applied: " + msg.err.Error())
+	}
+
+	previous, settings := m.settings, msg.settings
+	m.settings = settings
+	_ = ui.SetMatchItemTemplate(settings.ListItemTemplate) // Validated by checkSettings
+
+	liveDensity := ui.ParseDensity(settings.Density[data.DensityLiveView])
+	statsDensity := ui.ParseDensity(settings.Density[data.DensityFinishedView])
+	quiet := onlyDensityChanged(previous, settings) && liveDensity == m.liveDensity && statsDensity == m.statsDensity
+
+	var cmds []tea.Cmd
+	if !slices.Equal(previous.FinishedColumns, settings.FinishedColumns) {
+		m.finishedColumns = settings.FinishedColumns
+		cmds = append(cmds, m.refreshFinishedItems())
+	}
+	m.applyDensity(liveDensity, statsDensity)
+
+	m.replaySpeed = settings.ReplaySpeed
+	if m.replaySpeed <= 0 {
+		m.replaySpeed = DefaultReplaySpeed
+	}
+	m.powerSaver = settings.PowerSaver
+	if settings.Country != previous.Country {
+		m.broadcasts = make(map[int][]string) // Fetched again for the new country
+	}
+	m.launcher = ui.NewLauncher(settings.PlayerCommand)
+
+	if m.redditClient != nil && (settings.GoalLinkCacheMaxEntries != previous.GoalLinkCacheMaxEntries || settings.GoalLinkCacheDays != previous.GoalLinkCacheDays) {
+		_ = m.redditClient.Cache().SetLimits(settings.GoalLinkCacheMaxEntries, time.Duration(settings.GoalLinkCacheDays)*24*time.Hour)
+	}
+	if m.redditClient != nil && !reflect.DeepEqual(previous.GoalSearchWindows, settings.GoalSearchWindows) {
+		m.redditClient.SetSearchWindows(reddit.SearchWindowsFromSettings(settings))
+	}
+	if m.fotmobClient != nil && !reflect.DeepEqual(previous.CacheTTL, settings.CacheTTL) {
+		m.fotmobClient.Cache().SetTTLPolicy(fotmob.TTLPolicyFromSettings(settings))
+	}
+	if settings.EventLog != previous.EventLog && !m.useMockData {
+		m.eventLog = nil
+		if settings.EventLog {
+			m.eventLog, _ = eventlog.NewSink()
+		}
+	}
+
+	if !reflect.DeepEqual(previous.Rotation, settings.Rotation) {
+		nextID := 0
+		if m.rotation != nil {
+			nextID = m.rotation.id + 1 // Stale ticks of the old rotation are ignored
+		}
+		m.rotation = newRotation(settings.Rotation)
+		if m.rotation != nil {
+			m.rotation.id = nextID
+			cmds = append(cmds, m.startRotation())
+		}
+	}
+
+	if quiet {
+		return m, tea.Batch(cmds...) // Saved by golazo itself (e.g., "z" density), already in effect
+	}
+	message := "Settings reloaded"
+	if restart := restartOnlyChanges(previous, settings); len(restart) > 0 {
+		message = "Settings reloaded · restart to apply " + strings.Join(restart, ", ")
+	}
+	cmds = append(cmds, m.showToast(message))
+	return m, tea.Batch(cmds...)
+}
+
+// onlyDensityChanged reports whether settings differ from previous in density at most.
+func onlyDensityChanged(previous, settings *data.Settings) bool {
+	withPreviousDensity := *settings
+	withPreviousDensity.Density = previous.Density
+	return reflect.DeepEqual(*previous, withPreviousDensity)
+}
+
+// restartOnlyChanges names the changed settings that only apply at startup.
+func restartOnlyChanges(previous, settings *data.Settings) []string {
+	var keys []string
+	if previous.Proxy != settings.Proxy {
+		keys = append(keys, "proxy")
+	}
+	if previous.PrivacyMode != settings.PrivacyMode {
+		keys = append(keys, "privacy_mode")
+	}
+	if previous.FotmobSigning != settings.FotmobSigning {
+		keys = append(keys, "fotmob_signing")
+	}
+	if !slices.Equal(previous.FotmobMirrors, settings.FotmobMirrors) {
+		keys = append(keys, "fotmob_mirrors")
+	}
+	if previous.GoalLinkArchive != settings.GoalLinkArchive {
+		keys = append(keys, "goal_link_archive")
+	}
+	return keys
+}
+
+// applyDensity sets the list density of the live and finished views.
+func (m *model) applyDensity(live, finished ui.Density) {
+	if live != m.liveDensity {
+		m.liveDensity = live
+		delegate := ui.NewMatchListDelegateWithDensity(live)
+		m.liveMatchesList.SetDelegate(delegate)
+		m.upcomingMatchesList.SetDelegate(delegate)
+	}
+	if finished != m.statsDensity {
+		m.statsDensity = finished
+		m.statsMatchesList.SetDelegate(ui.NewMatchListDelegateWithDensity(finished))
+	}
+}
+
+// refreshFinishedItems re-renders every finished list item, e.g. after the
+// finished_columns setting changed.
+func (m *model) refreshFinishedItems() tea.Cmd {
+	var cmds []tea.Cmd
+	for i, item := range m.statsMatchesList.Items() {
+		listItem, ok := item.(ui.MatchListItem)
+		if !ok {
+			continue
+		}
+		listItem.Display = m.finishedDisplay(listItem.Match)
+		for j := range m.matches {
+			if m.matches[j].ID == listItem.Match.ID {
+				m.matches[j] = listItem.Display
+			}
+		}
+		cmds = append(cmds, m.statsMatchesList.SetItem(i, listItem))
+	}
+	return tea.Batch(cmds...)
+}
@@ -0,0 +1,182 @@
+package app
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/0xjuanma/golazo/internal/api"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// Replay playback settings.
+const (
+	ReplayTickInterval = 250 * time.Millisecond
+	DefaultReplaySpeed = 2.0  // Match minutes per second (a half takes ~23s)
+	minReplaySpeed     = 0.5  // Slowest speed reachable with "-"
+	maxReplaySpeed     = 32.0 // Fastest speed reachable with "+"
+)
+
+// replayState tracks an accelerated, spoiler-free playback of a finished match.
+type replayState struct {
+	details *api.MatchDetails // Full details being replayed
+	minute  float64           // Current match minute
+	speed   float64           // Match minutes per second
+	id      int               // Playback generation; stale ticks are ignored
+}
+
+// replayTickMsg advances the replay clock.
+type replayTickMsg struct {
+	id int
+}
+
+// scheduleReplayTick advances replay generation id after ReplayTickInterval.
+func scheduleReplayTick(id int) tea.Cmd {
+	return tea.Tick(ReplayTickInterval, func(t time.Time) tea.Msg {
+		return replayTickMsg{id: id}
+	})
+}
+
+// endMinute returns the minute at which the replay reaches full time.
+func (r *replayState) endMinute() float64 {
+	end := r.details.MatchDuration
+	if end == 0 {
+		end = 90
+	}
+	for _, e := range r.details.Events {
+		end = max(end, e.Minute)
+	}
+	return float64(end + 1)
+}
+
+// done reports whether playback has reached full time.
+func (r *replayState) done() bool {
+	return r.minute >= r.endMinute()
+}
+
+// snapshot returns the match as it stood at the current replay minute: events and score
+// up to that minute, shown as live. Statistics, xG, half-time score and highlights are
+// withheld until they would have been known. Returns the full details once done.
+func (r *replayState) snapshot() *api.MatchDetails {
+	if r.done() {
+		return r.details
+	}
+
+	d := *r.details
+	minute := int(r.minute)
+
+	d.Events = nil
+	homeScore, awayScore := 0, 0
+	for _, e := range r.details.Events {
+		if e.Minute > minute {
+			continue
+		}
+		d.Events = append(d.Events, e)
+		if e.Type == "goal" {
+			if e.Team.ID == r.details.HomeTeam.ID {
+				homeScore++
+			} else {
+				awayScore++
+			}
+		}
+	}
+
+	liveTime := fmt.Sprintf("%d'", minute)
+	d.Status = api.MatchStatusLive
+	d.LiveTime = &liveTime
+	d.Clock = api.ParseClock(liveTime)
+	d.HomeScore = &homeScore
+	d.AwayScore = &awayScore
+	d.Winner = nil
+	d.Shootout = nil
+	d.Statistics = nil
+	d.HomeXG = nil
+	d.AwayXG = nil
+	d.Shots = nil
+	for _, s := range r.details.Shots {
+		if s.Minute <= minute {
+			d.Shots = append(d.Shots, s)
+		}
+	}
+	if len(r.details.Shots) > 0 {
+		homeXG := api.ShotsXG(d.Shots, d.HomeTeam.ID)
+		awayXG := api.ShotsXG(d.Shots, d.AwayTeam.ID)
+		d.HomeXG, d.AwayXG = &homeXG, &awayXG
+	}
+	d.Momentum = nil
+	for _, p := range r.details.Momentum {
+		if p.Minute <= float64(minute) {
+			d.Momentum = append(d.Momentum, p)
+		}
+	}
+	d.Highlight = nil
+	d.PlayerOfTheMatchID = 0
+	if minute <= 45 {
+		d.HalfTimeScore = nil
+		d.FirstHalfStatistics = nil
+	}
+	return &d
+}
+
+// toggleReplay starts a replay of the selected finished match, or stops the current one.
+func (m *model) toggleReplay() tea.Cmd {
+	if m.replay != nil {
+		m.replay = nil
+		return m.showToast("Replay stopped")
+	}
+	if m.matchDetails == nil || m.matchDetails.Status != api.MatchStatusFinished {
+		return nil
+	}
+
+	m.replayID++
+	m.replay = &replayState{
+		details: m.matchDetails,
+		speed:   m.replaySpeed,
+		id:      m.replayID,
+	}
+	m.statsScrollOffset = 0
+	return tea.Batch(
+		m.showToast(fmt.Sprintf("Replay ▶ %gx", m.replay.speed)),
+		scheduleReplayTick(m.replayID),
+	)
+}
+
+// changeReplaySpeed doubles (faster) or halves the replay speed.
+func (m *model) changeReplaySpeed(faster bool) tea.Cmd {
+	if m.replay == nil {
+		return nil
+	}
+	if faster && m.replay.speed < maxReplaySpeed {
+		m.replay.speed *= 2
+	} else if !faster && m.replay.speed > minReplaySpeed {
+		m.replay.speed /= 2
+	}
+	return m.showToast(fmt.Sprintf("Replay ▶ %gx", m.replay.speed))
+}
+
+// handleReplayTick advances the replay clock, stopping at full time or when the
+// selected match changes.
+func (m model) handleReplayTick(msg replayTickMsg) (tea.Model, tea.Cmd) {
+	if m.replay == nil || msg.id != m.replay.id {
+		return m, nil
+	}
+	if m.matchDetails == nil || m.matchDetails.ID != m.replay.details.ID {
+		m.replay = nil
+		return m, nil
+	}
+
+	m.replay.minute += m.replay.speed * ReplayTickInterval.Seconds()
+	if m.replay.done() {
+		m.replay = nil // Full details are shown again
+		return m, m.showToast("Replay finished")
+	}
+	return m, scheduleReplayTick(m.replay.id)
+}
+
+// statsDisplayDetails returns the details shown in the stats view:
+// the replay snapshot while replaying the selected match, otherwise the real details.
+func (m model) statsDisplayDetails() *api.MatchDetails {
+	if m.replay != nil && m.matchDetails != nil && m.replay.details.ID == m.matchDetails.ID {
+		return m.replay.snapshot()
+	}
+	return m.withStandings(m.matchDetails)
+}
@@ -0,0 +1,145 @@
+package app
+
+import (
+	"time"
+
+	"github.com/0xjuanma/golazo/internal/data"
+	"github.com/0xjuanma/golazo/internal/ui"
+	"github.com/charmbracelet/bubbles/list"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// rotationStartDelay is how long the main menu shows before rotation enters its first view.
+const rotationStartDelay = 3 * time.Second
+
+// rotationState cycles through views and their matches hands-free (rotation setting):
+// each match is shown for its view's dwell time, and after the last match the next
+// view is entered.
+type rotationState struct {
+	views  []view
+	dwell  map[view]time.Duration
+	index  int // Current position in views
+	paused bool
+	id     int // Incremented per schedule so stale ticks are ignored
+}
+
+// rotationTickMsg advances the rotation.
+type rotationTickMsg struct {
+	id int
+}
+
+// newRotation builds the rotation from settings; nil when rotation is off.
+func newRotation(settings *data.RotationSettings) *rotationState {
+	if settings == nil || len(settings.Views) == 0 {
+		return nil
+	}
+	r := &rotationState{dwell: make(map[view]time.Duration)}
+	for _, name := range settings.Views {
+		v := viewLiveMatches
+		if name == data.RotationFinished {
+			v = viewStats
+		}
+		r.views = append(r.views, v)
+		r.dwell[v] = settings.DwellFor(name)
+	}
+	return r
+}
+
+// scheduleRotationTick fires a rotationTickMsg after delay.
+func scheduleRotationTick(id int, delay time.Duration) tea.Cmd {
+	return tea.Tick(delay, func(time.Time) tea.Msg {
+		return rotationTickMsg{id: id}
+	})
+}
+
+// startRotation schedules the first rotation step; nil when rotation is off.
+func (m model) startRotation() tea.Cmd {
+	if m.rotation == nil {
+		return nil
+	}
+	return scheduleRotationTick(m.rotation.id, rotationStartDelay)
+}
+
+// toggleRotationPause pauses or resumes the rotation.
+func (m *model) toggleRotationPause() tea.Cmd {
+	r := m.rotation
+	r.paused = !r.paused
+	r.id++
+	if r.paused {
+		return m.showToast("Rotation paused")
+	}
+	return tea.Batch(m.showToast("Rotation resumed"), scheduleRotationTick(r.id, r.dwell[r.views[r.index]]))
+}
+
+// handleRotationTick shows the next match, or enters the next view after the last one.
+// Waits while loading, filtering, or a dialog, team page or goal feed is open.
+func (m model) handleRotationTick(msg rotationTickMsg) (tea.Model, tea.Cmd) {
+	r := m.rotation
+	if r == nil || r.paused || msg.id != r.id {
+		return m, nil
+	}
+	target := r.views[r.index]
+	next := scheduleRotationTick(r.id, r.dwell[target])
+
+	busy := m.mainViewLoading || m.liveViewLoading || m.statsViewLoading ||
+		(m.dialogOverlay != nil && m.dialogOverlay.HasDialogs())
+	if busy || m.currentView == viewSettings || m.currentView == viewTeam || m.currentView == viewGoalFeed {
+		return m, next
+	}
+
+	// Enter the rotation's current view (at startup, or after the user left it)
+	if m.currentView != target {
+		return m.enterRotationView(target, next)
+	}
+
+	matchList := &m.liveMatchesList
+	if target == viewStats {
+		matchList = &m.statsMatchesList
+	}
+	if matchList.FilterState() != list.Unfiltered {
+		return m, next
+	}
+
+	idx := matchList.Index() + 1
+	if idx >= len(matchList.Items()) {
+		if len(r.views) > 1 {
+			r.index = (r.index + 1) % len(r.views)
+			return m.enterRotationView(r.views[r.index], scheduleRotationTick(r.id, r.dwell[r.views[r.index]]))
+		}
+		idx = 0
+	}
+	matchList.Select(idx)
+
+	item, ok := matchList.SelectedItem().(ui.MatchListItem)
+	if !ok {
+		return m, next
+	}
+	for i, match := range m.matches {
+		if match.ID == item.Match.ID {
+			m.selected = i
+			break
+		}
+	}
+
+	var updated tea.Model
+	var cmd tea.Cmd
+	if target == viewStats {
+		updated, cmd = m.loadStatsMatchDetails(item.Match.ID)
+	} else {
+		updated, cmd = m.loadMatchDetails(item.Match.ID)
+		cmd = tea.Batch(cmd, m.fetchSelectedLeagueTable())
+	}
+	return updated, tea.Batch(cmd, next)
+}
+
+// enterRotationView loads a view as if it was picked from the main menu.
+func (m model) enterRotationView(target view, next tea.Cmd) (tea.Model, tea.Cmd) {
+	reset, _ := m.resetToMainView()
+	m = reset.(model)
+	m.selected = 1 // Live Matches menu item
+	if target == viewStats {
+		m.selected = 0 // Finished Matches menu item
+	}
+	updated, cmd := m.handleMainViewKeys(tea.KeyMsg{Type: tea.KeyEnter})
+	return updated, tea.Batch(cmd, next)
+}
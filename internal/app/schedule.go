@@ -0,0 +1,142 @@
+package app
+
+import (
+	"time"
+
+	"github.com/0xjuanma/golazo/internal/api"
+	"github.com/0xjuanma/golazo/internal/data"
+	"github.com/0xjuanma/golazo/internal/power"
+)
+
+// Adaptive live polling. The selected live match is polled every PollInterval and the
+// other live matches of the list every BackgroundPollInterval; both speed up in the
+// closing stages (the last ClosingMinutes of regular or extra time, and stoppage time).
+// Polling stops at full time.
+const (
+	PollInterval                  = 20 * time.Second
+	ClosingPollInterval           = 15 * time.Second // Minimum safe interval, the live details cache TTL
+	BackgroundPollInterval        = 90 * time.Second
+	ClosingBackgroundPollInterval = 60 * time.Second
+	ClosingMinutes                = 10
+)
+
+// selectedPollInterval returns the interval between polls of the selected live match.
+func selectedPollInterval(match api.Match) time.Duration {
+	if closingStage(match) {
+		return ClosingPollInterval
+	}
+	return PollInterval
+}
+
+// backgroundPollInterval returns the interval between polls of an unselected live match.
+func backgroundPollInterval(match api.Match) time.Duration {
+	if closingStage(match) {
+		return ClosingBackgroundPollInterval
+	}
+	return BackgroundPollInterval
+}
+
+// closingStage reports whether a live match is in stoppage time or the last
+// ClosingMinutes of regular or extra time, from its clock ("87'", "90+3'", "HT").
+func closingStage(match api.Match) bool {
+	if match.Status != api.MatchStatusLive {
+		return false
+	}
+	clock := match.Clock
+	if clock == nil && match.LiveTime != nil {
+		clock = api.ParseClock(*match.LiveTime)
+	}
+	if clock == nil || clock.Minute == 0 {
+		return false // HT, Pen, ...
+	}
+	if clock.InStoppageTime() {
+		return true
+	}
+	minute := clock.Minute
+	return (minute > 90-ClosingMinutes && minute <= 90) || minute > 120-ClosingMinutes
+}
+
+// PowerSaverFactor lengthens poll and refresh intervals while saving power.
+const PowerSaverFactor = 2
+
+// powerSaving reports whether to save power for a power_saver setting:
+// "on" always, "off" never, otherwise ("auto") while running on battery.
+func powerSaving(mode string) bool {
+	switch mode {
+	case data.PowerSaverOn:
+		return true
+	case data.PowerSaverOff:
+		return false
+	default:
+		return power.OnBattery()
+	}
+}
+
+// throttle lengthens d by PowerSaverFactor while saving power.
+func throttle(d time.Duration, powerSaver string) time.Duration {
+	if powerSaving(powerSaver) {
+		return d * PowerSaverFactor
+	}
+	return d
+}
+
+// Kickoff-aware live list refresh timing. Between match windows the list is refreshed
+// rarely; it ramps up shortly before kickoffs and returns to LiveRefreshInterval while
+// matches are live.
+const (
+	KickoffRampLead     = 5 * time.Minute  // Start polling frequently this long before kickoff
+	KickoffRampInterval = 1 * time.Minute  // Refresh interval around kickoff until the match shows as live
+	KickoffRampTimeout  = 20 * time.Minute // Give up ramping this long after a kickoff that never went live (postponed, delayed)
+	IdleRefreshInterval = 30 * time.Minute // Refresh interval with no live or imminent matches
+)
+
+// nextLiveRefresh returns how long to wait before refreshing the live list, given the
+// live matches and today's upcoming fixtures.
+func nextLiveRefresh(now time.Time, live, upcoming []api.Match) time.Duration {
+	if len(live) > 0 {
+		return LiveRefreshInterval
+	}
+
+	var next time.Time
+	for _, match := range upcoming {
+		if match.MatchTime == nil || now.Sub(*match.MatchTime) > KickoffRampTimeout {
+			continue
+		}
+		if next.IsZero() || match.MatchTime.Before(next) {
+			next = *match.MatchTime
+		}
+	}
+	if next.IsZero() {
+		return IdleRefreshInterval // All of today's matches are over
+	}
+
+	untilRamp := next.Add(-KickoffRampLead).Sub(now)
+	switch {
+	case untilRamp <= 0:
+		return KickoffRampInterval
+	case untilRamp < IdleRefreshInterval:
+		return untilRamp // Wake up exactly when the ramp starts
+	default:
+		return IdleRefreshInterval
+	}
+}
+
+// liveRefreshDelay returns the delay before the next live list refresh.
+// Kickoffs come from the fixtures seen by live fetches and today's stats data.
+// Followed teams' fixtures drive the schedule when any teams are followed; otherwise
+// all fixtures do. Throttled while saving power.
+func (m model) liveRefreshDelay(live []api.Match) time.Duration {
+	if m.useMockData || m.fotmobClient == nil {
+		return throttle(LiveRefreshInterval, m.powerSaver)
+	}
+
+	upcoming := m.fotmobClient.UpcomingKickoffs()
+	if m.statsData != nil {
+		upcoming = append(upcoming, m.statsData.TodayUpcoming...)
+	}
+	if settings, err := data.LoadSettings(); err == nil && len(settings.FollowedTeams) > 0 {
+		live = settings.FollowedMatches(live)
+		upcoming = settings.FollowedMatches(upcoming)
+	}
+	return throttle(nextLiveRefresh(time.Now(), live, upcoming), m.powerSaver)
+}
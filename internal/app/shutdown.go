@@ -0,0 +1,90 @@
+package app
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/0xjuanma/golazo/internal/data"
+	"github.com/0xjuanma/golazo/internal/trace"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// ShutdownGrace is how long in-flight requests and background writes may take to
+// finish on quit before requests are cancelled.
+const ShutdownGrace = 2 * time.Second
+
+// CrashNotice is shown on the main menu for CrashNoticeDuration when the previous
+// session didn't shut down cleanly.
+const (
+	CrashNotice         = "golazo didn't shut down cleanly last time"
+	CrashNoticeDuration = 5 * time.Second
+)
+
+var (
+	// requestsCtx is the parent context of every request the TUI makes; Shutdown cancels it.
+	requestsCtx, cancelRequests = context.WithCancel(context.Background())
+
+	// pendingWrites tracks background persistence (shared live snapshot, event log).
+	pendingWrites sync.WaitGroup
+)
+
+// Shutdown ends the TUI session after the program exits (q, ctrl+c or SIGTERM).
+// In-flight requests get ShutdownGrace to finish before they are cancelled, caches
+// are flushed, and the session state is saved with the clean shutdown marker checked
+// on the next start.
+func Shutdown(final tea.Model) {
+	writesDone := make(chan struct{})
+	go func() {
+		pendingWrites.Wait()
+		close(writesDone)
+	}()
+
+	deadline := time.Now().Add(ShutdownGrace)
+	for trace.InFlight() > 0 && time.Now().Before(deadline) {
+		time.Sleep(50 * time.Millisecond)
+	}
+	cancelRequests()
+
+	select {
+	case <-writesDone:
+	case <-time.After(time.Until(deadline)):
+	}
+
+	if c, ok := final.(companionModel); ok {
+		final = c.Model
+	}
+	m, ok := final.(model)
+	if !ok {
+		_ = data.EndSession(data.Session{})
+		return
+	}
+	if m.fotmobClient != nil {
+		_ = m.fotmobClient.SaveEmptyCache()
+	}
+	_ = data.EndSession(m.session())
+}
+
+// session returns the state saved for the next start: the open view and match.
+func (m model) session() data.Session {
+	var session data.Session
+	switch m.baseView() {
+	case viewLiveMatches:
+		session.View = "live"
+	case viewStats:
+		session.View = "finished"
+	}
+	if m.matchDetails != nil {
+		session.MatchID = m.matchDetails.ID
+	}
+	return session
+}
+
+// persistInBackground runs write in the background; Shutdown waits for it to finish.
+func persistInBackground(write func()) {
+	pendingWrites.Add(1)
+	go func() {
+		defer pendingWrites.Done()
+		write()
+	}()
+}
@@ -0,0 +1,129 @@
+package app
+
+import (
+	"github.com/0xjuanma/golazo/internal/api"
+	"github.com/0xjuanma/golazo/internal/rules"
+	"github.com/0xjuanma/golazo/internal/ui"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// toggleStandingsSplit switches the live view between match details and the live league table.
+// Tables are fetched again each time the split opens, as results may have come in meanwhile.
+func (m *model) toggleStandingsSplit() tea.Cmd {
+	m.standingsSplit = !m.standingsSplit
+	m.leagueTables = make(map[int][]api.LeagueTableEntry)
+	m.updateLiveListSize()
+	return m.fetchSelectedLeagueTable()
+}
+
+// selectedLiveMatch returns the match selected in the live list.
+func (m model) selectedLiveMatch() (api.Match, bool) {
+	item, ok := m.liveMatchesList.SelectedItem().(ui.MatchListItem)
+	if !ok {
+		return api.Match{}, false
+	}
+	return item.Match, true
+}
+
+// fetchSelectedLeagueTable fetches the table of the selected match's league once per split.
+func (m model) fetchSelectedLeagueTable() tea.Cmd {
+	if !m.standingsSplit || m.fotmobClient == nil {
+		return nil
+	}
+	match, ok := m.selectedLiveMatch()
+	if !ok {
+		return nil
+	}
+	if _, requested := m.leagueTables[match.League.ID]; requested {
+		return nil
+	}
+	m.leagueTables[match.League.ID] = nil // In flight (stays nil when the league has no table)
+
+	fetch := fetchStandings(m.fotmobClient, match.League.ID, match.League.Name, match.League.ParentLeagueID, match.HomeTeam.ID, match.AwayTeam.ID)
+	return func() tea.Msg {
+		msg := fetch().(standingsMsg)
+		msg.panel = true
+		return msg
+	}
+}
+
+// displayedLiveStandings builds the split layout's table for the selected match's league,
+// or nil when the split layout is off.
+func (m model) displayedLiveStandings() *ui.LiveStandings {
+	if !m.standingsSplit {
+		return nil
+	}
+	match, ok := m.selectedLiveMatch()
+	if !ok {
+		return &ui.LiveStandings{}
+	}
+
+	table, requested := m.leagueTables[match.League.ID]
+	standings := &ui.LiveStandings{
+		League:     match.League.Name,
+		Loading:    requested && table == nil,
+		HomeTeamID: match.HomeTeam.ID,
+		AwayTeamID: match.AwayTeam.ID,
+	}
+	if len(table) > 0 {
+		standings.Rows = recalculateStandings(match.League.ID, table, m.liveScores())
+	}
+	return standings
+}
+
+// liveScores returns the matches in progress with their current score,
+// preferring the fresher score of the displayed match details.
+func (m model) liveScores() []api.Match {
+	var live []api.Match
+	for _, match := range m.matches {
+		if m.matchDetails != nil && m.matchDetails.ID == match.ID {
+			live = append(live, m.matchDetails.Match)
+			continue
+		}
+		live = append(live, match.Match)
+	}
+	return live
+}
+
+// recalculateStandings applies the current score of every match in progress between two
+// teams of the table, then re-sorts it with the competition's rules. Only the table's own
+// order is known for earlier results, so head-to-head criteria cannot separate teams here.
+// Moved compares each team's new position to the table before kick-off.
+func recalculateStandings(leagueID int, table []api.LeagueTableEntry, matches []api.Match) []ui.LiveStandingsRow {
+	competition := rules.For(leagueID)
+	entries := make([]api.LeagueTableEntry, len(table))
+	copy(entries, table)
+	index := make(map[int]int, len(entries))
+	before := make(map[int]int, len(entries))
+	for i, entry := range entries {
+		index[entry.Team.ID] = i
+		before[entry.Team.ID] = entry.Position
+	}
+
+	playing := make(map[int]bool)
+	for _, match := range matches {
+		if match.Status != api.MatchStatusLive || match.HomeScore == nil || match.AwayScore == nil {
+			continue
+		}
+		home, okHome := index[match.HomeTeam.ID]
+		away, okAway := index[match.AwayTeam.ID]
+		if !okHome || !okAway {
+			continue
+		}
+		competition.Apply(&entries[home], *match.HomeScore, *match.AwayScore)
+		competition.Apply(&entries[away], *match.AwayScore, *match.HomeScore)
+		playing[match.HomeTeam.ID] = true
+		playing[match.AwayTeam.ID] = true
+	}
+
+	sorted := competition.Sort(entries, nil)
+	rows := make([]ui.LiveStandingsRow, len(sorted))
+	for i, entry := range sorted {
+		rows[i] = ui.LiveStandingsRow{
+			LeagueTableEntry: entry,
+			Moved:            before[entry.Team.ID] - entry.Position,
+			Playing:          playing[entry.Team.ID],
+		}
+	}
+	return rows
+}
@@ -0,0 +1,42 @@
+package app
+
+import (
+	"context"
+	"time"
+
+	"github.com/0xjuanma/golazo/internal/httpx"
+)
+
+// Opening a view fires a burst of requests (league or live lists, the selected match's
+// details, standings, goal links). For StartupWindow after a view is opened, they are
+// released through a queue StartupSpacing apart, most urgent first, instead of all at
+// once, so the burst doesn't trip provider rate limits.
+const (
+	StartupWindow  = 10 * time.Second
+	StartupSpacing = 150 * time.Millisecond
+)
+
+// Startup request priorities, most urgent first.
+const (
+	priorityLeagueList = iota
+	priorityLiveList
+	prioritySelectedDetails
+	priorityStandings
+	priorityGoalLinks
+	priorityPrefetch
+)
+
+// startupQueue staggers the requests made while a view is starting up.
+var startupQueue = httpx.NewQueue(StartupSpacing)
+
+// beginStartup starts the startup sequence of a view that is being opened.
+func beginStartup() {
+	startupQueue.Open(StartupWindow)
+}
+
+// awaitStartupTurn blocks until a request of the given priority may start.
+// Returns immediately outside the startup window. A done ctx ends the wait early;
+// the request then fails on its own context.
+func awaitStartupTurn(ctx context.Context, priority int) {
+	_ = startupQueue.Wait(ctx, priority)
+}
@@ -0,0 +1,101 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/0xjuanma/golazo/internal/api"
+	"github.com/0xjuanma/golazo/internal/fotmob"
+	"github.com/0xjuanma/golazo/internal/trace"
+	"github.com/0xjuanma/golazo/internal/ui"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// teamPageMsg carries a team's page: position, form, fixtures and squad.
+type teamPageMsg struct {
+	teamID int
+	page   *api.TeamPage
+	err    error
+}
+
+// fetchTeamPage fetches the page of a team.
+func fetchTeamPage(client *fotmob.Client, teamID int) tea.Cmd {
+	return func() tea.Msg {
+		ctx, cancel := context.WithTimeout(trace.Start(requestsCtx, fmt.Sprintf("fetch team %d", teamID)), 10*time.Second)
+		defer cancel()
+
+		page, err := client.Team(ctx, teamID)
+		return teamPageMsg{teamID: teamID, page: page, err: err}
+	}
+}
+
+// openTeamPage closes the dialogs and shows the page of a team. Esc returns to the
+// view it was opened from.
+func (m model) openTeamPage(team api.Team) (tea.Model, tea.Cmd) {
+	for m.dialogOverlay.HasDialogs() {
+		m.dialogOverlay.CloseFrontDialog()
+	}
+	if m.currentView != viewTeam {
+		m.teamReturnView = m.currentView
+	}
+	m.currentView = viewTeam
+	m.team = team
+	m.teamPage = nil
+	m.teamScroll = 0
+	if m.useMockData || m.fotmobClient == nil || team.ID == 0 {
+		m.teamLoading = false
+		return m, nil
+	}
+	m.teamLoading = true
+	return m, fetchTeamPage(m.fotmobClient, team.ID)
+}
+
+// baseView returns the current view, or the view the team page or goal feed was opened
+// from, so polling and refreshes carry on behind them.
+func (m model) baseView() view {
+	switch m.currentView {
+	case viewTeam:
+		return m.teamReturnView
+	case viewGoalFeed:
+		return viewLiveMatches
+	}
+	return m.currentView
+}
+
+// handleTeamPage stores a fetched team page if it is still the one shown.
+func (m model) handleTeamPage(msg teamPageMsg) (tea.Model, tea.Cmd) {
+	if m.currentView != viewTeam || msg.teamID != m.team.ID {
+		return m, nil
+	}
+	m.teamLoading = false
+	if msg.err != nil {
+		m.debugLog(fmt.Sprintf("Team page fetch failed for team %d: %v", msg.teamID, msg.err))
+		return m, nil
+	}
+	m.teamPage = msg.page
+	return m, nil
+}
+
+// handleTeamViewKeys scrolls the squad of the team page.
+func (m model) handleTeamViewKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "up", "k":
+		m.teamScroll = max(m.teamScroll-1, 0)
+	case "down", "j":
+		if m.teamPage != nil {
+			m.teamScroll = min(m.teamScroll+1, max(ui.SquadLines(m.teamPage.Squad)-1, 0))
+		}
+	}
+	return m, nil
+}
+
+// displayedTeam returns the state of the team page.
+func (m model) displayedTeam() ui.TeamView {
+	return ui.TeamView{
+		Team:    m.team,
+		Page:    m.teamPage,
+		Loading: m.teamLoading,
+		Scroll:  m.teamScroll,
+	}
+}
@@ -0,0 +1,167 @@
+package app
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/0xjuanma/golazo/internal/api"
+	"github.com/0xjuanma/golazo/internal/reddit"
+	"github.com/0xjuanma/golazo/internal/trace"
+	"github.com/0xjuanma/golazo/internal/ui"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// Match Thread comment stream settings.
+const (
+	ThreadPollInterval  = 60 * time.Second // Comment refresh interval while a live match is selected
+	ThreadRetryInterval = 3 * time.Minute  // Search retry interval while no thread has been posted
+	threadCommentLimit  = 25
+)
+
+// matchThreadMsg carries the Match Thread found for a match (nil when none was found).
+type matchThreadMsg struct {
+	matchID int
+	thread  *reddit.MatchThread
+}
+
+// threadCommentsMsg carries the newest comments of the selected match's thread.
+type threadCommentsMsg struct {
+	matchID  int
+	comments []reddit.Comment
+	err      error
+}
+
+// threadTickMsg triggers the next thread search or comment refresh for a match.
+type threadTickMsg struct {
+	matchID int
+}
+
+// fetchMatchThread searches r/soccer for the Match Thread of a fixture.
+func fetchMatchThread(client *reddit.Client, details *api.MatchDetails) tea.Cmd {
+	return func() tea.Msg {
+		matchTime := time.Now()
+		if details.MatchTime != nil {
+			matchTime = *details.MatchTime
+		}
+		ctx := trace.Start(requestsCtx, fmt.Sprintf("find match thread %d", details.ID))
+		thread, _ := client.FindMatchThread(ctx, details.HomeTeam.Name, details.AwayTeam.Name, matchTime)
+		return matchThreadMsg{matchID: details.ID, thread: thread}
+	}
+}
+
+// fetchThreadComments fetches the newest top-level comments of a Match Thread.
+func fetchThreadComments(client *reddit.Client, threadID string, matchID int) tea.Cmd {
+	return func() tea.Msg {
+		ctx := trace.Start(requestsCtx, fmt.Sprintf("fetch thread comments %d", matchID))
+		comments, err := client.ThreadComments(ctx, threadID, threadCommentLimit)
+		return threadCommentsMsg{matchID: matchID, comments: comments, err: err}
+	}
+}
+
+// scheduleThreadTick fires a threadTickMsg for matchID after delay.
+func scheduleThreadTick(matchID int, delay time.Duration) tea.Cmd {
+	return tea.Tick(delay, func(t time.Time) tea.Msg {
+		return threadTickMsg{matchID: matchID}
+	})
+}
+
+// resetMatchThread drops the Match Thread state of the previously selected match.
+func (m *model) resetMatchThread() {
+	m.matchThread = nil
+	m.threadMatchID = 0
+	m.threadComments = nil
+}
+
+// watchMatchThread starts the Match Thread search for a live match once per selection.
+// No-op with mock data, without a Reddit client, or when already watching this match.
+func (m *model) watchMatchThread(details *api.MatchDetails) tea.Cmd {
+	if m.useMockData || m.redditClient == nil || details.Status != api.MatchStatusLive {
+		return nil
+	}
+	if m.threadMatchID == details.ID {
+		return nil
+	}
+	m.resetMatchThread()
+	m.threadMatchID = details.ID
+	return fetchMatchThread(m.redditClient, details)
+}
+
+// threadWatched reports whether matchID is still the selected live match in the live view.
+func (m model) threadWatched(matchID int) bool {
+	return m.baseView() == viewLiveMatches &&
+		m.threadMatchID == matchID &&
+		m.matchDetails != nil &&
+		m.matchDetails.ID == matchID &&
+		m.matchDetails.Status == api.MatchStatusLive
+}
+
+// handleMatchThread starts streaming comments once the thread is found,
+// or retries the search later (threads are usually posted around kickoff).
+func (m model) handleMatchThread(msg matchThreadMsg) (tea.Model, tea.Cmd) {
+	if !m.threadWatched(msg.matchID) {
+		return m, nil
+	}
+	if msg.thread == nil {
+		return m, scheduleThreadTick(msg.matchID, ThreadRetryInterval)
+	}
+	m.matchThread = msg.thread
+	m.debugLog(fmt.Sprintf("Match thread found for match %d: %s", msg.matchID, msg.thread.URL))
+	return m, fetchThreadComments(m.redditClient, msg.thread.ID, msg.matchID)
+}
+
+// handleThreadComments stores fresh comments and schedules the next refresh.
+// On error the previous comments stay visible.
+func (m model) handleThreadComments(msg threadCommentsMsg) (tea.Model, tea.Cmd) {
+	if !m.threadWatched(msg.matchID) {
+		return m, nil
+	}
+	if msg.err == nil {
+		m.threadComments = msg.comments
+		m.updateLiveListSize()
+	}
+	return m, scheduleThreadTick(msg.matchID, throttle(ThreadPollInterval, m.powerSaver))
+}
+
+// handleThreadTick refreshes comments, or searches again while no thread is known.
+// Stops when the match is deselected, finishes or the user leaves the live view.
+func (m model) handleThreadTick(msg threadTickMsg) (tea.Model, tea.Cmd) {
+	if !m.threadWatched(msg.matchID) {
+		return m, nil
+	}
+	if m.matchThread == nil {
+		return m, fetchMatchThread(m.redditClient, m.matchDetails)
+	}
+	return m, fetchThreadComments(m.redditClient, m.matchThread.ID, msg.matchID)
+}
+
+// displayedThreadComments converts the thread comments for the comments panel.
+func (m model) displayedThreadComments() []ui.ThreadComment {
+	if len(m.threadComments) == 0 {
+		return nil
+	}
+	now := time.Now()
+	comments := make([]ui.ThreadComment, len(m.threadComments))
+	for i, c := range m.threadComments {
+		comments[i] = ui.ThreadComment{
+			Author: c.Author,
+			Body:   c.Body,
+			Score:  c.Score,
+			Age:    commentAge(now.Sub(c.CreatedAt)),
+		}
+	}
+	return comments
+}
+
+// commentAge formats a comment's age compactly: "now", "45s", "12m", "2h".
+func commentAge(d time.Duration) string {
+	switch {
+	case d < 10*time.Second:
+		return "now"
+	case d < time.Minute:
+		return fmt.Sprintf("%ds", int(d.Seconds()))
+	case d < time.Hour:
+		return fmt.Sprintf("%dm", int(d.Minutes()))
+	default:
+		return fmt.Sprintf("%dh", int(d.Hours()))
+	}
+}
@@ -4,12 +4,15 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"slices"
 	"strings"
 	"time"
 
+	"github.com/0xjuanma/golazo/internal/alerts"
 	"github.com/0xjuanma/golazo/internal/api"
 	"github.com/0xjuanma/golazo/internal/data"
 	"github.com/0xjuanma/golazo/internal/fotmob"
+	"github.com/0xjuanma/golazo/internal/notify"
 	"github.com/0xjuanma/golazo/internal/reddit"
 	"github.com/0xjuanma/golazo/internal/ui"
 	"github.com/charmbracelet/bubbles/list"
@@ -64,6 +67,12 @@ func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	case pollDisplayCompleteMsg:
 		return m.handlePollDisplayComplete()
 
+	case backgroundPollTickMsg:
+		return m.handleBackgroundPollTick(msg)
+
+	case backgroundPollMsg:
+		return m.handleBackgroundPoll(msg)
+
 	case list.FilterMatchesMsg:
 		// Route filter matches message to the appropriate list based on current view
 		return m.handleFilterMatches(msg)
@@ -71,6 +80,100 @@ func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	case goalLinksMsg:
 		return m.handleGoalLinks(msg)
 
+	case goalLinkProgressMsg:
+		return m.handleGoalLinkProgress(msg)
+
+	case settingsCheckMsg:
+		return m.handleSettingsCheck()
+
+	case settingsReloadedMsg:
+		return m.handleSettingsReloaded(msg)
+
+	case partyUpdatedMsg:
+		return m.handlePartyUpdated(msg)
+
+	case partySentMsg:
+		if msg.err != nil {
+			return m, m.showToast("Watch party: " + msg.err.Error())
+		}
+		return m, nil
+
+	case toastClearMsg:
+		if msg.id == m.toastID {
+			m.toast = ""
+		}
+		return m, nil
+
+	case reminderDueMsg:
+		// A snooze running past kickoff has nothing left to remind about
+		if msg.match.MatchTime != nil && time.Now().After(*msg.match.MatchTime) {
+			return m, nil
+		}
+		return m, sendReminder(m.notifier, msg.match)
+
+	case reminderActionMsg:
+		return m.handleReminderAction(msg)
+
+	case halfTimeCheckMsg:
+		return m, fetchHalfTimeStatus(m.fotmobClient, msg.watch)
+
+	case halfTimeStatusMsg:
+		return m.handleHalfTimeStatus(msg)
+
+	case replayTickMsg:
+		return m.handleReplayTick(msg)
+
+	case matchThreadMsg:
+		return m.handleMatchThread(msg)
+
+	case threadCommentsMsg:
+		return m.handleThreadComments(msg)
+
+	case threadTickMsg:
+		return m.handleThreadTick(msg)
+
+	case commentaryMsg:
+		return m.handleCommentary(msg)
+
+	case teamPageMsg:
+		return m.handleTeamPage(msg)
+
+	case goalFeedMsg:
+		return m.handleGoalFeed(msg)
+
+	case goalFeedTickMsg:
+		return m.handleGoalFeedTick(msg)
+
+	case playerPageMsg:
+		return m.handlePlayerPage(msg)
+
+	case freezeTimeoutMsg:
+		return m.handleFreezeTimeout(msg)
+
+	case rawResponseMsg:
+		if msg.err != nil {
+			m.debugLog(fmt.Sprintf("Raw response export failed: %v", msg.err))
+			return m, m.showToast("Export failed: " + msg.err.Error())
+		}
+		return m, m.showToast("Saved raw response to " + msg.path)
+
+	case matchExportMsg:
+		if msg.err != nil {
+			return m, m.showToast("Export failed: " + msg.err.Error())
+		}
+		return m, m.showToast("Saved match to " + msg.path)
+
+	case rotationTickMsg:
+		return m.handleRotationTick(msg)
+
+	case broadcastsMsg:
+		return m.handleBroadcasts(msg)
+
+	case groupTablesMsg:
+		m.groupTables[msg.leagueID] = msg.groups
+		m.seasonTables[msg.leagueID] = msg.table
+		return m, nil
+
 	case standingsMsg:
 		return m.handleStandings(msg)
 
@@ -98,7 +201,7 @@ func (m model) handleWindowSize(msg tea.WindowSizeMsg) (tea.Model, tea.Cmd) {
 
 	switch m.currentView {
 	case viewLiveMatches:
-		leftWidth := max(m.width*35/100, 25)
+		leftWidth := ui.LiveListPanelWidth(m.width, len(m.threadComments) > 0 && !m.standingsSplit)
 		availableWidth := leftWidth - frameH*2
 		availableHeight := m.height - frameV*2 - titleHeight - spinnerHeight
 		if availableWidth > 0 && availableHeight > 0 {
@@ -141,13 +244,13 @@ func (m model) handleSpinnerTick(msg spinner.TickMsg) (tea.Model, tea.Cmd) {
 
 // handleLiveUpdate processes live match update messages.
 func (m model) handleLiveUpdate(msg liveUpdateMsg) (tea.Model, tea.Cmd) {
-	if msg.update != "" {
+	if msg.update != "" && !slices.Contains(m.liveUpdates, msg.update) {
 		m.liveUpdates = append(m.liveUpdates, msg.update)
 	}
 
 	// Continue polling if match is live
 	if m.polling && m.matchDetails != nil && m.matchDetails.Status == api.MatchStatusLive {
-		return m, schedulePollTick(m.matchDetails.ID)
+		return m, m.schedulePoll(m.matchDetails.ID)
 	}
 
 	m.loading = false
@@ -159,6 +262,22 @@ func (m model) handleLiveUpdate(msg liveUpdateMsg) (tea.Model, tea.Cmd) {
 func (m model) handleMatchDetails(msg matchDetailsMsg) (tea.Model, tea.Cmd) {
 	var cmds []tea.Cmd
 
+	// Drop responses for a match that is no longer selected (arriving out of order,
+	// or cancelled by newSelection)
+	if msg.matchID != m.selectedMatchID {
+		m.debugLog(fmt.Sprintf("handleMatchDetails: dropping details of match %d, %d is selected", msg.matchID, m.selectedMatchID))
+		return m, nil
+	}
+
+	if msg.details == nil && m.revalidating {
+		// Keep showing the stale details when revalidating them fails
+		m.revalidating = false
+		m.loading = false
+		m.polling = false
+		m.debugLog("handleMatchDetails: revalidation failed, keeping stale details")
+		return m, nil
+	}
+
 	if msg.details == nil {
 		// Clear match details when API call fails so we don't show stale data
 		m.matchDetails = nil
@@ -169,10 +288,14 @@ func (m model) handleMatchDetails(msg matchDetailsMsg) (tea.Model, tea.Cmd) {
 		return m, nil
 	}
 
+	previous := m.matchDetails
 	m.matchDetails = msg.details
 	m.debugLog(fmt.Sprintf("handleMatchDetails: loaded match %d (%s vs %s) with %d events, status=%v",
 		msg.details.ID, msg.details.HomeTeam.Name, msg.details.AwayTeam.Name, len(msg.details.Events), msg.details.Status))
 
+	// Archive newly seen events (opt-in via event_log setting)
+	m.recordEvents(msg.details)
+
 	// Debug highlights data
 	if msg.details.Highlight != nil {
 		m.debugLog(fmt.Sprintf("UI: highlights data loaded - URL: %s, Source: %s",
@@ -207,6 +330,12 @@ func (m model) handleMatchDetails(msg matchDetailsMsg) (tea.Model, tea.Cmd) {
 		}
 	}
 
+	if msg.stale {
+		return m.showStaleDetails(msg.details)
+	}
+	revalidated := m.revalidating
+	m.revalidating = false
+
 	// Check if match has goals and fetch links immediately (main branch approach)
 	hasGoals := false
 	for _, event := range msg.details.Events {
@@ -219,16 +348,26 @@ func (m model) handleMatchDetails(msg matchDetailsMsg) (tea.Model, tea.Cmd) {
 		cmds = append(cmds, fetchGoalLinks(m.redditClient, msg.details))
 	}
 
+	// Group-stage matches show their group table
+	if cmd := m.watchGroupTables(msg.details); cmd != nil {
+		cmds = append(cmds, cmd)
+	}
+	// Upcoming and live matches show where they are televised
+	if cmd := m.watchBroadcasts(msg.details); cmd != nil {
+		cmds = append(cmds, cmd)
+	}
+
 	// Cache for stats view (including during preload)
-	if m.currentView == viewStats || m.pendingSelection == 0 {
+	if m.baseView() == viewStats || m.pendingSelection == 0 {
 		m.matchDetailsCache[msg.details.ID] = msg.details
 		m.loading = false
 		m.statsViewLoading = false
+		cmds = append(cmds, m.refreshFinishedItem(msg.details.ID))
 		return m, tea.Batch(cmds...)
 	}
 
 	// Handle live matches view (including during preload)
-	if m.currentView == viewLiveMatches || m.pendingSelection == 1 {
+	if m.baseView() == viewLiveMatches || m.pendingSelection == 1 {
 		m.liveViewLoading = false
 
 		// Get current scores
@@ -252,26 +391,59 @@ func (m model) handleMatchDetails(msg matchDetailsMsg) (tea.Model, tea.Cmd) {
 		m.lastHomeScore = homeScore
 		m.lastAwayScore = awayScore
 
-		// Parse ALL events to rebuild the live updates list
-		// This ensures proper ordering (descending by minute) and uniqueness
-		m.liveUpdates = m.parser.ParseEvents(msg.details.Events, msg.details.HomeTeam, msg.details.AwayTeam)
+		// Diff the events against the previous poll: the feed is only rebuilt when events
+		// were added, changed or removed, and removed ones stay in it as overturned
+		if len(m.lastEvents) > 0 {
+			diff := fotmob.DiffEvents(m.lastEvents, msg.details.Events)
+			if !diff.Empty() {
+				m.debugLog(fmt.Sprintf("handleMatchDetails: %d new, %d changed, %d removed events",
+					len(diff.Added), len(diff.Changed), len(diff.Removed)))
+				m.overturnedEvents = fotmob.WithoutEvents(append(m.overturnedEvents, diff.Removed...), msg.details.Events)
+				m.liveUpdates = m.parser.ParseFeed(msg.details.Events, m.overturnedEvents, msg.details.HomeTeam, msg.details.AwayTeam)
+				cmds = append(cmds, m.notifyOverturnedGoals(diff.Removed))
+			}
+		} else {
+			m.liveUpdates = m.parser.ParseFeed(msg.details.Events, m.overturnedEvents, msg.details.HomeTeam, msg.details.AwayTeam)
+		}
 		m.lastEvents = msg.details.Events
+		m.recordDelayed(msg.details)
+		if cmd := m.notifyLineups(previous, msg.details); cmd != nil {
+			cmds = append(cmds, cmd)
+		}
+
+		// Refresh the commentary tab with every details load, including polls
+		if cmd := m.watchCommentary(msg.details); cmd != nil {
+			cmds = append(cmds, cmd)
+		}
 
 		// Continue polling if match is live
 		if msg.details.Status == api.MatchStatusLive {
 			// For initial load, clear loading state
 			// For poll refresh, loading is cleared by 1s timer (pollDisplayCompleteMsg)
-			if !m.polling {
+			if !m.polling || revalidated {
 				m.loading = false
 			}
 			// Note: if m.polling is true, m.loading stays true until the 1s timer fires
 
 			m.polling = true
-			// Schedule next poll tick (90 seconds from now)
-			cmds = append(cmds, schedulePollTick(msg.details.ID))
+			// Schedule next poll tick (90 seconds from now, longer while saving power, shorter in clutch time)
+			cmds = append(cmds, m.schedulePoll(msg.details.ID))
+
+			// Stream the r/soccer Match Thread alongside the details
+			if cmd := m.watchMatchThread(msg.details); cmd != nil {
+				cmds = append(cmds, cmd)
+			}
+		} else if awaitingLineups(msg.details) {
+			// Poll an upcoming match until its confirmed line-ups are out
+			if !m.polling || revalidated {
+				m.loading = false
+			}
+			m.polling = true
+			cmds = append(cmds, m.schedulePoll(msg.details.ID))
 		} else {
 			m.loading = false
 			m.polling = false
+			m.boost = nil
 		}
 		return m, tea.Batch(cmds...)
 	}
@@ -283,20 +455,94 @@ func (m model) handleMatchDetails(msg matchDetailsMsg) (tea.Model, tea.Cmd) {
 	return m, nil
 }
 
+// showStaleDetails shows expired cached details while fresh ones are fetched. Goal links,
+// polling and watchers start with the fresh details; in the live view they are handled
+// like a poll refresh, so goals scored in the meantime are notified.
+func (m model) showStaleDetails(details *api.MatchDetails) (tea.Model, tea.Cmd) {
+	m.revalidating = true
+	m.liveViewLoading = false
+	m.statsViewLoading = false
+	m.loading = false
+
+	if m.baseView() == viewLiveMatches || m.pendingSelection == 1 {
+		if details.HomeScore != nil {
+			m.lastHomeScore = *details.HomeScore
+		}
+		if details.AwayScore != nil {
+			m.lastAwayScore = *details.AwayScore
+		}
+		m.liveUpdates = m.parser.ParseFeed(details.Events, m.overturnedEvents, details.HomeTeam, details.AwayTeam)
+		m.lastEvents = details.Events
+		m.polling = true
+		m.loading = true // Shows "Updating..." until the fresh details arrive
+	}
+	return m, nil
+}
+
 // handleKeyPress routes key events to view-specific handlers.
 func (m model) handleKeyPress(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	// Any key resumes a frozen screen (and does nothing else, except quitting)
+	if m.frozenFrame != "" {
+		m.unfreeze()
+		if msg.String() == "ctrl+c" {
+			return m, tea.Quit
+		}
+		return m, nil
+	}
+
+	// The ":" prompt takes every key while it is open
+	if m.commandInput != nil {
+		return m.handleCommandKeys(msg)
+	}
+
 	// If dialog overlay has active dialogs, route messages there first
 	if m.dialogOverlay != nil && m.dialogOverlay.HasDialogs() {
 		action := m.dialogOverlay.Update(msg)
-		if _, ok := action.(ui.DialogActionClose); ok {
+		switch action := action.(type) {
+		case ui.DialogActionClose:
 			m.dialogOverlay.CloseFrontDialog()
+		case ui.DialogActionOpenTeam:
+			return m.openTeamPage(action.Team)
+		case ui.DialogActionOpenPlayer:
+			return m.openPlayerPage(action.Player)
 		}
 		return m, nil
 	}
 
+	// Macros run their chain of commands (not while typing a filter)
+	if lines, ok := m.macro(msg.String()); ok && !m.typingFilter() {
+		return m.runMacro(msg.String(), lines)
+	}
+
 	switch msg.String() {
 	case "q", "ctrl+c":
 		return m, tea.Quit
+	case "ctrl+d":
+		m.devOverlay = !m.devOverlay
+		return m, nil
+	case "ctrl+f":
+		return m, m.freeze()
+	case "ctrl+e":
+		// Export the selected match's raw provider response for bug reports
+		if m.currentView == viewLiveMatches || m.currentView == viewStats {
+			return m, m.exportRawResponse()
+		}
+		return m, nil
+	case "ctrl+g":
+		if m.currentView == viewLiveMatches || m.currentView == viewStats {
+			m.openSearchDebugDialog()
+		}
+		return m, nil
+	case " ":
+		// Space pauses/resumes the rotation (not while typing a filter)
+		if m.rotation != nil && !m.typingFilter() && (m.currentView == viewLiveMatches || m.currentView == viewStats) {
+			return m, m.toggleRotationPause()
+		}
+	case ":":
+		// Command mode (not while typing a filter)
+		if !m.typingFilter() {
+			return m.openCommandLine()
+		}
 	case "esc":
 		// Check if any list is in filtering mode - if so, let the list handle Esc
 		// to cancel the filter instead of navigating back
@@ -320,6 +566,14 @@ func (m model) handleKeyPress(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 			break
 		}
 
+		if m.currentView == viewTeam {
+			m.currentView = m.teamReturnView
+			return m, nil
+		}
+		if m.currentView == viewGoalFeed {
+			m.currentView = viewLiveMatches
+			return m, nil
+		}
 		if m.currentView != viewMain {
 			return m.resetToMainView()
 		}
@@ -335,6 +589,10 @@ func (m model) handleKeyPress(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 		return m.handleStatsSelection(msg)
 	case viewSettings:
 		return m.handleSettingsViewKeys(msg)
+	case viewTeam:
+		return m.handleTeamViewKeys(msg)
+	case viewGoalFeed:
+		return m.handleGoalFeedKeys(msg)
 	}
 
 	return m, nil
@@ -347,7 +605,11 @@ func (m model) resetToMainView() (tea.Model, tea.Cmd) {
 	m.matchDetails = nil
 	m.matchDetailsCache = make(map[int]*api.MatchDetails)
 	m.liveUpdates = nil
+	m.pausedFeed = nil
+	m.resetMatchThread()
+	m.standingsSplit = false
 	m.lastEvents = nil
+	m.overturnedEvents = nil
 	m.lastHomeScore = 0
 	m.lastAwayScore = 0
 	m.loading = false
@@ -401,7 +663,77 @@ func (m model) handleLiveMatchesSelection(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 				break
 			}
 		}
-		return m.loadMatchDetails(targetMatchID)
+		updated, cmd := m.loadMatchDetails(targetMatchID)
+		return updated, tea.Batch(cmd, m.fetchSelectedLeagueTable())
+	}
+
+	// Handle standings key (s) to open the selected match's league table
+	if msg.String() == "s" && m.liveMatchesList.FilterState() != list.Filtering {
+		return m, m.openStandings()
+	}
+
+	// Handle split layout key (t) to show the live league table next to the list
+	if msg.String() == "t" && m.liveMatchesList.FilterState() != list.Filtering {
+		return m, m.toggleStandingsSplit()
+	}
+
+	// Handle open key (o) to play the next goal replay in the external player
+	if msg.String() == "o" && m.liveMatchesList.FilterState() != list.Filtering {
+		return m, m.openNextMedia()
+	}
+
+	// Handle copy key (y) to copy the current goal replay link
+	if msg.String() == "y" && m.liveMatchesList.FilterState() != list.Filtering {
+		return m, m.copyCurrentMedia()
+	}
+
+	// Handle mirror key (m) to switch the current goal replay to its next mirror
+	if msg.String() == "m" && m.liveMatchesList.FilterState() != list.Filtering {
+		return m, m.cycleMirror()
+	}
+
+	// Handle density key (z) to cycle list item density
+	if msg.String() == "z" && m.liveMatchesList.FilterState() != list.Filtering {
+		return m, m.cycleDensity()
+	}
+
+	// Handle clutch time key (b) to boost polling of the displayed live match
+	if msg.String() == "b" && m.liveMatchesList.FilterState() != list.Filtering {
+		return m, m.toggleBoost()
+	}
+
+	// Handle all goals key (a) to open the goal feed of every live match
+	if msg.String() == "a" && m.liveMatchesList.FilterState() != list.Filtering {
+		return m.openGoalFeed()
+	}
+
+	// Handle commentary key (c) to switch the details panel to the text commentary tab
+	if msg.String() == "c" && m.liveMatchesList.FilterState() != list.Filtering {
+		return m, m.toggleCommentary()
+	}
+
+	// Handle feed keys: p pauses/resumes the updates feed, [ and ] step through it while
+	// paused, or scroll the commentary tab
+	if m.liveMatchesList.FilterState() != list.Filtering {
+		switch msg.String() {
+		case "p":
+			m.toggleFeedPause()
+			return m, nil
+		case "[":
+			if m.commentaryTab {
+				m.stepCommentary(1)
+			} else {
+				m.stepFeed(1)
+			}
+			return m, nil
+		case "]":
+			if m.commentaryTab {
+				m.stepCommentary(-1)
+			} else {
+				m.stepFeed(-1)
+			}
+			return m, nil
+		}
 	}
 
 	// Handle refresh key (r) to force refresh current match
@@ -471,21 +803,28 @@ func (m model) handleStatsSelection(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 			return m, nil
 		case "s":
 			// Fetch standings and open dialog
-			if m.matchDetails != nil {
-				return m, fetchStandings(
-					m.fotmobClient,
-					m.matchDetails.League.ID,
-					m.matchDetails.League.Name,
-					m.matchDetails.League.ParentLeagueID,
-					m.matchDetails.HomeTeam.ID,
-					m.matchDetails.AwayTeam.ID,
-				)
-			}
+			return m, m.openStandings()
+		case "a":
+			// Open shot map dialog
+			m.openShotMapDialog()
+			return m, nil
+		case "t":
+			// Open player ratings dialog
+			m.openRatingsDialog()
 			return m, nil
 		case "x":
 			// Open full statistics dialog
 			m.openStatisticsDialog()
 			return m, nil
+		case "o":
+			// Open the next goal replay/highlight in the external player
+			return m, m.openNextMedia()
+		case "y":
+			// Copy the current goal replay/highlight link
+			return m, m.copyCurrentMedia()
+		case "m":
+			// Switch the current goal replay to its next mirror
+			return m, m.cycleMirror()
 		}
 	}
 
@@ -498,6 +837,23 @@ func (m model) handleStatsSelection(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 		if msg.String() == "tab" {
 			return m.handleStatsViewKeys(msg)
 		}
+		// Cycle list item density
+		if msg.String() == "z" {
+			return m, m.cycleDensity()
+		}
+		// Standings of the selected match's league
+		if msg.String() == "s" {
+			return m, m.openStandings()
+		}
+		// Start/stop a spoiler-free replay of the selected match, and adjust its speed
+		switch msg.String() {
+		case "p":
+			return m, m.toggleReplay()
+		case "+", "=":
+			return m, m.changeReplaySpeed(true)
+		case "-":
+			return m, m.changeReplaySpeed(false)
+		}
 	}
 
 	// Capture selected item BEFORE Update (critical for filter mode - selection changes after filter clears)
@@ -562,8 +918,8 @@ func (m model) handleStatsSelection(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 func (m model) handleLiveMatches(msg liveMatchesMsg) (tea.Model, tea.Cmd) {
 	var cmds []tea.Cmd
 
-	// Schedule the next refresh (5-min timer)
-	cmds = append(cmds, scheduleLiveRefresh(m.fotmobClient, m.useMockData))
+	// Schedule the next refresh (kickoff-aware timer)
+	cmds = append(cmds, scheduleLiveRefresh(m.fotmobClient, m.useMockData, m.liveRefreshDelay(msg.matches)))
 
 	if len(msg.matches) == 0 {
 		m.liveViewLoading = false
@@ -600,18 +956,21 @@ func (m model) handleLiveMatches(msg liveMatchesMsg) (tea.Model, tea.Cmd) {
 	return m, tea.Batch(cmds...)
 }
 
-// handleLiveRefresh processes periodic live matches refresh (every 5 min).
+// handleLiveRefresh processes periodic live matches refresh (kickoff-aware interval).
 // Only updates if still in the live view.
 func (m model) handleLiveRefresh(msg liveRefreshMsg) (tea.Model, tea.Cmd) {
 	// Ignore refresh if not in live view (user navigated away)
-	if m.currentView != viewLiveMatches {
+	if m.baseView() != viewLiveMatches {
 		return m, nil
 	}
 
 	var cmds []tea.Cmd
 
 	// Schedule the next refresh
-	cmds = append(cmds, scheduleLiveRefresh(m.fotmobClient, m.useMockData))
+	cmds = append(cmds, scheduleLiveRefresh(m.fotmobClient, m.useMockData, m.liveRefreshDelay(msg.matches)))
+
+	// Share the refreshed list with companion commands (e.g., golazo tmux)
+	m.shareLiveMatches(msg.matches)
 
 	if len(msg.matches) == 0 {
 		// No live matches - clear list but keep view
@@ -646,6 +1005,7 @@ func (m model) handleLiveRefresh(msg liveRefreshMsg) (tea.Model, tea.Cmd) {
 	}
 	m.selected = newSelected
 	m.liveMatchesList.Select(newSelected)
+	cmds = append(cmds, m.startBackgroundPolls())
 
 	return m, tea.Batch(cmds...)
 }
@@ -696,8 +1056,12 @@ func (m model) handleLiveBatchData(msg liveBatchDataMsg) (tea.Model, tea.Cmd) {
 			m.fotmobClient.Cache().SetLiveMatches(m.liveMatchesBuffer)
 		}
 
+		// Share the live list with companion commands (e.g., golazo tmux)
+		m.shareLiveMatches(m.liveMatchesBuffer)
+
 		// Schedule periodic refresh
-		cmds = append(cmds, scheduleLiveRefresh(m.fotmobClient, m.useMockData))
+		cmds = append(cmds, scheduleLiveRefresh(m.fotmobClient, m.useMockData, m.liveRefreshDelay(m.liveMatchesBuffer)))
+		cmds = append(cmds, m.startBackgroundPolls())
 
 		return m, tea.Batch(cmds...)
 	}
@@ -712,10 +1076,120 @@ func (m model) handleLiveBatchData(msg liveBatchDataMsg) (tea.Model, tea.Cmd) {
 	return m, tea.Batch(cmds...)
 }
 
+// shareLiveMatches persists the live matches list for other golazo processes (async, best-effort).
+// Mock data is never shared.
+func (m model) shareLiveMatches(matches []api.Match) {
+	if m.useMockData {
+		return
+	}
+	persistInBackground(func() { _ = fotmob.SaveSharedLiveMatches(matches) })
+}
+
+// recordEvents archives the match's events to the event log (async, best-effort).
+func (m model) recordEvents(details *api.MatchDetails) {
+	if m.eventLog == nil || details == nil {
+		return
+	}
+	sink := m.eventLog
+	persistInBackground(func() { _ = sink.Record(details) })
+}
+
+// scheduleReminders schedules kickoff reminders for followed teams' upcoming matches.
+// Each match is scheduled once; matches that already kicked off are skipped.
+func (m *model) scheduleReminders(upcoming []api.Match) tea.Cmd {
+	if m.useMockData || m.notifier == nil || !m.notifier.Enabled() || len(upcoming) == 0 {
+		return nil
+	}
+	settings, err := data.LoadSettings()
+	if err != nil || len(settings.FollowedTeams) == 0 {
+		return nil
+	}
+	if m.reminders == nil {
+		m.reminders = make(map[int]bool)
+	}
+
+	var cmds []tea.Cmd
+	for _, match := range settings.FollowedMatches(upcoming) {
+		if m.reminders[match.ID] || match.MatchTime == nil || time.Now().After(*match.MatchTime) {
+			continue
+		}
+		m.reminders[match.ID] = true
+		delay := time.Until(match.MatchTime.Add(-ReminderLead))
+		if delay < 0 {
+			delay = 0 // Inside the lead window - remind now
+		}
+		cmds = append(cmds, scheduleReminder(match, delay))
+	}
+	return tea.Batch(cmds...)
+}
+
+// handleReminderAction applies the button chosen on a kickoff reminder.
+func (m model) handleReminderAction(msg reminderActionMsg) (tea.Model, tea.Cmd) {
+	switch msg.action {
+	case notify.ActionSnooze:
+		return m, scheduleReminder(msg.match, ReminderSnooze)
+	case notify.ActionHalfTime:
+		// First check around the end of the first half, then poll until HT
+		delay := HalfTimeCheckInterval
+		watch := halfTimeWatch{matchID: msg.match.ID, deadline: time.Now().Add(HalfTimeWatchLimit)}
+		if msg.match.MatchTime != nil {
+			if untilHT := time.Until(msg.match.MatchTime.Add(45 * time.Minute)); untilHT > delay {
+				delay = untilHT
+			}
+			watch.deadline = msg.match.MatchTime.Add(HalfTimeWatchLimit)
+		}
+		return m, scheduleHalfTimeCheck(watch, delay)
+	}
+	return m, nil
+}
+
+// handleHalfTimeStatus notifies once the watched match reaches half-time, otherwise keeps
+// polling until it does. Polling stops when the match is no longer upcoming or live (it
+// ended or was postponed), HalfTimeWatchLimit after kickoff, or after HalfTimeMaxFailures
+// failed checks in a row.
+func (m model) handleHalfTimeStatus(msg halfTimeStatusMsg) (tea.Model, tea.Cmd) {
+	watch := msg.watch
+	details := msg.details
+	if details == nil {
+		watch.failures++
+		if watch.failures >= HalfTimeMaxFailures {
+			return m, nil
+		}
+	} else {
+		watch.failures = 0
+		if details.Clock != nil && details.Clock.Period == api.PeriodHalfTime {
+			_ = m.notifier.HalfTime(details)
+			return m, nil
+		}
+		if details.Status != api.MatchStatusLive && details.Status != api.MatchStatusNotStarted {
+			return m, nil
+		}
+	}
+	if time.Now().Add(HalfTimeCheckInterval).After(watch.deadline) {
+		return m, nil
+	}
+	return m, scheduleHalfTimeCheck(watch, HalfTimeCheckInterval)
+}
+
+// appendDailyNotes appends followed teams' finished results to the user's daily note.
+// No-op with mock data or when no daily note path / followed teams are configured.
+func (m model) appendDailyNotes(finished []api.Match) {
+	if m.useMockData || len(finished) == 0 {
+		return
+	}
+	go func() {
+		settings, err := data.LoadSettings()
+		if err != nil || settings.DailyNotePath == "" {
+			return
+		}
+		_, _ = data.AppendDailyNotes(settings.DailyNotePath, settings.FollowedMatches(finished))
+	}()
+}
+
 // updateLiveListSize sets the live list dimensions based on window size.
 func (m *model) updateLiveListSize() {
 	const spinnerHeight = 3
-	leftWidth := max(m.width*35/100, 25)
+	leftWidth := ui.LiveListPanelWidth(m.width, len(m.threadComments) > 0 && !m.standingsSplit)
 	if m.width == 0 {
 		leftWidth = 40
 	}
@@ -785,6 +1259,9 @@ func (m model) handleStatsDayData(msg statsDayDataMsg) (tea.Model, tea.Cmd) {
 		}
 	}
 
+	// Record followed teams' results in the daily note (deduplicated on disk)
+	m.appendDailyNotes(msg.finished)
+
 	// Accumulate finished matches (deduplicate by match ID)
 	if len(msg.finished) > 0 {
 		// Build a set of existing IDs to avoid duplicates
@@ -841,6 +1318,7 @@ func (m model) handleStatsDayData(msg statsDayDataMsg) (tea.Model, tea.Cmd) {
 			upcomingDisplay = append(upcomingDisplay, ui.MatchDisplay{Match: match})
 		}
 		m.liveUpcomingMatches = upcomingDisplay
+		cmds = append(cmds, m.scheduleReminders(m.statsData.TodayUpcoming))
 	}
 
 	// Track progress
@@ -903,13 +1381,57 @@ func (m *model) applyStatsDateFilter() {
 	// Convert to display format
 	displayMatches := make([]ui.MatchDisplay, 0, len(finishedMatches))
 	for _, match := range finishedMatches {
-		displayMatches = append(displayMatches, ui.MatchDisplay{Match: match})
+		displayMatches = append(displayMatches, m.finishedDisplay(match))
 	}
 	m.matches = displayMatches
 	m.statsMatchesList.SetItems(ui.ToMatchListItems(displayMatches))
 	// Note: Upcoming matches are now shown in the Live view instead
 }
 
+// finishedDisplay wraps a finished match with the configured extra columns,
+// filled from cached details and goal replay links when available. The shootout score
+// also comes from cached details when the match list lacks it.
+func (m model) finishedDisplay(match api.Match) ui.MatchDisplay {
+	details := m.matchDetailsCache[match.ID]
+	if match.Shootout == nil && details != nil {
+		match.Shootout = details.Shootout
+	}
+	display := ui.MatchDisplay{Match: match}
+	if len(m.finishedColumns) == 0 {
+		return display
+	}
+
+	hasReplay := false
+	for key, link := range m.goalLinks {
+		if key.MatchID == match.ID && link != nil && ui.IsValidReplayURL(link.URL) {
+			hasReplay = true
+			break
+		}
+	}
+	display.Columns = ui.FinishedColumnValues(m.finishedColumns, details, hasReplay)
+	return display
+}
+
+// refreshFinishedItem re-renders the finished list item for matchID after its
+// details or replay links arrive, keeping the selection and filter intact.
+func (m *model) refreshFinishedItem(matchID int) tea.Cmd {
+	for i, item := range m.statsMatchesList.Items() {
+		listItem, ok := item.(ui.MatchListItem)
+		if !ok || listItem.Match.ID != matchID {
+			continue
+		}
+		display := m.finishedDisplay(listItem.Match)
+		for j := range m.matches {
+			if m.matches[j].ID == matchID {
+				m.matches[j] = display
+			}
+		}
+		listItem.Display = display
+		return m.statsMatchesList.SetItem(i, listItem)
+	}
+	return nil
+}
+
 // filterMatchesByDays filters matches to only include those from the last N days.
 // Uses LOCAL time for date comparison so "today" matches user's actual timezone.
 func filterMatchesByDays(matches []api.Match, days int) []api.Match {
@@ -938,6 +1460,14 @@ func filterMatchesByDays(matches []api.Match, days int) []api.Match {
 // handleAnimationTick updates all UI animations: logo reveal and loading spinners.
 // Uses a SINGLE tick chain - all animations share the same 70ms tick rate.
 func (m model) handleAnimationTick(msg ui.TickMsg) (tea.Model, tea.Cmd) {
+	// Animations are skipped while saving power; the chain stops until the next load
+	if powerSaving(m.powerSaver) {
+		if m.animatedLogo != nil {
+			m.animatedLogo.Finish()
+		}
+		return m, nil
+	}
+
 	// Logo animation (main view, one-time)
 	logoAnimating := false
 	if m.currentView == viewMain && m.animatedLogo != nil && !m.animatedLogo.IsComplete() {
@@ -1038,7 +1568,7 @@ func (m model) handleMainViewCheck(msg mainViewCheckMsg) (tea.Model, tea.Cmd) {
 // Shows "Updating..." spinner for 1s as visual feedback, then fetches data.
 func (m model) handlePollTick(msg pollTickMsg) (tea.Model, tea.Cmd) {
 	// Only process if we're still in live view and polling is active
-	if m.currentView != viewLiveMatches || !m.polling {
+	if m.baseView() != viewLiveMatches || !m.polling {
 		return m, nil
 	}
 
@@ -1047,15 +1577,26 @@ func (m model) handlePollTick(msg pollTickMsg) (tea.Model, tea.Cmd) {
 		return m, nil
 	}
 
+	// Ignore ticks superseded by a clutch time boost
+	if msg.seq != m.pollSeq {
+		return m, nil
+	}
+	var toast tea.Cmd
+	if m.boost != nil && !time.Now().Before(m.boost.until) {
+		m.boost = nil
+		toast = m.showToast("Clutch time over")
+	}
+
 	// Set loading state to show "Updating..." spinner
 	m.loading = true
 
 	// Start the actual API call, spinner animation, and 1s display timer
 	// Also check for any new goals that might have been scored since last poll
 	return m, tea.Batch(
-		fetchPollMatchDetails(m.fotmobClient, msg.matchID, m.useMockData),
+		fetchPollMatchDetails(m.selectionCtx, m.fotmobClient, msg.matchID, m.useMockData),
 		ui.SpinnerTick(),
 		schedulePollSpinnerHide(), // Hide spinner after 0.5 seconds
+		toast,
 	)
 }
 
@@ -1096,9 +1637,12 @@ func (m model) handleFilterMatches(msg list.FilterMatchesMsg) (tea.Model, tea.Cm
 // Uses score-based detection (more reliable than event ID comparison).
 // Only called during poll refreshes when we have previous score data.
 func (m *model) notifyNewGoals(details *api.MatchDetails) {
-	if m.notifier == nil || details == nil {
+	if m.notifier == nil || details == nil || (m.settings != nil && m.settings.IsLeagueMuted(details.League.ID)) {
 		return
 	}
+	if m.spoilerOffset > 0 {
+		return // Would spoil goals the delayed view hasn't shown yet
+	}
 
 	// Get current scores
 	homeScore := 0
@@ -1118,29 +1662,69 @@ func (m *model) notifyNewGoals(details *api.MatchDetails) {
 		return
 	}
 
-	// Find the most recent goal event to get player details
-	var goalEvent *api.MatchEvent
-	for i := len(details.Events) - 1; i >= 0; i-- {
-		event := details.Events[i]
+	// Notify the new goals in the order they were scored (by provider time, not the
+	// order of the events), so near-simultaneous goals read right
+	var goals []api.MatchEvent
+	for _, event := range details.Events {
 		if strings.ToLower(event.Type) == "goal" {
-			// Check if this goal matches the team that scored
-			if homeGoalScored && event.Team.ID == details.HomeTeam.ID {
-				goalEvent = &event
-				break
-			}
-			if awayGoalScored && event.Team.ID == details.AwayTeam.ID {
-				goalEvent = &event
-				break
-			}
+			goals = append(goals, event)
+		}
+	}
+	slices.SortStableFunc(goals, func(a, b api.MatchEvent) int {
+		return a.Timestamp.Compare(b.Timestamp)
+	})
+
+	newHome := homeScore - m.lastHomeScore
+	newAway := awayScore - m.lastAwayScore
+	var newGoals []api.MatchEvent
+	for i := len(goals) - 1; i >= 0 && (newHome > 0 || newAway > 0); i-- {
+		switch {
+		case goals[i].Team.ID == details.HomeTeam.ID && newHome > 0:
+			newHome--
+		case goals[i].Team.ID == details.AwayTeam.ID && newAway > 0:
+			newAway--
+		default:
+			continue
 		}
+		newGoals = append(newGoals, goals[i])
 	}
+	slices.Reverse(newGoals)
 
-	if goalEvent != nil {
-		// Send notification - errors are silently ignored to not disrupt the app
-		_ = m.notifier.Goal(*goalEvent, details.HomeTeam, details.AwayTeam, homeScore, awayScore)
+	// Send notifications with the score each goal made - errors are silently ignored to
+	// not disrupt the app
+	var alertSettings *data.AlertSettings
+	if m.settings != nil {
+		alertSettings = m.settings.Alerts
+	}
+	home, away := m.lastHomeScore, m.lastAwayScore
+	for _, goal := range newGoals {
+		if goal.Team.ID == details.HomeTeam.ID {
+			home++
+		} else {
+			away++
+		}
+		_ = m.notifier.Goal(goal, details.HomeTeam, details.AwayTeam, home, away)
+		for _, alert := range alerts.Evaluate(alertSettings, alerts.Goal{Event: goal, Details: details, HomeScore: home, AwayScore: away}) {
+			_ = m.notifier.Alert(alert.Title, alert.Message)
+		}
 	}
 }
 
+// notifyOverturnedGoals shows a toast for goals FotMob no longer reports.
+func (m *model) notifyOverturnedGoals(removed []api.MatchEvent) tea.Cmd {
+	for _, event := range removed {
+		if strings.ToLower(event.Type) != "goal" {
+			continue
+		}
+		player := "Unknown"
+		if event.Player != nil {
+			player = *event.Player
+		}
+		return m.showToast(fmt.Sprintf("Goal overturned: %s %d'", player, event.Minute))
+	}
+	return nil
+}
+
 // max returns the larger of two integers.
 func max(a, b int) int {
 	if a > b {
@@ -1149,9 +1733,33 @@ func max(a, b int) int {
 	return b
 }
 
+// handleGoalLinkProgress records progress of an in-flight goal links fetch and
+// merges each found link immediately so replay indicators appear as they arrive.
+func (m model) handleGoalLinkProgress(msg goalLinkProgressMsg) (tea.Model, tea.Cmd) {
+	p := msg.progress
+	m.goalLinksProgress = &goalLinksProgress{
+		matchID: msg.matchID,
+		done:    p.Done,
+		total:   p.Total,
+		found:   p.Found,
+	}
+
+	if p.Link != nil {
+		if m.goalLinks == nil {
+			m.goalLinks = make(map[reddit.GoalLinkKey]*reddit.GoalLink)
+		}
+		m.goalLinks[p.Key] = p.Link
+	}
+
+	return m, waitForGoalLinks(msg.ch)
+}
+
 // handleGoalLinks processes goal replay links fetched from Reddit.
 func (m model) handleGoalLinks(msg goalLinksMsg) (tea.Model, tea.Cmd) {
 	m.debugLog(fmt.Sprintf("handleGoalLinks called for match %d with %d links", msg.matchID, len(msg.links)))
+	if m.goalLinksProgress != nil && m.goalLinksProgress.matchID == msg.matchID {
+		m.goalLinksProgress = nil
+	}
 	if len(msg.links) == 0 {
 		m.debugLog(fmt.Sprintf("GoalLinks completed for match %d: no links found", msg.matchID))
 		return m, nil
@@ -1180,7 +1788,7 @@ func (m model) handleGoalLinks(msg goalLinksMsg) (tea.Model, tea.Cmd) {
 
 	m.debugLog(fmt.Sprintf("Goal link batch complete: %d valid, %d failed", validLinks, failedLinks))
 
-	return m, nil
+	return m, m.refreshFinishedItem(msg.matchID)
 }
 
 // debugLog writes debug messages to a log file without interfering with the UI
@@ -1346,15 +1954,51 @@ func (m *model) openFormationsDialog() {
 		m.matchDetails.AwayFormation,
 		m.matchDetails.HomeStarting,
 		m.matchDetails.AwayStarting,
+		substitutedOff(m.matchDetails.Events),
+		m.matchDetails.LineupsPredicted,
 	)
 	m.dialogOverlay.OpenDialog(dialog)
 }
 
+// substitutedOff maps each substituted player to the minute they went off.
+// Substitution events store the player going off in Player.
+func substitutedOff(events []api.MatchEvent) map[string]int {
+	subs := make(map[string]int)
+	for _, event := range events {
+		if event.Type == "substitution" && event.Player != nil {
+			subs[*event.Player] = event.Minute
+		}
+	}
+	return subs
+}
+
+// openStandings fetches the standings of the displayed match's league; the dialog opens when they arrive.
+func (m model) openStandings() tea.Cmd {
+	if m.matchDetails == nil {
+		return nil
+	}
+	return fetchStandings(
+		m.fotmobClient,
+		m.matchDetails.League.ID,
+		m.matchDetails.League.Name,
+		m.matchDetails.League.ParentLeagueID,
+		m.matchDetails.HomeTeam.ID,
+		m.matchDetails.AwayTeam.ID,
+	)
+}
+
 // handleStandings processes standings data and opens the standings dialog.
 func (m model) handleStandings(msg standingsMsg) (tea.Model, tea.Cmd) {
 	m.debugLog(fmt.Sprintf("handleStandings: received msg with %d standings, leagueID=%d, leagueName=%s",
 		len(msg.standings), msg.leagueID, msg.leagueName))
 
+	if msg.panel {
+		if m.standingsSplit && len(msg.standings) > 0 {
+			m.leagueTables[msg.leagueID] = msg.standings
+		}
+		return m, nil
+	}
+
 	if len(msg.standings) == 0 {
 		m.debugLog("handleStandings: no standings data, skipping dialog")
 		return m, nil
@@ -1405,3 +2049,298 @@ func (m *model) openStatisticsDialog() {
 	)
 	m.dialogOverlay.OpenDialog(dialog)
 }
+
+// openShotMapDialog opens the shot map dialog for the current match.
+func (m *model) openShotMapDialog() {
+	if m.matchDetails == nil || m.dialogOverlay == nil {
+		return
+	}
+
+	// Skip if the provider has no shot data for this match
+	if len(m.matchDetails.Shots) == 0 {
+		return
+	}
+
+	// Get team names
+	homeTeam := m.matchDetails.HomeTeam.ShortName
+	if homeTeam == "" {
+		homeTeam = m.matchDetails.HomeTeam.Name
+	}
+	awayTeam := m.matchDetails.AwayTeam.ShortName
+	if awayTeam == "" {
+		awayTeam = m.matchDetails.AwayTeam.Name
+	}
+
+	dialog := ui.NewShotMapDialog(
+		homeTeam,
+		awayTeam,
+		m.matchDetails.HomeTeam.ID,
+		m.matchDetails.AwayTeam.ID,
+		m.matchDetails.Shots,
+	)
+	m.dialogOverlay.OpenDialog(dialog)
+}
+
+// openRatingsDialog opens the player ratings dialog for the current match, once finished.
+func (m *model) openRatingsDialog() {
+	if m.matchDetails == nil || m.dialogOverlay == nil || m.matchDetails.Status != api.MatchStatusFinished {
+		return
+	}
+
+	// Get team names
+	homeTeam := m.matchDetails.HomeTeam.ShortName
+	if homeTeam == "" {
+		homeTeam = m.matchDetails.HomeTeam.Name
+	}
+	awayTeam := m.matchDetails.AwayTeam.ShortName
+	if awayTeam == "" {
+		awayTeam = m.matchDetails.AwayTeam.Name
+	}
+
+	dialog := ui.NewRatingsDialog(
+		homeTeam,
+		awayTeam,
+		m.matchDetails.HomeStarting,
+		m.matchDetails.HomeSubstitutes,
+		m.matchDetails.AwayStarting,
+		m.matchDetails.AwaySubstitutes,
+		m.matchDetails.PlayerOfTheMatchID,
+	)
+
+	// Skip if FotMob has not rated the players
+	if !dialog.HasRatings() {
+		return
+	}
+	m.dialogOverlay.OpenDialog(dialog)
+}
+
+// openSearchDebugDialog opens the Reddit search debug dialog for the displayed match:
+// the queries sent for its goals, top candidates with scores and the winning strategy.
+func (m *model) openSearchDebugDialog() {
+	if m.matchDetails == nil || m.dialogOverlay == nil || m.redditClient == nil {
+		return
+	}
+
+	var goals []ui.SearchDebugGoal
+	for _, t := range m.redditClient.SearchTraces(m.matchDetails.ID) {
+		g := t.Goal
+		label := fmt.Sprintf("%d' %s (%s %d-%d %s)", g.Minute, g.ScorerName, g.HomeTeam, g.HomeScore, g.AwayScore, g.AwayTeam)
+		age := commentAge(time.Since(t.At))
+		if age != "now" {
+			age += " ago"
+		}
+
+		goal := ui.SearchDebugGoal{Goal: label, Age: age, Winner: t.Winner, URL: t.URL}
+		for _, a := range t.Attempts {
+			q := ui.SearchDebugQuery{Strategy: a.Strategy, Query: a.Query, Sort: a.Sort, Results: a.Results, Err: a.Err}
+			for _, c := range a.Candidates {
+				q.Candidates = append(q.Candidates, ui.SearchDebugCandidate{Title: c.Title, Score: c.Score, Rejected: c.Rejected})
+			}
+			goal.Queries = append(goal.Queries, q)
+		}
+		goals = append(goals, goal)
+	}
+
+	match := fmt.Sprintf("%s vs %s", m.matchDetails.HomeTeam.Name, m.matchDetails.AwayTeam.Name)
+	m.dialogOverlay.OpenDialog(ui.NewSearchDebugDialog(match, goals))
+}
+
+// mediaURLs returns the clips available for the current match:
+// goal replays (most recent first) followed by the official highlights.
+// Direct video URLs are used when an external player command is configured.
+func (m *model) mediaURLs() []string {
+	if m.matchDetails == nil {
+		return nil
+	}
+
+	var urls []string
+	for i := len(m.matchDetails.Events) - 1; i >= 0; i-- {
+		event := m.matchDetails.Events[i]
+		if event.Type != "goal" {
+			continue
+		}
+		link, ok := m.goalLinks[reddit.GoalLinkKey{MatchID: m.matchDetails.ID, Minute: event.Minute}]
+		if !ok || link == nil || !ui.IsValidReplayURL(link.URL) {
+			continue
+		}
+		url := link.URL
+		if m.launcher.HasPlayer() {
+			url = link.PlayableURL()
+		}
+		if !slices.Contains(urls, url) {
+			urls = append(urls, url)
+		}
+	}
+
+	if highlight := m.matchDetails.Highlight; highlight != nil && ui.IsValidReplayURL(highlight.URL) {
+		urls = append(urls, highlight.URL)
+	}
+
+	return urls
+}
+
+// openNextMedia opens the next clip for the current match in the external player.
+// Repeated presses cycle through all goal replays and the highlights.
+func (m *model) openNextMedia() tea.Cmd {
+	urls := m.mediaURLs()
+	if len(urls) == 0 || m.launcher == nil {
+		return nil
+	}
+
+	if m.mediaMatchID != m.matchDetails.ID {
+		m.mediaMatchID = m.matchDetails.ID
+		m.mediaIndex = 0
+	}
+	url := urls[m.mediaIndex%len(urls)]
+	m.mediaIndex++
+
+	m.debugLog(fmt.Sprintf("Opening media for match %d: %s", m.matchDetails.ID, url))
+	launcher := m.launcher
+	return func() tea.Msg {
+		// Best-effort - a missing player shouldn't disrupt the app
+		_ = launcher.Open(url)
+		return nil
+	}
+}
+
+// currentMediaURL returns the current clip for the match: the one last opened
+// with "o", or the most recent goal replay. Returns "" when there is none.
+func (m *model) currentMediaURL() string {
+	urls := m.mediaURLs()
+	if len(urls) == 0 {
+		return ""
+	}
+	if m.mediaMatchID == m.matchDetails.ID && m.mediaIndex > 0 {
+		return urls[(m.mediaIndex-1)%len(urls)]
+	}
+	return urls[0]
+}
+
+// copyCurrentMedia copies the current clip link for the match to the clipboard.
+func (m *model) copyCurrentMedia() tea.Cmd {
+	url := m.currentMediaURL()
+	if url == "" {
+		return m.showToast("No replay link to copy")
+	}
+
+	if err := ui.CopyToClipboard(url); err != nil {
+		m.debugLog(fmt.Sprintf("Copy to clipboard failed: %v", err))
+		return m.showToast("Could not copy link")
+	}
+	return m.showToast("Link copied")
+}
+
+// cycleMirror switches the current goal replay to its next mirror and remembers the
+// choice in the goal link cache, so "o" and "y" use it from then on.
+func (m *model) cycleMirror() tea.Cmd {
+	url := m.currentMediaURL()
+	if url == "" {
+		return m.showToast("No replay to switch")
+	}
+
+	var link *reddit.GoalLink
+	for key, l := range m.goalLinks {
+		if l != nil && key.MatchID == m.matchDetails.ID && (l.URL == url || l.PlayableURL() == url) {
+			link = l
+			break
+		}
+	}
+	if link == nil {
+		return m.showToast("No replay to switch")
+	}
+
+	mirror, position, ok := link.NextMirror()
+	if !ok {
+		return m.showToast("No other mirrors")
+	}
+
+	if m.redditClient != nil {
+		cache := m.redditClient.Cache()
+		saved := *link
+		go func() { _ = cache.Set(saved) }()
+	}
+	return m.showToast(fmt.Sprintf("Mirror %d/%d: %s", position, len(link.Mirrors), mirror.Host))
+}
+
+// pausedFeed freezes the live updates feed so bursts can be read at leisure.
+// Updates keep accumulating in model.liveUpdates while paused.
+type pausedFeed struct {
+	updates []string // Snapshot taken when paused (newest first)
+	cursor  int      // Index of the update shown at the top
+}
+
+// toggleFeedPause pauses the live updates feed, or resumes following it.
+func (m *model) toggleFeedPause() {
+	if m.pausedFeed != nil {
+		m.pausedFeed = nil
+		return
+	}
+	_, updates := m.delayedView()
+	if len(updates) == 0 {
+		return
+	}
+	m.pausedFeed = &pausedFeed{updates: slices.Clone(updates)}
+}
+
+// stepFeed moves the paused feed cursor: positive steps go back to older updates,
+// negative steps forward to newer ones.
+func (m *model) stepFeed(delta int) {
+	f := m.pausedFeed
+	if f == nil {
+		return
+	}
+	f.cursor = min(max(f.cursor+delta, 0), len(f.updates)-1)
+}
+
+// exportRawResponse saves the raw provider response of the displayed match.
+func (m *model) exportRawResponse() tea.Cmd {
+	if m.matchDetails == nil {
+		return nil
+	}
+	if m.useMockData || m.fotmobClient == nil {
+		return m.showToast("Raw export needs live data")
+	}
+	return tea.Batch(m.showToast("Exporting raw response..."), exportRawResponse(m.fotmobClient, m.matchDetails.ID))
+}
+
+// cycleDensity switches the current view's list to the next density and saves it to settings.
+func (m *model) cycleDensity() tea.Cmd {
+	var density ui.Density
+	var key string
+	switch m.currentView {
+	case viewLiveMatches:
+		m.liveDensity = m.liveDensity.Next()
+		density, key = m.liveDensity, data.DensityLiveView
+		delegate := ui.NewMatchListDelegateWithDensity(density)
+		m.liveMatchesList.SetDelegate(delegate)
+		m.upcomingMatchesList.SetDelegate(delegate)
+	case viewStats:
+		m.statsDensity = m.statsDensity.Next()
+		density, key = m.statsDensity, data.DensityFinishedView
+		m.statsMatchesList.SetDelegate(ui.NewMatchListDelegateWithDensity(density))
+	default:
+		return nil
+	}
+
+	// Persist the choice (best-effort, keeps other settings intact)
+	go func() {
+		settings, err := data.LoadSettings()
+		if err != nil {
+			return
+		}
+		if settings.Density == nil {
+			settings.Density = make(map[string]string)
+		}
+		settings.Density[key] = density.String()
+		_ = data.SaveSettings(settings)
+	}()
+
+	return m.showToast("Density: " + density.String())
+}
+
+// showToast displays a short confirmation message and schedules its removal.
+func (m *model) showToast(message string) tea.Cmd {
+	m.toastID++
+	m.toast = message
+	return scheduleToastClear(m.toastID)
+}
@@ -2,13 +2,36 @@ package app
 
 import (
 	"fmt"
+	"math"
+	"strings"
+	"time"
 
+	"github.com/0xjuanma/golazo/internal/api"
 	"github.com/0xjuanma/golazo/internal/reddit"
 	"github.com/0xjuanma/golazo/internal/ui"
 )
 
-// View renders the current application state.
+// View renders the current application state, timing each frame for the developer overlay.
 func (m model) View() string {
+	if m.frozenFrame != "" {
+		return m.frozenFrame
+	}
+	start := time.Now()
+	view := m.render()
+	if m.frames != nil {
+		m.frames.record(time.Since(start))
+	}
+	if m.commandInput != nil {
+		view = ui.OverlayCommandLine(view, m.width, m.commandInput.View())
+	}
+	if m.devOverlay {
+		view = ui.OverlayDevStats(view, m.width, m.devStats())
+	}
+	return view
+}
+
+// render renders the view for the current state.
+func (m model) render() string {
 	// DEBUG: Log that view is being called
 	m.debugLog(fmt.Sprintf("VIEW: View() called, currentView=%v, width=%d, height=%d, matchDetails=%v", m.currentView, m.width, m.height, m.matchDetails != nil))
 	if m.matchDetails != nil {
@@ -22,15 +45,17 @@ func (m model) View() string {
 
 	switch m.currentView {
 	case viewMain:
-		return ui.RenderMainMenu(m.width, m.height, m.selected, m.spinner, m.randomSpinner, m.mainViewLoading, m.getStatusBannerType(), m.animatedLogo)
+		return ui.OverlayToast(
+			ui.RenderMainMenu(m.width, m.height, m.selected, m.spinner, m.randomSpinner, m.mainViewLoading, m.getStatusBannerType(), m.animatedLogo),
+			m.width, m.toast)
 
 	case viewLiveMatches:
 		m.ensureLiveListSize()
-		return ui.RenderMultiPanelViewWithList(
+		return ui.OverlayToast(ui.RenderMultiPanelViewWithList(
 			m.width, m.height,
 			m.liveMatchesList,
-			m.matchDetails,
-			m.liveUpdates,
+			m.withBroadcasts(m.withStandings(m.displayedDetails())),
+			m.displayedLiveUpdates(),
 			m.spinner,
 			m.loading,
 			m.randomSpinner,
@@ -41,31 +66,43 @@ func (m model) View() string {
 			m.polling,
 			m.liveUpcomingMatches,
 			m.buildGoalLinksMap(),
+			m.goalLinksStatus(),
+			m.feedStatus(),
+			m.displayedCommentary(),
+			m.displayedThreadComments(),
+			m.displayedLiveStandings(),
 			m.getStatusBannerType(),
-		)
+		), m.width, m.toast)
 
 	case viewStats:
 		m.ensureStatsListSize()
 		spinner := m.ensureStatsSpinner()
-		return ui.RenderStatsViewWithList(
+		return ui.OverlayToast(ui.RenderStatsViewWithList(
 			m.width, m.height,
 			m.statsMatchesList,
-			m.matchDetails,
+			m.statsDisplayDetails(),
 			spinner,
 			m.statsViewLoading,
 			m.statsDateRange,
 			m.statsDaysLoaded,
 			m.statsTotalDays,
 			m.buildGoalLinksMap(),
+			m.goalLinksStatus(),
 			m.getStatusBannerType(),
 			&m.statsDetailsViewport,
 			m.statsRightPanelFocused,
 			m.statsScrollOffset,
-		)
+		), m.width, m.toast)
 
 	case viewSettings:
 		return ui.RenderSettingsView(m.width, m.height, m.settingsState, m.getStatusBannerType())
 
+	case viewTeam:
+		return ui.RenderTeamView(m.width, m.height, m.displayedTeam(), m.getStatusBannerType())
+
+	case viewGoalFeed:
+		return ui.RenderGoalFeedView(m.width, m.height, m.displayedGoalFeed(), m.getStatusBannerType())
+
 	default:
 		return ui.RenderMainMenu(m.width, m.height, m.selected, m.spinner, m.randomSpinner, m.mainViewLoading, m.getStatusBannerType(), m.animatedLogo)
 	}
@@ -84,7 +121,7 @@ func (m *model) ensureLiveListSize() {
 		spinnerHeight = 3
 	)
 
-	leftWidth := max(m.width*35/100, 25)
+	leftWidth := ui.LiveListPanelWidth(m.width, len(m.threadComments) > 0 && !m.standingsSplit)
 	availableWidth := leftWidth - frameH*2
 	availableHeight := m.height - frameV*2 - titleHeight - spinnerHeight
 
@@ -144,5 +181,56 @@ func (m *model) buildGoalLinksMap() ui.GoalLinksMap {
 	return result
 }
 
+// goalLinksStatus returns the goal link fetch progress for the displayed match.
+// Returns an empty string when no fetch is in flight for it.
+func (m *model) goalLinksStatus() string {
+	p := m.goalLinksProgress
+	if p == nil || m.matchDetails == nil || p.matchID != m.matchDetails.ID || p.done >= p.total {
+		return ""
+	}
+	return fmt.Sprintf("%d/%d goal links found", p.found, p.total)
+}
+
 // Ensure reddit.GoalLinkKey is used (avoid unused import)
 var _ reddit.GoalLinkKey
+
+// displayedDetails returns the selected match details shown in the live view, delayed by
+// the spoiler offset.
+func (m model) displayedDetails() *api.MatchDetails {
+	details, _ := m.delayedView()
+	return details
+}
+
+// displayedLiveUpdates returns the updates shown in the live view: the paused snapshot
+// scrolled to the cursor, or the live feed, delayed by the spoiler offset and with the
+// watch party's reactions, when following.
+func (m model) displayedLiveUpdates() []string {
+	if m.pausedFeed == nil {
+		details, updates := m.delayedView()
+		return m.withReactions(updates, details)
+	}
+	return m.pausedFeed.updates[m.pausedFeed.cursor:]
+}
+
+// feedStatus describes the paused feed and clutch time for the updates title
+// (e.g., "⏸ 3/12 · 2 new", "⚡ 7m").
+func (m model) feedStatus() string {
+	var parts []string
+	if f := m.pausedFeed; f != nil {
+		status := fmt.Sprintf("⏸ %d/%d", f.cursor+1, len(f.updates))
+		_, updates := m.delayedView()
+		if newCount := len(updates) - len(f.updates); newCount > 0 {
+			status += fmt.Sprintf(" · %d new", newCount)
+		}
+		parts = append(parts, status)
+	}
+	if m.matchDetails != nil {
+		if left := m.boostRemaining(m.matchDetails.ID); left > 0 {
+			parts = append(parts, fmt.Sprintf("⚡ %dm", int(math.Ceil(left.Minutes()))))
+		}
+	}
+	if m.spoilerOffset > 0 {
+		parts = append(parts, "⏱ -"+formatOffset(m.spoilerOffset))
+	}
+	return strings.Join(parts, "  ")
+}
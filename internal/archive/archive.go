@@ -0,0 +1,202 @@
+// Package archive stores everything golazo knows about a match in a self-contained
+// directory or zip for permanent offline reference ("golazo archive").
+package archive
+
+import (
+	"archive/zip"
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"time"
+
+	"github.com/0xjuanma/golazo/internal/api"
+	"github.com/0xjuanma/golazo/internal/data"
+	"github.com/0xjuanma/golazo/internal/reddit"
+)
+
+// Options describes what goes into an archive.
+type Options struct {
+	Version     string
+	Details     *api.MatchDetails
+	RawResponse []byte                // Raw provider response for the match, if available
+	Commentary  []api.CommentaryEntry // Minute-by-minute commentary, newest first
+	GoalLinks   []*reddit.GoalLink    // Resolved goal clips
+	Notes       []string              // What could not be fetched, kept in the manifest
+	ArchivedAt  time.Time
+}
+
+// File is a file of an archive.
+type File struct {
+	Name    string
+	Content []byte
+}
+
+// Manifest describes an archive: the match, when and by which golazo it was archived,
+// and what it holds. It is written last, so an archive without one is incomplete.
+type Manifest struct {
+	MatchID    int               `json:"match_id"`
+	Match      string            `json:"match"`
+	League     string            `json:"league,omitempty"`
+	Version    string            `json:"golazo_version"`
+	ArchivedAt time.Time         `json:"archived_at"`
+	Files      []string          `json:"files"`
+	Checksums  map[string]string `json:"checksums"` // SHA-256 of each file, hex
+	Notes      []string          `json:"notes,omitempty"`
+}
+
+// lineups is the line-ups file: formations, starters, substitutes and the announced
+// line-ups with positions when known.
+type lineups struct {
+	HomeFormation   string           `json:"home_formation,omitempty"`
+	AwayFormation   string           `json:"away_formation,omitempty"`
+	HomeStarting    []api.PlayerInfo `json:"home_starting,omitempty"`
+	AwayStarting    []api.PlayerInfo `json:"away_starting,omitempty"`
+	HomeSubstitutes []api.PlayerInfo `json:"home_substitutes,omitempty"`
+	AwaySubstitutes []api.PlayerInfo `json:"away_substitutes,omitempty"`
+	HomeLineup      *api.Lineup      `json:"home_lineup,omitempty"`
+	AwayLineup      *api.Lineup      `json:"away_lineup,omitempty"`
+	PlayerOfMatch   int              `json:"player_of_the_match_id,omitempty"`
+}
+
+// shotmap is the shot map file: every shot with its xG, and the teams' totals.
+type shotmap struct {
+	HomeTeamID int        `json:"home_team_id"`
+	AwayTeamID int        `json:"away_team_id"`
+	HomeXG     *float64   `json:"home_xg,omitempty"`
+	AwayXG     *float64   `json:"away_xg,omitempty"`
+	Shots      []api.Shot `json:"shots"`
+}
+
+// statistics is the statistics file: full match and first half.
+type statistics struct {
+	FullMatch []api.MatchStatistic `json:"full_match"`
+	FirstHalf []api.MatchStatistic `json:"first_half,omitempty"`
+}
+
+// Build returns the files of a match archive: a Markdown summary, the parsed details,
+// their events, statistics, line-ups and shot map on their own, the commentary, goal
+// links and raw provider response when available, and a manifest listing them.
+func Build(opts Options) ([]File, error) {
+	d := opts.Details
+	if d == nil {
+		return nil, fmt.Errorf("no match details to archive")
+	}
+
+	files := []File{{"summary.md", []byte(data.FormatMatchMarkdown(d))}}
+	add := func(name string, v any) error {
+		content, err := json.MarshalIndent(v, "", "  ")
+		if err != nil {
+			return fmt.Errorf("encode %s: %w", name, err)
+		}
+		files = append(files, File{name, append(content, '\n')})
+		return nil
+	}
+
+	events := d.Events
+	if events == nil {
+		events = []api.MatchEvent{}
+	}
+	shots := d.Shots
+	if shots == nil {
+		shots = []api.Shot{}
+	}
+	type part struct {
+		name string
+		v    any
+	}
+	parts := []part{
+		{"match.json", d},
+		{"events.json", events},
+		{"statistics.json", statistics{FullMatch: d.Statistics, FirstHalf: d.FirstHalfStatistics}},
+		{"lineups.json", lineups{
+			HomeFormation: d.HomeFormation, AwayFormation: d.AwayFormation,
+			HomeStarting: d.HomeStarting, AwayStarting: d.AwayStarting,
+			HomeSubstitutes: d.HomeSubstitutes, AwaySubstitutes: d.AwaySubstitutes,
+			HomeLineup: d.HomeLineup, AwayLineup: d.AwayLineup,
+			PlayerOfMatch: d.PlayerOfTheMatchID,
+		}},
+		{"shotmap.json", shotmap{HomeTeamID: d.HomeTeam.ID, AwayTeamID: d.AwayTeam.ID, HomeXG: d.HomeXG, AwayXG: d.AwayXG, Shots: shots}},
+	}
+	if len(opts.Commentary) > 0 {
+		parts = append(parts, part{"commentary.json", opts.Commentary})
+	}
+	if len(opts.GoalLinks) > 0 {
+		links := slices.Clone(opts.GoalLinks)
+		slices.SortFunc(links, func(a, b *reddit.GoalLink) int { return a.Minute - b.Minute })
+		parts = append(parts, part{"goal_links.json", links})
+	}
+	for _, part := range parts {
+		if err := add(part.name, part.v); err != nil {
+			return nil, err
+		}
+	}
+
+	if len(opts.RawResponse) > 0 {
+		raw := opts.RawResponse
+		var indented bytes.Buffer
+		if err := json.Indent(&indented, raw, "", "  "); err == nil {
+			raw = indented.Bytes()
+		}
+		files = append(files, File{"raw/match_details.json", raw})
+	}
+
+	manifest := Manifest{
+		MatchID:    d.ID,
+		Match:      fmt.Sprintf("%s vs %s", d.HomeTeam.Name, d.AwayTeam.Name),
+		League:     d.League.Name,
+		Version:    opts.Version,
+		ArchivedAt: opts.ArchivedAt.UTC(),
+		Checksums:  make(map[string]string, len(files)),
+		Notes:      opts.Notes,
+	}
+	for _, f := range files {
+		manifest.Files = append(manifest.Files, f.Name)
+		manifest.Checksums[f.Name] = checksum(f.Content)
+	}
+	if err := add("manifest.json", manifest); err != nil {
+		return nil, err
+	}
+	return files, nil
+}
+
+// checksum returns the hex SHA-256 of content.
+func checksum(content []byte) string {
+	sum := sha256.Sum256(content)
+	return hex.EncodeToString(sum[:])
+}
+
+// WriteDir writes the archive files into dir, creating it. The manifest comes last in
+// Build's files, so an interrupted write leaves no manifest.
+func WriteDir(dir string, files []File) error {
+	for _, f := range files {
+		path := filepath.Join(dir, filepath.FromSlash(f.Name))
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			return fmt.Errorf("create %s: %w", filepath.Dir(path), err)
+		}
+		if err := data.WriteFileAtomic(path, f.Content, 0644); err != nil {
+			return fmt.Errorf("write %s: %w", f.Name, err)
+		}
+	}
+	return nil
+}
+
+// WriteZip writes the archive files as a zip, under a top directory named dir.
+func WriteZip(w io.Writer, dir string, files []File) error {
+	zw := zip.NewWriter(w)
+	for _, f := range files {
+		fw, err := zw.Create(dir + "/" + f.Name)
+		if err != nil {
+			return fmt.Errorf("add %s: %w", f.Name, err)
+		}
+		if _, err := fw.Write(f.Content); err != nil {
+			return fmt.Errorf("write %s: %w", f.Name, err)
+		}
+	}
+	return zw.Close()
+}
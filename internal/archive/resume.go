@@ -0,0 +1,113 @@
+package archive
+
+import (
+	"archive/zip"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/0xjuanma/golazo/internal/data"
+)
+
+// ProgressFileName is the progress manifest of a batch run, kept next to its archives.
+const ProgressFileName = "progress.json"
+
+// Verify checks a match archive, a directory or a zip: it must have a manifest and every
+// file it lists must match its checksum. A verified archive needn't be fetched again.
+func Verify(path string) (*Manifest, error) {
+	if strings.HasSuffix(path, ".zip") {
+		zr, err := zip.OpenReader(path)
+		if err != nil {
+			return nil, err
+		}
+		defer zr.Close()
+		// Files are under the archive's top directory
+		top := strings.TrimSuffix(filepath.Base(path), ".zip")
+		sub, err := fs.Sub(zr, top)
+		if err != nil {
+			return nil, err
+		}
+		return verify(sub)
+	}
+	return verify(os.DirFS(path))
+}
+
+// verify checks the manifest and files of an archive.
+func verify(fsys fs.FS) (*Manifest, error) {
+	content, err := fs.ReadFile(fsys, "manifest.json")
+	if err != nil {
+		return nil, fmt.Errorf("no manifest: %w", err)
+	}
+	var manifest Manifest
+	if err := json.Unmarshal(content, &manifest); err != nil {
+		return nil, fmt.Errorf("decode manifest: %w", err)
+	}
+	if len(manifest.Checksums) == 0 {
+		return nil, errors.New("manifest has no checksums")
+	}
+	for _, name := range manifest.Files {
+		content, err := fs.ReadFile(fsys, name)
+		if err != nil {
+			return nil, err
+		}
+		if checksum(content) != manifest.Checksums[name] {
+			return nil, fmt.Errorf("%s: checksum mismatch", name)
+		}
+	}
+	return &manifest, nil
+}
+
+// Progress records a batch run, so an interrupted one resumes where it stopped.
+type Progress struct {
+	Done   map[int]string `json:"done"`   // Archived matches and their path
+	Failed map[int]string `json:"failed"` // Matches that failed and why; retried on the next run
+}
+
+// LoadProgress reads the progress manifest at path; a missing one is empty.
+func LoadProgress(path string) (*Progress, error) {
+	progress := &Progress{Done: make(map[int]string), Failed: make(map[int]string)}
+	f, err := os.Open(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return progress, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	if err := json.NewDecoder(io.LimitReader(f, 16<<20)).Decode(progress); err != nil {
+		return nil, fmt.Errorf("decode %s: %w", filepath.Base(path), err)
+	}
+	if progress.Done == nil {
+		progress.Done = make(map[int]string)
+	}
+	if progress.Failed == nil {
+		progress.Failed = make(map[int]string)
+	}
+	return progress, nil
+}
+
+// Save writes the progress manifest to path.
+func (p *Progress) Save(path string) error {
+	content, err := json.MarshalIndent(p, "", "  ")
+	if err != nil {
+		return err
+	}
+	return data.WriteFileAtomic(path, append(content, '\n'), 0644)
+}
+
+// MarkDone records an archived match.
+func (p *Progress) MarkDone(matchID int, path string) {
+	p.Done[matchID] = path
+	delete(p.Failed, matchID)
+}
+
+// MarkFailed records a match that could not be archived.
+func (p *Progress) MarkFailed(matchID int, err error) {
+	p.Failed[matchID] = err.Error()
+	delete(p.Done, matchID)
+}
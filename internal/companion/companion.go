@@ -0,0 +1,181 @@
+// Package companion serves the second-screen web companion: a minimal page showing
+// the live scores and the selected match's feed of a running TUI, kept current over
+// Server-Sent Events.
+package companion
+
+import (
+	"bytes"
+	_ "embed"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"sync"
+	"time"
+)
+
+//go:embed index.html
+var indexHTML []byte
+
+// KeepAliveInterval is how often an idle event stream gets a comment, so proxies and
+// phones going to sleep don't drop it silently.
+const KeepAliveInterval = 30 * time.Second
+
+// Score is a live match as shown on the companion page.
+type Score struct {
+	ID        int    `json:"id"`
+	League    string `json:"league"`
+	Home      string `json:"home"`
+	Away      string `json:"away"`
+	HomeScore *int   `json:"home_score,omitempty"`
+	AwayScore *int   `json:"away_score,omitempty"`
+	Minute    string `json:"minute,omitempty"` // e.g. "67'", "HT"
+}
+
+// Feed is the match selected in the TUI with its updates feed, newest first.
+type Feed struct {
+	Score
+	Updates []string `json:"updates"`
+}
+
+// Snapshot is what the TUI is tracking.
+type Snapshot struct {
+	Live     []Score `json:"live"`
+	Selected *Feed   `json:"selected,omitempty"`
+}
+
+// Server publishes snapshots of the TUI to the companion page and hosts its watch party.
+type Server struct {
+	live *broadcaster // Snapshots, JSON
+
+	partyMu sync.Mutex
+	party   Party
+	parties *broadcaster // Party states, JSON
+}
+
+// New creates a server with an empty snapshot and party.
+func New() *Server {
+	return &Server{
+		live:    newBroadcaster([]byte(`{"live":[]}`)),
+		party:   Party{Reactions: []Reaction{}},
+		parties: newBroadcaster([]byte(`{"offset_seconds":0,"reactions":[]}`)),
+	}
+}
+
+// Publish sends a snapshot to the connected pages. Unchanged snapshots are dropped.
+func (s *Server) Publish(snapshot Snapshot) {
+	state, err := json.Marshal(snapshot)
+	if err != nil {
+		return
+	}
+	s.live.publish(state)
+}
+
+// Serve serves the companion page on ln until it fails.
+func (s *Server) Serve(ln net.Listener) error {
+	server := &http.Server{Handler: s.Handler(), ReadHeaderTimeout: 10 * time.Second}
+	return server.Serve(ln)
+}
+
+// Handler returns the companion's routes: the page at /, the event stream at /events,
+// the current snapshot at /state, and the watch party under /party.
+func (s *Server) Handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /{$}", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+		_, _ = w.Write(indexHTML)
+	})
+	mux.HandleFunc("GET /state", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write(s.live.current())
+	})
+	mux.HandleFunc("GET /events", func(w http.ResponseWriter, r *http.Request) {
+		serveEvents(w, r, s.live)
+	})
+	s.handleParty(mux)
+	return mux
+}
+
+// broadcaster keeps the last published state and sends every change to its subscribers.
+type broadcaster struct {
+	mu          sync.Mutex
+	state       []byte
+	subscribers map[chan []byte]struct{}
+}
+
+func newBroadcaster(initial []byte) *broadcaster {
+	return &broadcaster{state: initial, subscribers: make(map[chan []byte]struct{})}
+}
+
+// publish sends state to the subscribers. Unchanged states are dropped.
+func (b *broadcaster) publish(state []byte) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if bytes.Equal(state, b.state) {
+		return
+	}
+	b.state = state
+	for ch := range b.subscribers {
+		// Slow subscribers only need the latest state
+		select {
+		case <-ch:
+		default:
+		}
+		ch <- state
+	}
+}
+
+// current returns the last published state.
+func (b *broadcaster) current() []byte {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.state
+}
+
+// subscribe returns a channel receiving the current state, then every change, and a
+// function to stop.
+func (b *broadcaster) subscribe() (<-chan []byte, func()) {
+	ch := make(chan []byte, 1)
+	b.mu.Lock()
+	b.subscribers[ch] = struct{}{}
+	ch <- b.state
+	b.mu.Unlock()
+	return ch, func() {
+		b.mu.Lock()
+		delete(b.subscribers, ch)
+		b.mu.Unlock()
+	}
+}
+
+// serveEvents streams the states of b: the current one, then every change.
+func serveEvents(w http.ResponseWriter, r *http.Request, b *broadcaster) {
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		http.Error(w, "streaming unsupported", http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+
+	ch, stop := b.subscribe()
+	defer stop()
+
+	keepAlive := time.NewTicker(KeepAliveInterval)
+	defer keepAlive.Stop()
+	for {
+		select {
+		case <-r.Context().Done():
+			return
+		case state := <-ch:
+			if _, err := fmt.Fprintf(w, "data: %s\n\n", state); err != nil {
+				return
+			}
+		case <-keepAlive.C:
+			if _, err := fmt.Fprint(w, ": keep-alive\n\n"); err != nil {
+				return
+			}
+		}
+		flusher.Flush()
+	}
+}
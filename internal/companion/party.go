@@ -0,0 +1,286 @@
+package companion
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/0xjuanma/golazo/internal/data"
+)
+
+// Watch party limits.
+const (
+	MaxPartyOffset    = 10 * time.Minute // Longest spoiler offset
+	MaxPartyReactions = 500              // Reactions kept; the oldest are dropped beyond
+	maxReactionLength = 16               // Bytes; a few emoji at most
+	maxPartyBody      = 4 << 10
+
+	// PartyRetryInterval is how long a guest waits before reconnecting to the host.
+	PartyRetryInterval = 5 * time.Second
+)
+
+// Reaction is a chat-free watch party marker on a match minute, e.g. 🔥 at 67'.
+type Reaction struct {
+	MatchID int    `json:"match_id"`
+	Minute  int    `json:"minute"`
+	Emoji   string `json:"emoji"`
+}
+
+// Party is the shared state of a watch party.
+type Party struct {
+	OffsetSeconds int        `json:"offset_seconds"` // Spoiler offset every member delays live updates by
+	Reactions     []Reaction `json:"reactions"`      // Oldest first
+}
+
+// Offset returns the spoiler offset.
+func (p Party) Offset() time.Duration {
+	return time.Duration(p.OffsetSeconds) * time.Second
+}
+
+// ValidateReaction checks a reaction before it is shared.
+func ValidateReaction(r Reaction) error {
+	switch {
+	case r.MatchID <= 0:
+		return errors.New("reaction needs a match")
+	case r.Minute < 0 || r.Minute > 130:
+		return fmt.Errorf("invalid minute %d", r.Minute)
+	case strings.TrimSpace(r.Emoji) == "" || len(r.Emoji) > maxReactionLength || !utf8.ValidString(r.Emoji):
+		return errors.New("reaction must be a short emoji")
+	}
+	return nil
+}
+
+// ValidateOffset checks a spoiler offset before it is shared.
+func ValidateOffset(offset time.Duration) error {
+	if offset < 0 || offset > MaxPartyOffset {
+		return fmt.Errorf("offset must be between 0 and %s", MaxPartyOffset)
+	}
+	return nil
+}
+
+// Party returns the current watch party state.
+func (s *Server) Party() Party {
+	s.partyMu.Lock()
+	defer s.partyMu.Unlock()
+	return s.partyCopy()
+}
+
+// React adds a reaction to the party.
+func (s *Server) React(r Reaction) error {
+	if err := ValidateReaction(r); err != nil {
+		return err
+	}
+	s.partyMu.Lock()
+	defer s.partyMu.Unlock()
+	s.party.Reactions = append(s.party.Reactions, r)
+	if extra := len(s.party.Reactions) - MaxPartyReactions; extra > 0 {
+		s.party.Reactions = s.party.Reactions[extra:]
+	}
+	s.publishParty()
+	return nil
+}
+
+// SetOffset changes the party's spoiler offset.
+func (s *Server) SetOffset(offset time.Duration) error {
+	if err := ValidateOffset(offset); err != nil {
+		return err
+	}
+	s.partyMu.Lock()
+	defer s.partyMu.Unlock()
+	s.party.OffsetSeconds = int(offset / time.Second)
+	s.publishParty()
+	return nil
+}
+
+// SubscribeParty returns a channel receiving the party state, then every change, and a
+// function to stop.
+func (s *Server) SubscribeParty() (<-chan Party, func()) {
+	states, stop := s.parties.subscribe()
+	ch := make(chan Party, 1)
+	done := make(chan struct{})
+	go func() {
+		defer close(ch)
+		for {
+			select {
+			case <-done:
+				return
+			case state := <-states:
+				var party Party
+				if json.Unmarshal(state, &party) != nil {
+					continue
+				}
+				select {
+				case ch <- party:
+				case <-done:
+					return
+				}
+			}
+		}
+	}()
+	return ch, func() {
+		stop()
+		close(done)
+	}
+}
+
+// publishParty broadcasts the party state. Call with partyMu held.
+func (s *Server) publishParty() {
+	if state, err := json.Marshal(s.party); err == nil {
+		s.parties.publish(state)
+	}
+}
+
+// partyCopy returns a copy of the party state. Call with partyMu held.
+func (s *Server) partyCopy() Party {
+	party := s.party
+	party.Reactions = append([]Reaction{}, s.party.Reactions...)
+	return party
+}
+
+// handleParty registers the watch party routes: the state at /party, its event stream
+// at /party/events, reactions posted to /party/reactions and the offset put to
+// /party/offset.
+func (s *Server) handleParty(mux *http.ServeMux) {
+	mux.HandleFunc("GET /party", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write(s.parties.current())
+	})
+	mux.HandleFunc("GET /party/events", func(w http.ResponseWriter, r *http.Request) {
+		serveEvents(w, r, s.parties)
+	})
+	mux.HandleFunc("POST /party/reactions", func(w http.ResponseWriter, r *http.Request) {
+		var reaction Reaction
+		if !decodeBody(w, r, &reaction) {
+			return
+		}
+		if err := s.React(reaction); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+	mux.HandleFunc("PUT /party/offset", func(w http.ResponseWriter, r *http.Request) {
+		var body struct {
+			Seconds int `json:"seconds"`
+		}
+		if !decodeBody(w, r, &body) {
+			return
+		}
+		if err := s.SetOffset(time.Duration(body.Seconds) * time.Second); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
+
+// decodeBody decodes a JSON request body into v, answering 400 when it can't.
+func decodeBody(w http.ResponseWriter, r *http.Request, v any) bool {
+	if err := json.NewDecoder(io.LimitReader(r.Body, maxPartyBody)).Decode(v); err != nil {
+		http.Error(w, "invalid JSON: "+err.Error(), http.StatusBadRequest)
+		return false
+	}
+	return true
+}
+
+// PartyClient is a guest of a watch party hosted by another golazo (--join).
+type PartyClient struct {
+	baseURL string
+	client  *http.Client
+}
+
+// NewPartyClient creates a guest of the party served at baseURL (e.g. "http://host:8080").
+// Requests go through the proxy configuration and privacy mode like every API client,
+// without a client timeout, which would cut the event stream.
+func NewPartyClient(baseURL string) *PartyClient {
+	return &PartyClient{baseURL: strings.TrimRight(baseURL, "/"), client: data.NewHTTPClient(0)}
+}
+
+// Follow sends the party state to ch whenever it changes until ctx is done,
+// reconnecting every PartyRetryInterval while the host is unreachable.
+func (c *PartyClient) Follow(ctx context.Context, ch chan<- Party) {
+	for ctx.Err() == nil {
+		_ = c.stream(ctx, ch)
+		select {
+		case <-ctx.Done():
+		case <-time.After(PartyRetryInterval):
+		}
+	}
+}
+
+// stream reads the party event stream until it ends.
+func (c *PartyClient) stream(ctx context.Context, ch chan<- Party) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.baseURL+"/party/events", nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "text/event-stream")
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("party events: %s", resp.Status)
+	}
+
+	scanner := bufio.NewScanner(resp.Body)
+	scanner.Buffer(make([]byte, 64*1024), 1<<20)
+	for scanner.Scan() {
+		payload, ok := strings.CutPrefix(scanner.Text(), "data: ")
+		if !ok {
+			continue // Keep-alive comments and blank separators
+		}
+		var party Party
+		if err := json.Unmarshal([]byte(payload), &party); err != nil {
+			continue
+		}
+		select {
+		case ch <- party:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+	return scanner.Err()
+}
+
+// React shares a reaction with the party.
+func (c *PartyClient) React(ctx context.Context, r Reaction) error {
+	return c.send(ctx, http.MethodPost, "/party/reactions", r)
+}
+
+// SetOffset changes the party's spoiler offset.
+func (c *PartyClient) SetOffset(ctx context.Context, offset time.Duration) error {
+	return c.send(ctx, http.MethodPut, "/party/offset", map[string]int{"seconds": int(offset / time.Second)})
+}
+
+// send sends a JSON request to the host.
+func (c *PartyClient) send(ctx context.Context, method, path string, v any) error {
+	body, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, method, c.baseURL+path, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode >= 300 {
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+		return fmt.Errorf("party host: %s", strings.TrimSpace(string(msg)))
+	}
+	return nil
+}
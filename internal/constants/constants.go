@@ -18,4 +18,6 @@ const (
 	StatusBannerNewVersion
 	// StatusBannerDev indicates this is a development build.
 	StatusBannerDev
+	// StatusBannerDegraded indicates an upstream provider's circuit breaker is open.
+	StatusBannerDegraded
 )
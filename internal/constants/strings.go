@@ -17,7 +17,11 @@ const (
 	PanelMinuteByMinute    = "Minute-by-minute"
 	PanelMatchStatistics   = "Match Statistics"
 	PanelUpdates           = "Updates"
+	PanelCommentary        = "Commentary"
+	PanelMatchThread       = "Match Thread"
+	PanelLiveTable         = "Live Table"
 	PanelLeaguePreferences = "League Preferences"
+	PanelRedditSearch      = "Reddit Search"
 )
 
 // Empty state messages
@@ -26,20 +30,33 @@ const (
 	EmptyNoFinishedMatches = "No finished matches"
 	EmptySelectMatch       = "Select a match"
 	EmptyNoUpdates         = "No updates"
+	EmptyNoCommentary      = "No commentary for this match"
+	EmptyNoShots           = "No shot data for this team"
+	EmptyNoRatings         = "No ratings"
 	EmptyNoMatches         = "No matches available"
+	EmptyNoTeamPage        = "Team page not available"
+	EmptyNoPlayerPage      = "Player page not available"
+	EmptyNoGoals           = "No goals in live matches yet"
 )
 
 // Help text
 const (
-	HelpMainMenu           = "↑/↓: navigate  Enter: select  q: quit"
-	HelpMatchesView        = "↑/↓: navigate  r: refresh details  /: filter  Esc: back  q: quit"
+	HelpMainMenu           = "↑/↓: navigate  Enter: select  :: command  q: quit"
+	HelpMatchesView        = "↑/↓: navigate  r: refresh details  o: replay  y: copy link  m: mirror  p: pause feed  [/]: step  c: commentary  b: clutch time  a: all goals  s: standings  t: table  z: density  /: filter  :: command  Esc: back  q: quit"
 	HelpSettingsView       = "↑/↓: navigate  ←/→: switch tabs  Space: toggle  /: filter  Enter: save  Esc: back"
-	HelpStatsView          = "h/l: date range  j/k: navigate  Tab: focus details  ↑/↓: scroll when focused  r: refresh details  /: filter  Esc: back"
+	HelpStatsView          = "h/l: date range  j/k: navigate  Tab: focus details  ↑/↓: scroll when focused  r: refresh details  s: standings  p: replay  z: density  /: filter  :: command  Esc: back"
 	HelpStatsViewUnfocused = "Tab: focus details"
-	HelpStatsViewFocused   = "Tab: unfocus  s: standings  f: formations  x: all statistics  ↑/↓: scroll"
-	HelpStandingsDialog    = "Esc: close"
-	HelpFormationsDialog   = "Tab/←/→: switch team  Esc: close"
+	HelpStatsViewFocused   = "Tab: unfocus  s: standings  f: formations  a: shot map  t: ratings  x: all statistics  o: replay  y: copy link  m: mirror  ↑/↓: scroll"
+	HelpStandingsDialog    = "↑/↓: select  Enter: team page  Esc: close"
+	HelpFormationsDialog   = "Tab/←/→: switch team  ↑/↓: select  Enter: player  p: pitch view  Esc: close"
+	HelpFormationsPitch    = "p: list view  Esc: close"
 	HelpStatisticsDialog   = "↑/↓: navigate  Esc: close"
+	HelpShotMapDialog      = "Tab/←/→: switch team  Esc: close"
+	HelpRatingsDialog      = "Tab/←/→: switch team  ★ player of the match  ↑ substitute  Esc: close"
+	HelpSearchDebugDialog  = "↑/↓: scroll  Esc: close"
+	HelpTeamView           = "↑/↓: scroll squad  Esc: back"
+	HelpPlayerDialog       = "Esc: close"
+	HelpGoalFeedView       = "↑/↓: scroll  r: refresh  Esc: back"
 )
 
 // Status text
@@ -60,6 +77,20 @@ const (
 const (
 	// NotificationTitleGoal is the title shown in goal notifications.
 	NotificationTitleGoal = "⚽ GOLAZO!"
+	// NotificationTitleKickoff is the title shown in kickoff reminders.
+	NotificationTitleKickoff = "⏰ Kickoff"
+	// NotificationTitleHalfTime is the title shown in half-time notifications.
+	NotificationTitleHalfTime = "⏸ Half-time"
+	// NotificationTitleLineups is the title shown when confirmed line-ups are out.
+	NotificationTitleLineups = "📋 Line-ups"
+	// NotificationTitleFirstGoal is the title shown in first goal alerts.
+	NotificationTitleFirstGoal = "🥇 First goal"
+	// NotificationTitleLateGoal is the title shown in late goal alerts.
+	NotificationTitleLateGoal = "⏱ Late goal"
+	// NotificationTitleHatTrick is the title shown in hat-trick alerts.
+	NotificationTitleHatTrick = "🎩 Hat-trick!"
+	// NotificationTitleThreeGoals is the title shown when a team reaches three goals.
+	NotificationTitleThreeGoals = "🔥 Three goals"
 )
 
 // Stats labels
@@ -0,0 +1,267 @@
+package data
+
+import (
+	_ "embed"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/0xjuanma/golazo/internal/api"
+)
+
+// CatalogueOverlayFileName is the catalogue update written by "golazo catalogue update"
+// in the config directory, merged over the embedded catalogue at load time.
+const CatalogueOverlayFileName = "catalogue.json"
+
+// catalogueJSON is the league and team catalogue, regenerated with
+// scripts/generate_catalogue.go.
+//
+//go:embed catalogue.json
+var catalogueJSON []byte
+
+// Catalogue is league and team metadata shipped with golazo, so alias matching,
+// team colors and list filtering work offline and on first run without API calls.
+type Catalogue struct {
+	Version int               `json:"version"`
+	Leagues []CatalogueLeague `json:"leagues"`
+	Teams   []CatalogueTeam   `json:"teams"`
+}
+
+// CatalogueLeague is a league in the catalogue.
+type CatalogueLeague struct {
+	ID      int      `json:"id"` // FotMob league ID
+	Name    string   `json:"name"`
+	Country string   `json:"country"`
+	Region  string   `json:"region"`
+	Aliases []string `json:"aliases,omitempty"` // e.g. "EPL"
+}
+
+// CatalogueTeam is a team in the catalogue.
+type CatalogueTeam struct {
+	ID          int               `json:"id"` // FotMob team ID
+	Name        string            `json:"name"`
+	ShortName   string            `json:"short_name,omitempty"`
+	Country     string            `json:"country"`
+	LeagueID    int               `json:"league_id"`
+	Aliases     []string          `json:"aliases,omitempty"`      // e.g. "Spurs", "Man Utd"
+	Colors      []string          `json:"colors,omitempty"`       // Hex colors, primary first
+	ProviderIDs map[string]string `json:"provider_ids,omitempty"` // IDs at other providers, e.g. "espn"
+}
+
+// Names returns the team's name, short name and aliases.
+func (t CatalogueTeam) Names() []string {
+	names := []string{t.Name}
+	if t.ShortName != "" && t.ShortName != t.Name {
+		names = append(names, t.ShortName)
+	}
+	return append(names, t.Aliases...)
+}
+
+// Names returns the league's name and aliases.
+func (l CatalogueLeague) Names() []string {
+	return append([]string{l.Name}, l.Aliases...)
+}
+
+// continentalCountries are the Country values of international competitions.
+var continentalCountries = []string{"International", "Europe", "South America", "North America", "Asia", "Africa"}
+
+// Domestic reports whether the league is a national league rather than an international
+// competition, i.e. whether its teams belong to it.
+func (l CatalogueLeague) Domestic() bool {
+	return !slices.Contains(continentalCountries, l.Country)
+}
+
+// loadCatalogue parses the embedded catalogue and merges the user's overlay over it,
+// once. A malformed overlay is ignored.
+var loadCatalogue = sync.OnceValue(func() *Catalogue {
+	catalogue := EmbeddedCatalogue()
+	if overlay, err := LoadCatalogueOverlay(); err == nil {
+		catalogue.Merge(overlay)
+	}
+	return catalogue
+})
+
+// CurrentCatalogue returns the league and team catalogue: the one shipped with golazo,
+// updated by "golazo catalogue update".
+func CurrentCatalogue() *Catalogue {
+	return loadCatalogue()
+}
+
+// EmbeddedCatalogue returns a copy of the league and team catalogue shipped with golazo.
+// A malformed catalogue is treated as empty, since every lookup has a fallback.
+func EmbeddedCatalogue() *Catalogue {
+	var catalogue Catalogue
+	if err := json.Unmarshal(catalogueJSON, &catalogue); err != nil {
+		return &Catalogue{}
+	}
+	return &catalogue
+}
+
+// catalogueOverlayPath returns the path to the catalogue overlay.
+func catalogueOverlayPath() (string, error) {
+	dir, err := ConfigDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, CatalogueOverlayFileName), nil
+}
+
+// LoadCatalogueOverlay returns the catalogue update saved by SaveCatalogueOverlay, or an
+// empty catalogue when there is none.
+func LoadCatalogueOverlay() (*Catalogue, error) {
+	path, err := catalogueOverlayPath()
+	if err != nil {
+		return nil, err
+	}
+	raw, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return &Catalogue{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var overlay Catalogue
+	if err := json.Unmarshal(raw, &overlay); err != nil {
+		return nil, fmt.Errorf("parse %s: %w", path, err)
+	}
+	return &overlay, nil
+}
+
+// SaveCatalogueOverlay writes the catalogue update merged over the embedded catalogue.
+func SaveCatalogueOverlay(overlay *Catalogue) error {
+	path, err := catalogueOverlayPath()
+	if err != nil {
+		return err
+	}
+	raw, err := json.MarshalIndent(overlay, "", "  ")
+	if err != nil {
+		return fmt.Errorf("marshal catalogue: %w", err)
+	}
+	return WriteFileAtomic(path, raw, 0644)
+}
+
+// Merge applies overlay to the catalogue: leagues and teams are matched by ID, set
+// fields replace existing ones, aliases and provider IDs are added, and unknown
+// entries are appended.
+func (c *Catalogue) Merge(overlay *Catalogue) {
+	if overlay.Version > c.Version {
+		c.Version = overlay.Version
+	}
+	for _, league := range overlay.Leagues {
+		i := slices.IndexFunc(c.Leagues, func(l CatalogueLeague) bool { return l.ID == league.ID })
+		if i < 0 {
+			c.Leagues = append(c.Leagues, league)
+			continue
+		}
+		existing := &c.Leagues[i]
+		overrideIfSet(&existing.Name, league.Name)
+		overrideIfSet(&existing.Country, league.Country)
+		overrideIfSet(&existing.Region, league.Region)
+		existing.Aliases = appendNew(existing.Aliases, league.Aliases...)
+	}
+	for _, team := range overlay.Teams {
+		i := slices.IndexFunc(c.Teams, func(t CatalogueTeam) bool { return t.ID == team.ID })
+		if i < 0 {
+			c.Teams = append(c.Teams, team)
+			continue
+		}
+		existing := &c.Teams[i]
+		overrideIfSet(&existing.Name, team.Name)
+		overrideIfSet(&existing.ShortName, team.ShortName)
+		overrideIfSet(&existing.Country, team.Country)
+		if team.LeagueID != 0 {
+			existing.LeagueID = team.LeagueID
+		}
+		existing.Aliases = appendNew(existing.Aliases, team.Aliases...)
+		if len(team.Colors) > 0 {
+			existing.Colors = team.Colors
+		}
+		for provider, id := range team.ProviderIDs {
+			if existing.ProviderIDs == nil {
+				existing.ProviderIDs = make(map[string]string)
+			}
+			existing.ProviderIDs[provider] = id
+		}
+	}
+}
+
+// overrideIfSet sets *field to value unless value is empty.
+func overrideIfSet(field *string, value string) {
+	if value != "" {
+		*field = value
+	}
+}
+
+// appendNew appends the values not already in list (case-insensitive).
+func appendNew(list []string, values ...string) []string {
+	for _, value := range values {
+		if !slices.ContainsFunc(list, func(s string) bool { return strings.EqualFold(s, value) }) {
+			list = append(list, value)
+		}
+	}
+	return list
+}
+
+// League returns the league with the FotMob ID.
+func (c *Catalogue) League(id int) (CatalogueLeague, bool) {
+	for _, league := range c.Leagues {
+		if league.ID == id {
+			return league, true
+		}
+	}
+	return CatalogueLeague{}, false
+}
+
+// LeagueByName returns the league whose name or alias is name (case-insensitive).
+func (c *Catalogue) LeagueByName(name string) (CatalogueLeague, bool) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return CatalogueLeague{}, false
+	}
+	for _, league := range c.Leagues {
+		for _, candidate := range league.Names() {
+			if strings.EqualFold(candidate, name) {
+				return league, true
+			}
+		}
+	}
+	return CatalogueLeague{}, false
+}
+
+// Team returns the catalogue entry for a team, by FotMob ID or else by name, short
+// name or alias (case-insensitive).
+func (c *Catalogue) Team(team api.Team) (CatalogueTeam, bool) {
+	if team.ID != 0 {
+		for _, entry := range c.Teams {
+			if entry.ID == team.ID {
+				return entry, true
+			}
+		}
+	}
+	for _, name := range []string{team.Name, team.ShortName} {
+		if entry, ok := c.TeamByName(name); ok {
+			return entry, true
+		}
+	}
+	return CatalogueTeam{}, false
+}
+
+// TeamByName returns the team whose name, short name or alias is name (case-insensitive).
+func (c *Catalogue) TeamByName(name string) (CatalogueTeam, bool) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return CatalogueTeam{}, false
+	}
+	for _, entry := range c.Teams {
+		for _, candidate := range entry.Names() {
+			if strings.EqualFold(candidate, name) {
+				return entry, true
+			}
+		}
+	}
+	return CatalogueTeam{}, false
+}
@@ -0,0 +1,127 @@
+package data
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// staleTempAge is how old a leftover temporary file must be before it is removed;
+// younger ones may belong to a write in progress in another process.
+const staleTempAge = time.Minute
+
+// WriteFileChecked writes data atomically like WriteFileAtomic and records its SHA-256 in
+// "<path>.sha256" (sha256sum format). The previous content is kept as "<path>.prev" and
+// its checksum listed too. The checksums are written first, so whichever version of the
+// file a crash leaves behind is listed, and ReadFileChecked can tell it from a damaged
+// one and recover "<path>.prev" instead.
+func WriteFileChecked(path string, data []byte, perm os.FileMode) error {
+	previous, err := os.ReadFile(path)
+	if err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("read %s: %w", filepath.Base(path), err)
+	}
+	if err := keepPrevious(path); err != nil {
+		return err
+	}
+
+	sums := checksumLine(data, filepath.Base(path))
+	if previous != nil {
+		sums += checksumLine(previous, filepath.Base(path)+".prev")
+	}
+	if err := WriteFileAtomic(path+".sha256", []byte(sums), perm); err != nil {
+		return err
+	}
+	return WriteFileAtomic(path, data, perm)
+}
+
+// ReadFileChecked reads a file written by WriteFileChecked, verifying it against its
+// checksums. When the file matches none, the previous version is returned if it does
+// (recovered is true). Files without checksums, or where neither version matches (e.g.,
+// edited by hand), are returned as they are.
+func ReadFileChecked(path string) (data []byte, recovered bool, err error) {
+	removeStaleTemps(path)
+
+	data, err = os.ReadFile(path)
+	if err != nil && !os.IsNotExist(err) {
+		return nil, false, err
+	}
+	sums, sumErr := os.ReadFile(path + ".sha256")
+	if sumErr != nil || matchesChecksum(data, sums) {
+		return data, false, err
+	}
+	if prev, prevErr := os.ReadFile(path + ".prev"); prevErr == nil && matchesChecksum(prev, sums) {
+		return prev, true, nil
+	}
+	return data, false, err
+}
+
+// RemovePrevious deletes the previous copy WriteFileChecked keeps of path, for files whose
+// old content must not linger on disk once replaced (e.g., removed secrets).
+func RemovePrevious(path string) error {
+	if err := os.Remove(path + ".prev"); err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("remove previous copy: %w", err)
+	}
+	return nil
+}
+
+// checksumLine returns the sha256sum line of data for a file named name.
+func checksumLine(data []byte, name string) string {
+	sum := sha256.Sum256(data)
+	return fmt.Sprintf("%s  %s\n", hex.EncodeToString(sum[:]), name)
+}
+
+// matchesChecksum reports whether data matches any line of a sha256sum file.
+func matchesChecksum(data, sums []byte) bool {
+	sum := sha256.Sum256(data)
+	want := hex.EncodeToString(sum[:])
+	for line := range bytes.Lines(sums) {
+		if fields := bytes.Fields(line); len(fields) > 0 && string(fields[0]) == want {
+			return true
+		}
+	}
+	return false
+}
+
+// keepPrevious links (or copies) the current content of path to "<path>.prev", so the
+// file itself is never missing during a write.
+func keepPrevious(path string) error {
+	prev := path + ".prev"
+	if err := os.Remove(prev); err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("remove previous copy: %w", err)
+	}
+	if err := os.Link(path, prev); err == nil || os.IsNotExist(err) {
+		return nil
+	}
+
+	// Hard links are not supported everywhere; fall back to a copy
+	src, err := os.Open(path)
+	if err != nil {
+		return fmt.Errorf("open %s: %w", filepath.Base(path), err)
+	}
+	defer src.Close()
+	dst, err := os.Create(prev)
+	if err != nil {
+		return fmt.Errorf("create previous copy: %w", err)
+	}
+	if _, err := io.Copy(dst, src); err != nil {
+		_ = dst.Close()
+		return fmt.Errorf("copy previous content: %w", err)
+	}
+	return dst.Close()
+}
+
+// removeStaleTemps deletes temporary files WriteFileAtomic left next to path when a
+// process died mid-write.
+func removeStaleTemps(path string) {
+	temps, _ := filepath.Glob(path + ".*.tmp")
+	for _, tmp := range temps {
+		if info, err := os.Stat(tmp); err == nil && time.Since(info.ModTime()) > staleTempAge {
+			_ = os.Remove(tmp)
+		}
+	}
+}
@@ -0,0 +1,56 @@
+package data
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// commandHistoryFileName stores the ":" command mode history in the config directory,
+// one command per line, oldest first.
+const commandHistoryFileName = "command_history"
+
+// CommandHistoryLimit is how many commands the history keeps.
+const CommandHistoryLimit = 200
+
+// LoadCommandHistory returns the saved command history, oldest first. A missing file
+// is an empty history.
+func LoadCommandHistory() ([]string, error) {
+	dir, err := ConfigDir()
+	if err != nil {
+		return nil, err
+	}
+	content, err := os.ReadFile(filepath.Join(dir, commandHistoryFileName))
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("read command history: %w", err)
+	}
+
+	var history []string
+	for _, line := range strings.Split(string(content), "\n") {
+		if line = strings.TrimSpace(line); line != "" {
+			history = append(history, line)
+		}
+	}
+	return history, nil
+}
+
+// SaveCommandHistory writes the command history, keeping the CommandHistoryLimit most
+// recent commands.
+func SaveCommandHistory(history []string) error {
+	dir, err := ConfigDir()
+	if err != nil {
+		return err
+	}
+	if len(history) > CommandHistoryLimit {
+		history = history[len(history)-CommandHistoryLimit:]
+	}
+	content := strings.Join(history, "\n") + "\n"
+	if err := WriteFileAtomic(filepath.Join(dir, commandHistoryFileName), []byte(content), 0644); err != nil {
+		return fmt.Errorf("write command history: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,243 @@
+package data
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/pbkdf2"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"sync"
+)
+
+const (
+	// CredentialsFileName is the encrypted credentials store in the config directory.
+	CredentialsFileName = "credentials.enc"
+	// PassphraseEnv supplies the credentials passphrase without a prompt (scripts, tmux).
+	PassphraseEnv = "GOLAZO_PASSPHRASE"
+
+	// credentialsSchemaVersion is the credentials file format version.
+	credentialsSchemaVersion = 1
+	// keyIterations is the PBKDF2-SHA256 work factor (OWASP 2023 recommendation).
+	keyIterations = 600_000
+)
+
+// ErrWrongPassphrase is returned when the credentials cannot be decrypted.
+var ErrWrongPassphrase = errors.New("wrong passphrase or damaged credentials file")
+
+// credentialsSchema is the credentials file format and its migrations.
+var credentialsSchema = Schema{Name: "credentials store", Version: credentialsSchemaVersion}
+
+// credentialsFile is the on-disk format: the credentials as JSON, sealed with AES-256-GCM
+// under a key derived from the passphrase. Byte fields are base64 in JSON.
+type credentialsFile struct {
+	Version int    `json:"version"`
+	Salt    []byte `json:"salt"`
+	Nonce   []byte `json:"nonce"`
+	Sealed  []byte `json:"sealed"`
+}
+
+// Credentials is an unlocked credentials store: named secrets (e.g. "proxy") kept
+// encrypted in the config directory instead of plaintext settings, for systems without
+// an OS keyring (see KeyringCredential).
+type Credentials struct {
+	path       string
+	passphrase string
+	values     map[string]string
+}
+
+var (
+	sessionMu          sync.RWMutex
+	sessionCredentials *Credentials // Unlocked once per process by UnlockSession
+)
+
+// credentialsPath returns the path of the credentials store.
+func credentialsPath() (string, error) {
+	dir, err := ConfigDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, CredentialsFileName), nil
+}
+
+// CredentialsExist reports whether a credentials store has been created.
+func CredentialsExist() bool {
+	path, err := credentialsPath()
+	if err != nil {
+		return false
+	}
+	_, err = os.Stat(path)
+	return err == nil
+}
+
+// OpenCredentials decrypts the credentials store with passphrase. When there is no
+// store yet, an empty one is returned that Save creates with this passphrase.
+func OpenCredentials(passphrase string) (*Credentials, error) {
+	path, err := credentialsPath()
+	if err != nil {
+		return nil, err
+	}
+	creds := &Credentials{path: path, passphrase: passphrase, values: make(map[string]string)}
+
+	raw, _, err := credentialsSchema.Load(path)
+	if err != nil || raw == nil {
+		return creds, err
+	}
+	var file credentialsFile
+	if err := json.Unmarshal(raw, &file); err != nil {
+		return nil, fmt.Errorf("parse credentials file: %w", err)
+	}
+	gcm, err := credentialsCipher(passphrase, file.Salt)
+	if err != nil {
+		return nil, err
+	}
+	plain, err := gcm.Open(nil, file.Nonce, file.Sealed, credentialsAAD())
+	if err != nil {
+		return nil, ErrWrongPassphrase
+	}
+	if err := json.Unmarshal(plain, &creds.values); err != nil {
+		return nil, fmt.Errorf("parse credentials: %w", err)
+	}
+	return creds, nil
+}
+
+// Get returns a stored secret.
+func (c *Credentials) Get(name string) (string, bool) {
+	value, ok := c.values[name]
+	return value, ok
+}
+
+// Set stores a secret; call Save to persist it.
+func (c *Credentials) Set(name, value string) {
+	c.values[name] = value
+}
+
+// Delete removes a secret; call Save to persist it.
+func (c *Credentials) Delete(name string) bool {
+	_, ok := c.values[name]
+	delete(c.values, name)
+	return ok
+}
+
+// Names returns the names of the stored secrets, sorted.
+func (c *Credentials) Names() []string {
+	names := make([]string, 0, len(c.values))
+	for name := range c.values {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	return names
+}
+
+// Save encrypts the store with a fresh salt and nonce and writes it, readable only by
+// the user. The previous copy kept for crash recovery is deleted once the write is done,
+// so removed or replaced secrets don't linger in it.
+func (c *Credentials) Save() error {
+	plain, err := json.Marshal(c.values)
+	if err != nil {
+		return fmt.Errorf("marshal credentials: %w", err)
+	}
+	file := credentialsFile{Version: credentialsSchemaVersion, Salt: make([]byte, 16)}
+	if _, err := rand.Read(file.Salt); err != nil {
+		return fmt.Errorf("generate salt: %w", err)
+	}
+	gcm, err := credentialsCipher(c.passphrase, file.Salt)
+	if err != nil {
+		return err
+	}
+	file.Nonce = make([]byte, gcm.NonceSize())
+	if _, err := rand.Read(file.Nonce); err != nil {
+		return fmt.Errorf("generate nonce: %w", err)
+	}
+	file.Sealed = gcm.Seal(nil, file.Nonce, plain, credentialsAAD())
+
+	raw, err := json.MarshalIndent(file, "", "  ")
+	if err != nil {
+		return fmt.Errorf("marshal credentials file: %w", err)
+	}
+	if err := WriteFileChecked(c.path, raw, 0600); err != nil {
+		return err
+	}
+	return RemovePrevious(c.path)
+}
+
+// credentialsCipher derives the AES-256-GCM cipher for a passphrase and salt.
+func credentialsCipher(passphrase string, salt []byte) (cipher.AEAD, error) {
+	key, err := pbkdf2.Key(sha256.New, passphrase, salt, keyIterations, 32)
+	if err != nil {
+		return nil, fmt.Errorf("derive key: %w", err)
+	}
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+// credentialsAAD binds the sealed data to this file format.
+func credentialsAAD() []byte {
+	return fmt.Appendf(nil, "golazo credentials v%d", credentialsSchemaVersion)
+}
+
+// UnlockSession opens the credentials store for the rest of the process, so secrets
+// are read with Credential. The passphrase is asked for once, through prompt, unless
+// PassphraseEnv is set. Does nothing when there is no store or it is already unlocked.
+func UnlockSession(prompt func() (string, error)) error {
+	sessionMu.Lock()
+	defer sessionMu.Unlock()
+	if sessionCredentials != nil || !CredentialsExist() {
+		return nil
+	}
+
+	passphrase, ok := os.LookupEnv(PassphraseEnv)
+	if !ok {
+		if prompt == nil {
+			return fmt.Errorf("credentials are locked: set %s", PassphraseEnv)
+		}
+		var err error
+		if passphrase, err = prompt(); err != nil {
+			return err
+		}
+	}
+	creds, err := OpenCredentials(passphrase)
+	if err != nil {
+		return err
+	}
+	sessionCredentials = creds
+	return nil
+}
+
+// Credential returns a secret from the OS keyring, or else from the session's unlocked
+// store, the fallback where no keyring is available. Callers fall back to environment
+// variables themselves.
+func Credential(name string) (string, bool) {
+	if value, ok := KeyringCredential(name); ok {
+		return value, true
+	}
+	sessionMu.RLock()
+	defer sessionMu.RUnlock()
+	if sessionCredentials == nil {
+		return "", false
+	}
+	return sessionCredentials.Get(name)
+}
+
+// CredentialValues returns every secret read from the keyring and of the session's
+// unlocked store, for redaction.
+func CredentialValues() []string {
+	values := keyringValues()
+	sessionMu.RLock()
+	defer sessionMu.RUnlock()
+	if sessionCredentials == nil {
+		return values
+	}
+	for _, name := range sessionCredentials.Names() {
+		values = append(values, sessionCredentials.values[name])
+	}
+	return values
+}
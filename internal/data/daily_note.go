@@ -0,0 +1,137 @@
+package data
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/0xjuanma/golazo/internal/api"
+)
+
+// DailyNoteDatePlaceholder is replaced with the match date (YYYY-MM-DD) in daily_note_path.
+const DailyNoteDatePlaceholder = "{{date}}"
+
+// dailyNoteMarker tags each appended line with its match ID so re-runs don't duplicate entries.
+const dailyNoteMarker = "<!-- golazo:%d -->"
+
+// dailyNoteMu serializes appends so concurrent callers can't write the same result twice.
+var dailyNoteMu sync.Mutex
+
+// DailyNotePath expands a daily note path template for the given date.
+// Supports a leading "~/" for the home directory.
+func DailyNotePath(template string, date time.Time) (string, error) {
+	path := strings.ReplaceAll(template, DailyNoteDatePlaceholder, date.Format("2006-01-02"))
+
+	if rest, ok := strings.CutPrefix(path, "~/"); ok {
+		homeDir, err := os.UserHomeDir()
+		if err != nil {
+			return "", fmt.Errorf("get home directory: %w", err)
+		}
+		path = filepath.Join(homeDir, rest)
+	}
+
+	return path, nil
+}
+
+// AppendDailyNotes appends finished match results to the daily note for each match's local date.
+// Matches already present in a note are skipped. Returns the number of results appended.
+func AppendDailyNotes(template string, matches []api.Match) (int, error) {
+	if template == "" {
+		return 0, fmt.Errorf("daily_note_path is not set in %s", settingsFileName)
+	}
+
+	// Group by note file so each file is read and written once
+	byPath := make(map[string][]api.Match)
+	var paths []string
+	for _, match := range matches {
+		if match.Status != api.MatchStatusFinished || match.MatchTime == nil {
+			continue
+		}
+		path, err := DailyNotePath(template, match.MatchTime.Local())
+		if err != nil {
+			return 0, err
+		}
+		if _, ok := byPath[path]; !ok {
+			paths = append(paths, path)
+		}
+		byPath[path] = append(byPath[path], match)
+	}
+
+	dailyNoteMu.Lock()
+	defer dailyNoteMu.Unlock()
+
+	appended := 0
+	for _, path := range paths {
+		n, err := appendToNote(path, byPath[path])
+		appended += n
+		if err != nil {
+			return appended, err
+		}
+	}
+
+	return appended, nil
+}
+
+// appendToNote appends result lines for matches not yet recorded in the note at path.
+func appendToNote(path string, matches []api.Match) (int, error) {
+	existing, err := os.ReadFile(path)
+	if err != nil && !os.IsNotExist(err) {
+		return 0, fmt.Errorf("read daily note: %w", err)
+	}
+
+	var lines []string
+	for _, match := range matches {
+		marker := fmt.Sprintf(dailyNoteMarker, match.ID)
+		if strings.Contains(string(existing), marker) {
+			continue
+		}
+		lines = append(lines, formatDailyNoteLine(match)+" "+marker)
+	}
+	if len(lines) == 0 {
+		return 0, nil
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return 0, fmt.Errorf("create daily note directory: %w", err)
+	}
+
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		return 0, fmt.Errorf("open daily note: %w", err)
+	}
+	defer f.Close()
+
+	content := strings.Join(lines, "\n") + "\n"
+	if len(existing) > 0 && !strings.HasSuffix(string(existing), "\n") {
+		content = "\n" + content
+	}
+	if _, err := f.WriteString(content); err != nil {
+		return 0, fmt.Errorf("write daily note: %w", err)
+	}
+
+	return len(lines), nil
+}
+
+// formatDailyNoteLine formats a result as a Markdown list item:
+// "- ⚽ Arsenal 2 - 1 Chelsea (Premier League)".
+func formatDailyNoteLine(match api.Match) string {
+	homeScore, awayScore := 0, 0
+	if match.HomeScore != nil {
+		homeScore = *match.HomeScore
+	}
+	if match.AwayScore != nil {
+		awayScore = *match.AwayScore
+	}
+
+	line := fmt.Sprintf("- ⚽ %s %d - %d %s", match.HomeTeam.Name, homeScore, awayScore, match.AwayTeam.Name)
+	if match.Shootout != nil {
+		line += fmt.Sprintf(" (%d-%d pens)", match.Shootout.HomeScore, match.Shootout.AwayScore)
+	}
+	if match.League.Name != "" {
+		line += fmt.Sprintf(" (%s)", match.League.Name)
+	}
+	return line
+}
@@ -0,0 +1,29 @@
+//go:build windows
+
+package data
+
+import (
+	"os"
+
+	"golang.org/x/sys/windows"
+)
+
+// lockRange locks the whole file (LockFileEx locks byte ranges).
+const lockRange = ^uint32(0)
+
+// lockFile takes an exclusive LockFileEx lock on f, blocking until it is available.
+func lockFile(f *os.File) error {
+	var ol windows.Overlapped
+	return windows.LockFileEx(windows.Handle(f.Fd()), windows.LOCKFILE_EXCLUSIVE_LOCK, 0, lockRange, lockRange, &ol)
+}
+
+// unlockFile releases the LockFileEx lock on f.
+func unlockFile(f *os.File) error {
+	var ol windows.Overlapped
+	return windows.UnlockFileEx(windows.Handle(f.Fd()), 0, lockRange, lockRange, &ol)
+}
+
+// syncDir is a no-op: Windows cannot flush directories, and NTFS journals renames.
+func syncDir(string) error {
+	return nil
+}
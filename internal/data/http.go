@@ -0,0 +1,106 @@
+package data
+
+import (
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/0xjuanma/golazo/internal/httpx"
+)
+
+var (
+	proxySetting     string
+	proxySettingOnce sync.Once
+
+	chaos httpx.Chaos // Fault injection for clients created after SetChaos (--chaos)
+)
+
+// SetChaos enables fault injection in HTTP clients created afterwards, and in mock
+// data requests (see MockTransportFault). Call it before creating API clients.
+func SetChaos(c httpx.Chaos) {
+	chaos = c
+}
+
+// NewHTTPClient returns an HTTP client with the given timeout that honors proxy configuration
+// and privacy mode. All API clients (FotMob, Reddit, version check) should be created through this.
+func NewHTTPClient(timeout time.Duration) *http.Client {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.Proxy = proxyForRequest
+
+	var rt http.RoundTripper = transport
+	if chaos.Enabled() {
+		rt = &httpx.ChaosTransport{Base: transport, Chaos: chaos}
+	}
+	return &http.Client{
+		Timeout:   timeout,
+		Transport: &privacyTransport{base: rt},
+	}
+}
+
+// proxyForRequest resolves the proxy for a request. Precedence:
+//  1. the "proxy" option in settings.yaml
+//  2. the "proxy" secret of the OS keyring, or else of the unlocked credentials store
+//  3. HTTPS_PROXY / HTTP_PROXY (respecting NO_PROXY)
+//  4. ALL_PROXY (respecting NO_PROXY)
+//
+// Proxy URLs may use the http, https, socks5 or socks5h schemes.
+func proxyForRequest(req *http.Request) (*url.URL, error) {
+	proxySettingOnce.Do(func() {
+		if settings, err := LoadSettings(); err == nil {
+			proxySetting = strings.TrimSpace(settings.Proxy)
+		}
+	})
+	if proxySetting != "" {
+		return parseProxyURL(proxySetting)
+	}
+	if proxy, ok := Credential("proxy"); ok && proxy != "" {
+		return parseProxyURL(proxy)
+	}
+
+	if proxy, err := http.ProxyFromEnvironment(req); proxy != nil || err != nil {
+		return proxy, err
+	}
+
+	allProxy := os.Getenv("ALL_PROXY")
+	if allProxy == "" {
+		allProxy = os.Getenv("all_proxy")
+	}
+	if allProxy == "" || noProxy(req.URL.Hostname()) {
+		return nil, nil
+	}
+	return parseProxyURL(allProxy)
+}
+
+// parseProxyURL parses a proxy address, defaulting to http:// when no scheme is given.
+func parseProxyURL(raw string) (*url.URL, error) {
+	if !strings.Contains(raw, "://") {
+		raw = "http://" + raw
+	}
+	return url.Parse(raw)
+}
+
+// noProxy reports whether host is excluded by NO_PROXY (comma-separated hosts or domain suffixes, or "*").
+func noProxy(host string) bool {
+	value := os.Getenv("NO_PROXY")
+	if value == "" {
+		value = os.Getenv("no_proxy")
+	}
+	host = strings.ToLower(host)
+	for entry := range strings.SplitSeq(value, ",") {
+		entry = strings.ToLower(strings.TrimSpace(entry))
+		if entry == "" {
+			continue
+		}
+		if entry == "*" {
+			return true
+		}
+		entry = strings.TrimPrefix(entry, ".")
+		if host == entry || strings.HasSuffix(host, "."+entry) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,75 @@
+package data
+
+import (
+	"errors"
+	"sync"
+
+	"github.com/zalando/go-keyring"
+)
+
+// KeyringService is the service name golazo's secrets are stored under in the OS keyring
+// (macOS Keychain, Windows Credential Manager, Secret Service on Linux).
+const KeyringService = "golazo"
+
+var (
+	keyringMu    sync.Mutex
+	keyringCache = make(map[string]keyringEntry) // Lookups per secret, kept for the process
+)
+
+// keyringEntry is the cached result of a keyring lookup.
+type keyringEntry struct {
+	value string
+	ok    bool
+}
+
+// KeyringCredential returns a secret from the OS keyring. Lookups are cached, since
+// the proxy is resolved on every request; a keyring that is missing or locked reads as
+// no secret.
+func KeyringCredential(name string) (string, bool) {
+	keyringMu.Lock()
+	defer keyringMu.Unlock()
+	if entry, ok := keyringCache[name]; ok {
+		return entry.value, entry.ok
+	}
+	value, err := keyring.Get(KeyringService, name)
+	entry := keyringEntry{value: value, ok: err == nil && value != ""}
+	keyringCache[name] = entry
+	return entry.value, entry.ok
+}
+
+// SetKeyringCredential stores a secret in the OS keyring. It fails when no keyring is
+// available, e.g. on a headless Linux box without a Secret Service.
+func SetKeyringCredential(name, value string) error {
+	keyringMu.Lock()
+	defer keyringMu.Unlock()
+	if err := keyring.Set(KeyringService, name, value); err != nil {
+		return err
+	}
+	keyringCache[name] = keyringEntry{value: value, ok: true}
+	return nil
+}
+
+// DeleteKeyringCredential removes a secret from the OS keyring, reporting whether it was there.
+func DeleteKeyringCredential(name string) (bool, error) {
+	keyringMu.Lock()
+	defer keyringMu.Unlock()
+	delete(keyringCache, name)
+	err := keyring.Delete(KeyringService, name)
+	if errors.Is(err, keyring.ErrNotFound) {
+		return false, nil
+	}
+	return err == nil, err
+}
+
+// keyringValues returns the secrets read from the keyring so far, for redaction.
+func keyringValues() []string {
+	keyringMu.Lock()
+	defer keyringMu.Unlock()
+	var values []string
+	for _, entry := range keyringCache {
+		if entry.ok {
+			values = append(values, entry.value)
+		}
+	}
+	return values
+}
@@ -0,0 +1,114 @@
+package data
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/0xjuanma/golazo/internal/api"
+)
+
+// matchExportDir is the config subdirectory holding exported match summaries.
+const matchExportDir = "exports"
+
+// SaveMatchMarkdown writes a Markdown summary of a match (score, goals, cards and
+// statistics) to the exports directory and returns the file path.
+func SaveMatchMarkdown(details *api.MatchDetails) (string, error) {
+	dir, err := ConfigDir()
+	if err != nil {
+		return "", err
+	}
+	dir = filepath.Join(dir, matchExportDir)
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return "", fmt.Errorf("create exports directory: %w", err)
+	}
+
+	path := filepath.Join(dir, fmt.Sprintf("match-%d-%s.md", details.ID, time.Now().Format("20060102-150405")))
+	if err := WriteFileAtomic(path, []byte(FormatMatchMarkdown(details)), 0644); err != nil {
+		return "", fmt.Errorf("write match export: %w", err)
+	}
+	return path, nil
+}
+
+// FormatMatchMarkdown formats a match summary as Markdown.
+func FormatMatchMarkdown(details *api.MatchDetails) string {
+	var b strings.Builder
+	homeScore, awayScore := "-", "-"
+	if details.HomeScore != nil && details.AwayScore != nil {
+		homeScore, awayScore = fmt.Sprint(*details.HomeScore), fmt.Sprint(*details.AwayScore)
+	}
+	fmt.Fprintf(&b, "# %s %s - %s %s", details.HomeTeam.Name, homeScore, awayScore, details.AwayTeam.Name)
+	if details.Shootout != nil {
+		fmt.Fprintf(&b, " (%d-%d pens)", details.Shootout.HomeScore, details.Shootout.AwayScore)
+	}
+	b.WriteString("\n\n")
+
+	var facts []string
+	if details.League.Name != "" {
+		facts = append(facts, details.League.Name)
+	}
+	if details.MatchTime != nil {
+		facts = append(facts, details.MatchTime.Local().Format("2006-01-02 15:04"))
+	}
+	if venue := details.VenueText(); venue != "" {
+		facts = append(facts, venue)
+	}
+	if details.LiveTime != nil && *details.LiveTime != "" {
+		facts = append(facts, *details.LiveTime)
+	}
+	if len(facts) > 0 {
+		b.WriteString(strings.Join(facts, " · ") + "\n\n")
+	}
+
+	var events []string
+	for _, event := range details.Events {
+		if line := formatMarkdownEvent(event); line != "" {
+			events = append(events, line)
+		}
+	}
+	if len(events) > 0 {
+		b.WriteString("## Events\n\n" + strings.Join(events, "\n") + "\n\n")
+	}
+
+	if len(details.Statistics) > 0 {
+		fmt.Fprintf(&b, "## Statistics\n\n| %s | | %s |\n|---:|:---:|:---|\n", details.HomeTeam.Name, details.AwayTeam.Name)
+		for _, stat := range details.Statistics {
+			fmt.Fprintf(&b, "| %s | %s | %s |\n", stat.HomeValue, stat.Label, stat.AwayValue)
+		}
+		b.WriteString("\n")
+	}
+
+	return strings.TrimSuffix(b.String(), "\n")
+}
+
+// formatMarkdownEvent formats a goal or card as a Markdown list item:
+// "- 67' ⚽ Saka (Arsenal)". Other events are left out.
+func formatMarkdownEvent(event api.MatchEvent) string {
+	var icon string
+	switch strings.ToLower(event.Type) {
+	case "goal":
+		icon = "⚽"
+		if event.OwnGoal != nil && *event.OwnGoal {
+			icon = "⚽ (OG)"
+		}
+	case "card":
+		icon = "🟨"
+		if event.EventType != nil && strings.Contains(strings.ToLower(*event.EventType), "red") {
+			icon = "🟥"
+		}
+	default:
+		return ""
+	}
+
+	minute := event.DisplayMinute
+	if minute == "" {
+		minute = fmt.Sprintf("%d'", event.Minute)
+	}
+	player := "Unknown"
+	if event.Player != nil && *event.Player != "" {
+		player = *event.Player
+	}
+	return fmt.Sprintf("- %s %s %s (%s)", minute, icon, player, event.Team.Name)
+}
@@ -0,0 +1,48 @@
+package data
+
+import (
+	"context"
+	"errors"
+	"math/rand/v2"
+	"net/http"
+	"time"
+
+	"github.com/0xjuanma/golazo/internal/httpx"
+)
+
+// ErrChaosMalformed stands in for a mock response body that fails to parse.
+var ErrChaosMalformed = errors.New("chaos: malformed response body")
+
+// MockTransportFault injects the SetChaos transport faults into a mock data request, as
+// ChaosTransport does for HTTP requests: it waits a random latency up to MaxLatency,
+// then fails at ErrorRate with a 429 or 500 httpx.StatusError, so retries and the
+// circuit breaker treat it like a failing API. Returns nil when chaos is off.
+func MockTransportFault(ctx context.Context) error {
+	if !chaos.Enabled() {
+		return nil
+	}
+	if chaos.MaxLatency > 0 {
+		select {
+		case <-time.After(rand.N(chaos.MaxLatency)):
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+	if rand.Float64() < chaos.ErrorRate {
+		status := http.StatusInternalServerError
+		if rand.IntN(2) == 0 {
+			status = http.StatusTooManyRequests
+		}
+		return &httpx.StatusError{StatusCode: status}
+	}
+	return nil
+}
+
+// MockBodyFault fails a mock data request that got through at MalformedRate, with
+// ErrChaosMalformed. Returns nil when chaos is off.
+func MockBodyFault() error {
+	if chaos.MalformedRate > 0 && rand.Float64() < chaos.MalformedRate {
+		return ErrChaosMalformed
+	}
+	return nil
+}
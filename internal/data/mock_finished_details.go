@@ -37,9 +37,9 @@ func MockFinishedMatchDetails(matchID int) (*api.MatchDetails, error) {
 		Attendance: getMockAttendance(matchID),
 	}
 
-	// Add mock penalties for some matches to demonstrate the feature
-	if penalties := getMockPenalties(matchID); penalties != nil {
-		details.Penalties = penalties
+	// Add mock penalty shootouts for some matches to demonstrate the feature
+	if shootout := getMockShootout(matchID); shootout != nil {
+		details.Shootout = shootout
 	}
 
 	// Add mock highlights for some matches to demonstrate the feature
@@ -176,22 +176,29 @@ func generateFinishedMatchEvents(matchID int, match api.Match) []api.MatchEvent
 	return events
 }
 
-// getMockPenalties returns mock penalty data for testing the penalties feature.
-// Only some matches have penalties to simulate real-world scenarios.
-func getMockPenalties(matchID int) *struct {
-	Home *int `json:"home,omitempty"`
-	Away *int `json:"away,omitempty"`
-} {
+// getMockShootout returns mock penalty shootout data for testing the shootout feature.
+// Only some matches have shootouts to simulate real-world scenarios.
+func getMockShootout(matchID int) *api.Shootout {
 	switch matchID {
 	case 1005: // PSG 2-3 Bayern (went to penalties)
-		home := 4
-		away := 5
-		return &struct {
-			Home *int `json:"home,omitempty"`
-			Away *int `json:"away,omitempty"`
-		}{Home: &home, Away: &away}
+		return &api.Shootout{
+			HomeScore: 4,
+			AwayScore: 5,
+			Kicks: []api.ShootoutKick{
+				{Home: true, Player: "Mbappé", Scored: true},
+				{Home: false, Player: "Kane", Scored: true},
+				{Home: true, Player: "Dembélé", Scored: true},
+				{Home: false, Player: "Musiala", Scored: true},
+				{Home: true, Player: "Vitinha", Scored: false},
+				{Home: false, Player: "Sané", Scored: true},
+				{Home: true, Player: "Hakimi", Scored: true},
+				{Home: false, Player: "Kimmich", Scored: true},
+				{Home: true, Player: "Ramos", Scored: true},
+				{Home: false, Player: "Müller", Scored: true},
+			},
+		}
 	default:
-		return nil // No penalties for this match
+		return nil // No shootout for this match
 	}
 }
 
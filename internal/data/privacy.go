@@ -0,0 +1,86 @@
+package data
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// OutboundLogFileName lists, in privacy mode, every host golazo contacted (config directory).
+const OutboundLogFileName = "outbound_hosts.log"
+
+// ErrBlockedByPrivacyMode is returned for requests to hosts privacy mode doesn't allow.
+var ErrBlockedByPrivacyMode = errors.New("blocked by privacy mode")
+
+// privacyAllowedHosts are the only hosts contacted in privacy mode: the match data provider.
+var privacyAllowedHosts = []string{"www.fotmob.com", "fotmob.com"}
+
+var (
+	privacyMode   atomic.Bool
+	outboundMu    sync.Mutex
+	outboundHosts = make(map[string]bool) // Hosts already logged this run
+)
+
+// SetPrivacyMode enables privacy mode (privacy_mode setting or --private): optional
+// outbound calls (update checks, Reddit goal links and match threads, trace export) are
+// skipped, requests to any host but FotMob fail, and each host contacted is logged on
+// first use to OutboundLogFileName. Call it before creating API clients.
+func SetPrivacyMode(on bool) {
+	privacyMode.Store(on)
+}
+
+// PrivacyMode reports whether privacy mode is enabled.
+func PrivacyMode() bool {
+	return privacyMode.Load()
+}
+
+// privacyTransport enforces privacy mode on the requests of an HTTP client.
+type privacyTransport struct {
+	base http.RoundTripper
+}
+
+// RoundTrip logs the request's host on first use and refuses hosts outside the allowlist.
+func (t *privacyTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if !PrivacyMode() {
+		return t.base.RoundTrip(req)
+	}
+	host := strings.ToLower(req.URL.Hostname())
+	allowed := slices.Contains(privacyAllowedHosts, host)
+	logOutboundHost(host, allowed)
+	if !allowed {
+		return nil, fmt.Errorf("%s: %w", host, ErrBlockedByPrivacyMode)
+	}
+	return t.base.RoundTrip(req)
+}
+
+// logOutboundHost appends host to the outbound log the first time it is seen this run.
+func logOutboundHost(host string, allowed bool) {
+	outboundMu.Lock()
+	defer outboundMu.Unlock()
+	if outboundHosts[host] {
+		return
+	}
+	outboundHosts[host] = true
+
+	dir, err := ConfigDir()
+	if err != nil {
+		return
+	}
+	f, err := os.OpenFile(filepath.Join(dir, OutboundLogFileName), os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		return
+	}
+	defer func() { _ = f.Close() }()
+	verdict := "allowed"
+	if !allowed {
+		verdict = "blocked"
+	}
+	_, _ = fmt.Fprintf(f, "[%s] %s %s\n", time.Now().Format("2006-01-02 15:04:05"), verdict, host)
+}
@@ -0,0 +1,38 @@
+package data
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// rawDumpDir is the config subdirectory holding exported provider responses.
+const rawDumpDir = "dumps"
+
+// SaveRawResponse writes a provider's raw response for a match to the dumps directory,
+// indented when it is valid JSON, and returns the file path. Attach the file to bug
+// reports about parsing.
+func SaveRawResponse(matchID int, body []byte) (string, error) {
+	dir, err := ConfigDir()
+	if err != nil {
+		return "", err
+	}
+	dir = filepath.Join(dir, rawDumpDir)
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return "", fmt.Errorf("create dumps directory: %w", err)
+	}
+
+	var indented bytes.Buffer
+	if err := json.Indent(&indented, body, "", "  "); err == nil {
+		body = indented.Bytes()
+	}
+
+	path := filepath.Join(dir, fmt.Sprintf("match-%d-%s.json", matchID, time.Now().Format("20060102-150405")))
+	if err := WriteFileAtomic(path, body, 0644); err != nil {
+		return "", fmt.Errorf("write raw response: %w", err)
+	}
+	return path, nil
+}
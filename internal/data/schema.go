@@ -0,0 +1,97 @@
+package data
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+)
+
+// ErrIncompatibleSchema is returned for persistent data that cannot be migrated to
+// the current format, e.g. a file written by a newer golazo.
+var ErrIncompatibleSchema = errors.New("incompatible data format")
+
+// Migration upgrades raw data from one schema version to the next.
+type Migration func(raw []byte) ([]byte, error)
+
+// Schema describes the versioned on-disk format of persistent data. The version is
+// stamped in a top-level "version" field; data without one predates versioning and is
+// version 1.
+type Schema struct {
+	Name       string            // Used in errors, e.g. "goal link cache"
+	Version    int               // Current format version
+	Migrations map[int]Migration // Upgrade from the keyed version to the next
+}
+
+// SchemaVersion returns the version stamp of raw JSON data.
+func SchemaVersion(raw []byte) int {
+	trimmed := bytes.TrimSpace(raw)
+	if len(trimmed) == 0 || trimmed[0] != '{' {
+		return 1
+	}
+	var stamp struct {
+		Version int `json:"version"`
+	}
+	if json.Unmarshal(trimmed, &stamp) != nil || stamp.Version < 1 {
+		return 1
+	}
+	return stamp.Version
+}
+
+// Migrate upgrades raw data to the current version, one migration at a time.
+// migrated reports whether any migration ran. Returns an error wrapping
+// ErrIncompatibleSchema when the data is newer than this build or cannot be migrated.
+func (s Schema) Migrate(raw []byte) (out []byte, migrated bool, err error) {
+	version := SchemaVersion(raw)
+	if version > s.Version {
+		return nil, false, fmt.Errorf("%s format v%d is newer than this golazo supports (v%d): %w",
+			s.Name, version, s.Version, ErrIncompatibleSchema)
+	}
+	for ; version < s.Version; version++ {
+		migrate, ok := s.Migrations[version]
+		if !ok {
+			return nil, false, fmt.Errorf("%s has no migration from v%d: %w", s.Name, version, ErrIncompatibleSchema)
+		}
+		if raw, err = migrate(raw); err != nil {
+			return nil, false, fmt.Errorf("migrate %s from v%d: %w: %w", s.Name, version, err, ErrIncompatibleSchema)
+		}
+		migrated = true
+	}
+	return raw, migrated, nil
+}
+
+// Load reads a versioned file written by WriteFileChecked and migrates it to the current
+// version. stale reports that the file should be rewritten, because it was migrated or
+// recovered from its previous copy. A missing file returns nil data and no error. When
+// the file is incompatible it is moved aside (see SetAside) so the caller can rebuild
+// from scratch without losing the old data.
+func (s Schema) Load(path string) (raw []byte, stale bool, err error) {
+	raw, recovered, err := ReadFileChecked(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, false, nil
+		}
+		return nil, false, fmt.Errorf("read %s: %w", s.Name, err)
+	}
+	out, migrated, err := s.Migrate(raw)
+	if errors.Is(err, ErrIncompatibleSchema) {
+		if backup, setErr := SetAside(path, SchemaVersion(raw)); setErr == nil {
+			err = fmt.Errorf("%w; moved to %s", err, backup)
+		}
+	}
+	return out, migrated || recovered, err
+}
+
+// SetAside renames an incompatible file to <path>.v<version>.bak, where it can be
+// restored by a golazo that understands it, and drops its checksum and previous copy.
+// Returns the backup path.
+func SetAside(path string, version int) (string, error) {
+	backup := fmt.Sprintf("%s.v%d.bak", path, version)
+	if err := os.Rename(path, backup); err != nil {
+		return "", fmt.Errorf("move aside %s: %w", path, err)
+	}
+	_ = os.Remove(path + ".sha256")
+	_ = os.Remove(path + ".prev")
+	return backup, nil
+}
@@ -0,0 +1,91 @@
+package data
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+const (
+	// SessionFileName is the TUI session state in the config directory.
+	SessionFileName = "session.json"
+	// sessionSchemaVersion is the session file format version.
+	sessionSchemaVersion = 1
+)
+
+// sessionSchema is the session file format. Session state is disposable, so an
+// incompatible file is ignored rather than migrated.
+var sessionSchema = Schema{Name: "session state", Version: sessionSchemaVersion}
+
+// Session is the TUI state saved on quit. CleanShutdown doubles as the shutdown marker:
+// it is cleared while the TUI runs and set again once it has shut down cleanly.
+type Session struct {
+	Version       int       `json:"version"`
+	View          string    `json:"view,omitempty"`     // "live" or "finished"; empty on the main menu
+	MatchID       int       `json:"match_id,omitempty"` // Match shown when quitting
+	CleanShutdown bool      `json:"clean_shutdown"`
+	SavedAt       time.Time `json:"saved_at"`
+}
+
+// sessionPath returns the path to the session file.
+func sessionPath() (string, error) {
+	dir, err := ConfigDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, SessionFileName), nil
+}
+
+// LoadSession returns the last saved session. ok is false when there is none.
+func LoadSession() (session Session, ok bool) {
+	path, err := sessionPath()
+	if err != nil {
+		return Session{}, false
+	}
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		return Session{}, false
+	}
+	if raw, _, err = sessionSchema.Migrate(raw); err != nil {
+		return Session{}, false
+	}
+	if err := json.Unmarshal(raw, &session); err != nil {
+		return Session{}, false
+	}
+	return session, true
+}
+
+// BeginSession marks the session as running and reports whether the previous one
+// crashed, i.e. never reached EndSession. The previous session is returned as saved.
+func BeginSession() (previous Session, crashed bool) {
+	previous, ok := LoadSession()
+	crashed = ok && !previous.CleanShutdown
+
+	running := previous
+	running.CleanShutdown = false
+	_ = saveSession(running)
+	return previous, crashed
+}
+
+// EndSession saves the session state with the clean shutdown marker set.
+func EndSession(session Session) error {
+	session.CleanShutdown = true
+	return saveSession(session)
+}
+
+// saveSession writes the session file.
+func saveSession(session Session) error {
+	path, err := sessionPath()
+	if err != nil {
+		return err
+	}
+	session.Version = sessionSchemaVersion
+	session.SavedAt = time.Now()
+	raw, err := json.Marshal(session)
+	if err != nil {
+		return fmt.Errorf("marshal session: %w", err)
+	}
+	return WriteFileAtomic(path, raw, 0644)
+}
@@ -1,10 +1,16 @@
 package data
 
 import (
+	"errors"
+	"fmt"
+	"net/url"
 	"os"
 	"path/filepath"
 	"slices"
+	"strings"
+	"time"
 
+	"github.com/0xjuanma/golazo/internal/api"
 	"gopkg.in/yaml.v3"
 )
 
@@ -154,8 +160,307 @@ type Settings struct {
 	// SelectedLeagues contains the IDs of leagues the user wants to follow.
 	// If empty, all supported leagues are used.
 	SelectedLeagues []int `yaml:"selected_leagues"`
+
+	// MutedLeagues contains the IDs of leagues whose goals don't send desktop notifications
+	// (":mute <league>" in the TUI).
+	MutedLeagues []int `yaml:"muted_leagues,omitempty"`
+
+	// FollowedTeams contains team names (full or short, case-insensitive) the user follows.
+	FollowedTeams []string `yaml:"followed_teams,omitempty"`
+
+	// DailyNotePath is the Markdown note that followed teams' results are appended to.
+	// {{date}} is replaced with the match date (YYYY-MM-DD), e.g. "~/notes/{{date}}.md".
+	DailyNotePath string `yaml:"daily_note_path,omitempty"`
+
+	// Country is where the user watches, as an ISO 3166-1 alpha-3 code (e.g. "GBR", "USA").
+	// Upcoming and live matches show the TV stations showing them there. Empty shows none.
+	Country string `yaml:"country,omitempty"`
+
+	// EventLog enables archiving every detected match event to events.jsonl (JSON Lines).
+	EventLog bool `yaml:"event_log,omitempty"`
+
+	// PlayerCommand opens goal replays/highlights (key "o"), e.g. "mpv %s".
+	// Empty uses the system default handler (open/xdg-open/start).
+	PlayerCommand string `yaml:"player_command,omitempty"`
+
+	// Proxy routes all API requests through an HTTP or SOCKS5 proxy
+	// (e.g., "http://proxy:8080", "socks5://127.0.0.1:1080"). Overrides HTTP(S)_PROXY/ALL_PROXY.
+	Proxy string `yaml:"proxy,omitempty"`
+
+	// ListItemTemplate customizes the match list item line using Go template syntax,
+	// e.g. "{{.Home}} {{.Score}} {{.Away}} · {{.League}} · {{.Minute}}". Empty uses the default.
+	ListItemTemplate string `yaml:"list_item_template,omitempty"`
+
+	// FinishedColumns adds optional fields to finished list items, in priority order:
+	// "xg", "attendance", "referee", "replay". Values appear once match details are loaded.
+	FinishedColumns []string `yaml:"finished_columns,omitempty"`
+
+	// Density sets list item density per view ("live", "finished"):
+	// "comfortable" (default), "compact" or "dense". Toggled with "z" in each view.
+	Density map[string]string `yaml:"density,omitempty"`
+
+	// ReplaySpeed is the finished-match replay speed ("p" in the stats view)
+	// in match minutes per second. Default 2; "+"/"-" adjust it during playback.
+	ReplaySpeed float64 `yaml:"replay_speed,omitempty"`
+
+	// GoalLinkArchive looks up goal links for matches older than 3 days in the
+	// Arctic Shift Reddit archive, where Reddit's own search finds little.
+	GoalLinkArchive bool `yaml:"goal_link_archive,omitempty"`
+
+	// PowerSaver lengthens poll intervals and disables animations to save battery:
+	// "auto" (default) while on battery power, "on" always, "off" never.
+	PowerSaver string `yaml:"power_saver,omitempty"`
+
+	// GoalLinkCacheMaxEntries caps the goal link cache (default 5000); least recently
+	// used links are evicted beyond it. GoalLinkCacheDays is how long links are kept (default 7).
+	GoalLinkCacheMaxEntries int `yaml:"goal_link_cache_max_entries,omitempty"`
+	GoalLinkCacheDays       int `yaml:"goal_link_cache_days,omitempty"`
+
+	// PrivacyMode limits network traffic to FotMob: update checks, Reddit goal links and
+	// match threads, and trace export are off, and contacted hosts are logged (see SetPrivacyMode).
+	PrivacyMode bool `yaml:"privacy_mode,omitempty"`
+
+	// FotmobSigning selects how FotMob requests get their x-mas signature header:
+	// "auto" (default) tries each signing backend in turn, "off" sends requests unsigned,
+	// and an http(s) URL fetches tokens from that endpoint instead of FotMob's.
+	FotmobSigning string `yaml:"fotmob_signing,omitempty"`
+
+	// FotmobMirrors are alternate FotMob API base URLs (e.g. "https://mirror.example.com/api"),
+	// tried in order when www.fotmob.com/api blocks, rate limits or fails. Unused in privacy mode.
+	FotmobMirrors []string `yaml:"fotmob_mirrors,omitempty"`
+
+	// Macros bind keys to chains of ":" commands run in order, e.g.
+	// {f5: [refresh, links, "export md"]}. Keys use Bubble Tea names ("f5", "ctrl+r", "alt+1").
+	Macros map[string][]string `yaml:"macros,omitempty"`
+
+	// Alerts toggles milestone alerts sent on top of goal notifications.
+	Alerts *AlertSettings `yaml:"alerts,omitempty"`
+
+	// Rotation cycles through views and their matches hands-free (e.g., on a second monitor).
+	Rotation *RotationSettings `yaml:"rotation,omitempty"`
+
+	// CacheTTL overrides how long FotMob responses are cached per data type (see CacheTTLKeys),
+	// as Go durations, e.g. {standings: 30m, live_details: 10s}.
+	CacheTTL map[string]string `yaml:"cache_ttl,omitempty"`
+
+	// GoalSearchWindows overrides how long before and after kick-off goal clips are searched
+	// for, by league ID, e.g. {9478: {before: 24h, after: 72h}} for a tournament in a
+	// distant time zone. Default: the match day (12h each way).
+	GoalSearchWindows map[int]SearchWindowSettings `yaml:"goal_search_windows,omitempty"`
+}
+
+// SearchWindowSettings is a competition's goal clip search window (goal_search_windows setting).
+type SearchWindowSettings struct {
+	Before string `yaml:"before,omitempty"` // Go duration, e.g. 24h
+	After  string `yaml:"after,omitempty"`
+}
+
+// maxSearchWindow bounds each side of a goal clip search window.
+const maxSearchWindow = 7 * 24 * time.Hour
+
+// RotationSettings configures view auto-rotation (rotation setting).
+type RotationSettings struct {
+	// Views to cycle through, in order: "live" and/or "finished". Rotation is off when empty.
+	Views []string `yaml:"views"`
+	// Dwell is the time each match is shown per view (e.g., live: 30s). Default DefaultRotationDwell.
+	Dwell map[string]string `yaml:"dwell,omitempty"`
+}
+
+// Rotation views and timing (rotation setting).
+const (
+	RotationLive         = "live"
+	RotationFinished     = "finished"
+	DefaultRotationDwell = 20 * time.Second
+	minRotationDwell     = 5 * time.Second
+)
+
+// DwellFor returns how long each match is shown in a rotation view.
+// Call ValidateRotation first; invalid durations fall back to the default.
+func (r *RotationSettings) DwellFor(view string) time.Duration {
+	if d, err := time.ParseDuration(r.Dwell[view]); err == nil && d >= minRotationDwell {
+		return d
+	}
+	return DefaultRotationDwell
+}
+
+// ValidateRotation returns an error for unknown rotation views or invalid dwell times.
+func ValidateRotation(r *RotationSettings) error {
+	if r == nil {
+		return nil
+	}
+	for _, view := range r.Views {
+		if view != RotationLive && view != RotationFinished {
+			return fmt.Errorf("unknown view %q (want live or finished)", view)
+		}
+	}
+	for view, value := range r.Dwell {
+		if view != RotationLive && view != RotationFinished {
+			return fmt.Errorf("unknown dwell view %q (want live or finished)", view)
+		}
+		d, err := time.ParseDuration(value)
+		if err != nil {
+			return fmt.Errorf("dwell for %s: %w", view, err)
+		}
+		if d < minRotationDwell {
+			return fmt.Errorf("dwell for %s must be at least %s", view, minRotationDwell)
+		}
+	}
+	return nil
+}
+
+// AlertSettings toggles the built-in milestone alert rules (alerts setting).
+type AlertSettings struct {
+	FirstGoal  bool `yaml:"first_goal,omitempty"`  // First goal of the match
+	LateGoal   bool `yaml:"late_goal,omitempty"`   // Goal from LateGoalMinute on
+	HatTrick   bool `yaml:"hat_trick,omitempty"`   // Player completes a hat-trick
+	ThreeGoals bool `yaml:"three_goals,omitempty"` // Team reaches three goals
+
+	// LateGoalMinute is the minute late goals start from. Default DefaultLateGoalMinute.
+	LateGoalMinute int `yaml:"late_goal_minute,omitempty"`
+}
+
+// DefaultLateGoalMinute is the minute late goal alerts start from by default.
+const DefaultLateGoalMinute = 85
+
+// LateGoalMinuteOrDefault returns the minute late goals start from.
+func (a *AlertSettings) LateGoalMinuteOrDefault() int {
+	if a.LateGoalMinute > 0 {
+		return a.LateGoalMinute
+	}
+	return DefaultLateGoalMinute
+}
+
+// ValidateAlerts checks the alerts setting.
+func ValidateAlerts(a *AlertSettings) error {
+	if a == nil {
+		return nil
+	}
+	if a.LateGoalMinute < 0 || a.LateGoalMinute > 120 {
+		return fmt.Errorf("late_goal_minute must be between 1 and 120, got %d", a.LateGoalMinute)
+	}
+	return nil
+}
+
+// Power saver modes (power_saver setting).
+const (
+	PowerSaverAuto = "auto"
+	PowerSaverOn   = "on"
+	PowerSaverOff  = "off"
+)
+
+// ValidatePowerSaver returns an error for unknown power_saver values ("" means auto).
+func ValidatePowerSaver(mode string) error {
+	switch mode {
+	case "", PowerSaverAuto, PowerSaverOn, PowerSaverOff:
+		return nil
+	}
+	return fmt.Errorf("unknown mode %q (want auto, on or off)", mode)
+}
+
+// FotMob signing modes (fotmob_signing setting); any other value is a token endpoint URL.
+const (
+	FotmobSigningAuto = "auto"
+	FotmobSigningOff  = "off"
+)
+
+// ValidateFotmobSigning returns an error for fotmob_signing values that are neither a
+// mode nor an http(s) URL ("" means auto).
+func ValidateFotmobSigning(value string) error {
+	switch value {
+	case "", FotmobSigningAuto, FotmobSigningOff:
+		return nil
+	}
+	if u, err := url.Parse(value); err == nil && (u.Scheme == "http" || u.Scheme == "https") && u.Host != "" {
+		return nil
+	}
+	return fmt.Errorf("unknown value %q (want auto, off or an http(s) token endpoint URL)", value)
+}
+
+// ValidateFotmobMirrors returns an error for fotmob_mirrors entries that aren't http(s) URLs.
+func ValidateFotmobMirrors(mirrors []string) error {
+	for _, mirror := range mirrors {
+		u, err := url.Parse(mirror)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return fmt.Errorf("invalid mirror %q (want an http(s) base URL)", mirror)
+		}
+	}
+	return nil
+}
+
+// Cache TTL setting keys (cache_ttl), one per type of FotMob response.
+const (
+	CacheTTLLiveDetails     = "live_details"
+	CacheTTLUpcomingDetails = "upcoming_details"
+	CacheTTLFinishedDetails = "finished_details"
+	CacheTTLMatches         = "matches"
+	CacheTTLLiveMatches     = "live_matches"
+	CacheTTLStandings       = "standings"
+)
+
+// CacheTTLKeys are the valid cache_ttl keys.
+var CacheTTLKeys = []string{
+	CacheTTLLiveDetails, CacheTTLUpcomingDetails, CacheTTLFinishedDetails,
+	CacheTTLMatches, CacheTTLLiveMatches, CacheTTLStandings,
+}
+
+// ValidateCountry returns an error unless country is empty or an ISO 3166-1 alpha-3 code.
+func ValidateCountry(country string) error {
+	if country == "" {
+		return nil
+	}
+	if len(country) != 3 || strings.ContainsFunc(country, func(r rune) bool { return r < 'A' || r > 'Z' }) {
+		return fmt.Errorf("invalid country %q (want a three-letter code, e.g. GBR, USA, ESP)", country)
+	}
+	return nil
 }
 
+// ValidateCacheTTL returns an error for unknown cache_ttl keys or values that aren't
+// positive durations.
+func ValidateCacheTTL(ttls map[string]string) error {
+	for key, value := range ttls {
+		if !slices.Contains(CacheTTLKeys, key) {
+			return fmt.Errorf("unknown key %q (want one of %s)", key, strings.Join(CacheTTLKeys, ", "))
+		}
+		if d, err := time.ParseDuration(value); err != nil || d <= 0 {
+			return fmt.Errorf("invalid %s duration %q (e.g., 30s, 15m, 168h)", key, value)
+		}
+	}
+	return nil
+}
+
+// ValidateGoalSearchWindows returns an error for search windows whose sides aren't
+// positive durations of at most a week.
+func ValidateGoalSearchWindows(windows map[int]SearchWindowSettings) error {
+	for leagueID, window := range windows {
+		for side, value := range map[string]string{"before": window.Before, "after": window.After} {
+			if value == "" {
+				continue
+			}
+			if d, err := time.ParseDuration(value); err != nil || d <= 0 || d > maxSearchWindow {
+				return fmt.Errorf("league %d: invalid %s duration %q (e.g., 12h, 72h; at most %s)", leagueID, side, value, maxSearchWindow)
+			}
+		}
+	}
+	return nil
+}
+
+// CacheTTLDurations returns the valid cache_ttl overrides as durations.
+func (s *Settings) CacheTTLDurations() map[string]time.Duration {
+	durations := make(map[string]time.Duration)
+	for key, value := range s.CacheTTL {
+		if d, err := time.ParseDuration(value); err == nil && d > 0 {
+			durations[key] = d
+		}
+	}
+	return durations
+}
+
+// Density setting keys for each list view.
+const (
+	DensityLiveView     = "live"
+	DensityFinishedView = "finished"
+)
+
 // SettingsPath returns the path to the settings file.
 func SettingsPath() (string, error) {
 	dir, err := ConfigDir()
@@ -165,13 +470,30 @@ func SettingsPath() (string, error) {
 	return filepath.Join(dir, settingsFileName), nil
 }
 
+// ErrInvalidSettings is returned by ReadSettings when settings.yaml is not valid YAML.
+var ErrInvalidSettings = errors.New("invalid settings.yaml")
+
 // LoadSettings reads settings from the settings.yaml file.
 // Returns default settings (empty selection = all leagues) if file doesn't exist.
 func LoadSettings() (*Settings, error) {
-	path, err := SettingsPath()
+	settings, err := ReadSettings()
+	if errors.Is(err, ErrInvalidSettings) {
+		// Invalid YAML - return empty settings
+		return &Settings{}, nil
+	}
 	if err != nil {
 		return &Settings{}, err
 	}
+	return settings, nil
+}
+
+// ReadSettings reads settings like LoadSettings, but reports invalid YAML as an error
+// wrapping ErrInvalidSettings instead of returning empty settings.
+func ReadSettings() (*Settings, error) {
+	path, err := SettingsPath()
+	if err != nil {
+		return nil, err
+	}
 
 	data, err := os.ReadFile(path)
 	if err != nil {
@@ -179,18 +501,30 @@ func LoadSettings() (*Settings, error) {
 			// No settings file - return empty settings (will use all leagues)
 			return &Settings{}, nil
 		}
-		return &Settings{}, err
+		return nil, err
 	}
 
 	var settings Settings
 	if err := yaml.Unmarshal(data, &settings); err != nil {
-		// Invalid YAML - return empty settings
-		return &Settings{}, nil
+		return nil, fmt.Errorf("%w: %w", ErrInvalidSettings, err)
 	}
 
 	return &settings, nil
 }
 
+// SettingsModTime returns when settings.yaml was last modified (zero if it doesn't exist).
+func SettingsModTime() time.Time {
+	path, err := SettingsPath()
+	if err != nil {
+		return time.Time{}
+	}
+	info, err := os.Stat(path)
+	if err != nil {
+		return time.Time{}
+	}
+	return info.ModTime()
+}
+
 // SaveSettings writes settings to the settings.yaml file.
 func SaveSettings(settings *Settings) error {
 	path, err := SettingsPath()
@@ -247,6 +581,39 @@ func (s *Settings) IsLeagueSelected(leagueID int) bool {
 	return slices.Contains(s.SelectedLeagues, leagueID)
 }
 
+// IsLeagueMuted checks if a league ID is in the muted list.
+func (s *Settings) IsLeagueMuted(leagueID int) bool {
+	return slices.Contains(s.MutedLeagues, leagueID)
+}
+
+// FollowsTeam reports whether the team is in FollowedTeams (by full or short name,
+// or by a catalogue alias such as "Spurs").
+func (s *Settings) FollowsTeam(team api.Team) bool {
+	names := []string{team.Name, team.ShortName}
+	if entry, ok := CurrentCatalogue().Team(team); ok {
+		names = append(names, entry.Names()...)
+	}
+	for _, name := range s.FollowedTeams {
+		for _, candidate := range names {
+			if candidate != "" && strings.EqualFold(name, candidate) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// FollowedMatches returns the matches involving at least one followed team.
+func (s *Settings) FollowedMatches(matches []api.Match) []api.Match {
+	var followed []api.Match
+	for _, match := range matches {
+		if s.FollowsTeam(match.HomeTeam) || s.FollowsTeam(match.AwayTeam) {
+			followed = append(followed, match)
+		}
+	}
+	return followed
+}
+
 // GetAllRegions returns a list of all available regions in order.
 func GetAllRegions() []string {
 	return []string{RegionEurope, RegionAmerica, RegionGlobal}
@@ -5,7 +5,6 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
-	"net/http"
 	"os"
 	"path/filepath"
 	"runtime"
@@ -173,9 +172,7 @@ func SaveLatestVersion(version string) error {
 // Uses GitHub's redirect URL which is simpler than the API.
 // Returns the version tag (e.g., "v1.2.3").
 func CheckLatestVersion() (string, error) {
-	client := &http.Client{
-		Timeout: 10 * time.Second,
-	}
+	client := NewHTTPClient(10 * time.Second)
 
 	resp, err := client.Get("https://github.com/0xjuanma/golazo/releases/latest")
 	if err != nil {
@@ -0,0 +1,288 @@
+// Package eventlog archives detected match events as JSON Lines for offline analysis.
+// Each line is a self-contained JSON object (one event plus match context, or a state
+// record when the status or score changes without a new event), so the archive can be
+// processed with jq or loaded into pandas.
+package eventlog
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/0xjuanma/golazo/internal/api"
+	"github.com/0xjuanma/golazo/internal/data"
+)
+
+const (
+	// FileName is the name of the active event log file.
+	FileName = "events.jsonl"
+	// MaxFileSize is the size at which the active file is rotated.
+	MaxFileSize = 10 * 1024 * 1024
+	// MaxBackups is the number of rotated files kept (events.1.jsonl ... events.N.jsonl).
+	MaxBackups = 5
+	// SchemaVersion is the format version stamped on every line.
+	SchemaVersion = 1
+	// seedTailSize is how much of the end of the log a new sink reads back to learn which
+	// events are already written.
+	seedTailSize = 1024 * 1024
+)
+
+// lineSchema is the line format and its migrations. The archive is never rewritten:
+// older lines are migrated as they are read.
+var lineSchema = data.Schema{Name: "event log", Version: SchemaVersion}
+
+// KindState marks a state record: the match's status and score, logged when they change
+// without a new event (e.g. at full time). Event entries have no kind.
+const KindState = "state"
+
+// Entry is a single JSON line in the event log.
+type Entry struct {
+	Version   int            `json:"version"`
+	Kind      string         `json:"kind,omitempty"`
+	LoggedAt  time.Time      `json:"logged_at"`
+	MatchID   int            `json:"match_id"`
+	League    string         `json:"league"`
+	HomeTeam  string         `json:"home_team"`
+	AwayTeam  string         `json:"away_team"`
+	HomeScore *int           `json:"home_score,omitempty"`
+	AwayScore *int           `json:"away_score,omitempty"`
+	Status    string         `json:"status"`
+	Event     api.MatchEvent `json:"event,omitzero"` // Unset in state records
+}
+
+// Sink appends match events to a size-rotated JSON Lines file.
+// Events are written once; repeated polls of the same match only add new events, and a new
+// sink learns the events already written from the end of the log, so restarts don't append
+// them again. Safe for concurrent use.
+type Sink struct {
+	mu     sync.Mutex
+	path   string
+	seen   map[string]bool
+	states map[int]string // Last logged status and score per match
+}
+
+// NewSink creates a sink writing to events.jsonl in the config directory.
+func NewSink() (*Sink, error) {
+	dir, err := data.ConfigDir()
+	if err != nil {
+		return nil, fmt.Errorf("get config directory: %w", err)
+	}
+	s := &Sink{
+		path:   filepath.Join(dir, FileName),
+		seen:   make(map[string]bool),
+		states: make(map[int]string),
+	}
+	s.seedSeen()
+	return s, nil
+}
+
+// seedSeen marks the events in the last seedTailSize bytes of the log as written and
+// learns the last logged state of their matches, reading the newest rotated file too when
+// the active one is shorter. Unreadable lines are skipped.
+func (s *Sink) seedSeen() {
+	entries, read := readTail(s.path, seedTailSize)
+	if read < seedTailSize {
+		older, _ := readTail(s.backupPath(1), seedTailSize-read)
+		entries = append(older, entries...)
+	}
+	for _, e := range entries {
+		if e.Kind != KindState {
+			s.seen[eventKey(e.MatchID, e.Event)] = true
+		}
+		s.states[e.MatchID] = entryState(e.Status, e.HomeScore, e.AwayScore)
+	}
+}
+
+// readTail reads the entries in the last size bytes of path, skipping the first line when
+// it starts before them. read is the number of bytes read.
+func readTail(path string, size int64) (entries []Entry, read int64) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, 0
+	}
+	defer f.Close()
+	info, err := f.Stat()
+	if err != nil {
+		return nil, 0
+	}
+	offset := max(info.Size()-size, 0)
+	buf := make([]byte, info.Size()-offset)
+	if _, err := f.ReadAt(buf, offset); err != nil && !errors.Is(err, io.EOF) {
+		return nil, 0
+	}
+	if offset > 0 {
+		if i := bytes.IndexByte(buf, '\n'); i >= 0 {
+			buf = buf[i+1:]
+		} else {
+			buf = nil
+		}
+	}
+
+	for line := range bytes.SplitSeq(buf, []byte{'\n'}) {
+		if entry, ok := parseLine(line); ok {
+			entries = append(entries, entry)
+		}
+	}
+	return entries, int64(len(buf))
+}
+
+// parseLine decodes a log line, migrating older formats. Malformed lines and lines from
+// a newer golazo are reported as not ok.
+func parseLine(line []byte) (Entry, bool) {
+	var entry Entry
+	migrated, _, err := lineSchema.Migrate(line)
+	if err != nil || json.Unmarshal(migrated, &entry) != nil {
+		return Entry{}, false
+	}
+	return entry, true
+}
+
+// Path returns the path of the active log file.
+func (s *Sink) Path() string {
+	return s.path
+}
+
+// Record appends the match's events that have not been written yet. When there is no new
+// event but the status or score changed since the last line for the match, a state record
+// is appended instead, so the log knows how the match ended. Matches that haven't started
+// get no state record until they are in the log.
+func (s *Sink) Record(details *api.MatchDetails) error {
+	if details == nil {
+		return nil
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	entry := Entry{
+		Version:   SchemaVersion,
+		LoggedAt:  time.Now(),
+		MatchID:   details.ID,
+		League:    details.League.Name,
+		HomeTeam:  details.HomeTeam.Name,
+		AwayTeam:  details.AwayTeam.Name,
+		HomeScore: details.HomeScore,
+		AwayScore: details.AwayScore,
+		Status:    string(details.Status),
+	}
+	var lines []byte
+	var keys []string
+	for _, event := range details.Events {
+		key := eventKey(details.ID, event)
+		if s.seen[key] {
+			continue
+		}
+		entry.Event = event
+		raw, err := json.Marshal(entry)
+		if err != nil {
+			return fmt.Errorf("marshal event: %w", err)
+		}
+		lines = append(append(lines, raw...), '\n')
+		keys = append(keys, key)
+	}
+
+	state := entryState(entry.Status, entry.HomeScore, entry.AwayScore)
+	if len(lines) == 0 {
+		last, logged := s.states[details.ID]
+		if state == last || (!logged && details.Status == api.MatchStatusNotStarted) {
+			return nil
+		}
+		entry.Kind = KindState
+		entry.Event = api.MatchEvent{}
+		raw, err := json.Marshal(entry)
+		if err != nil {
+			return fmt.Errorf("marshal match state: %w", err)
+		}
+		lines = append(raw, '\n')
+	}
+
+	if err := s.rotateIfNeeded(); err != nil {
+		return err
+	}
+
+	f, err := os.OpenFile(s.path, os.O_APPEND|os.O_CREATE|os.O_RDWR, 0644)
+	if err != nil {
+		return fmt.Errorf("open event log: %w", err)
+	}
+	defer f.Close()
+
+	// A crash mid-append leaves a partial last line: end it so it doesn't swallow the
+	// next entry (readers skip it as malformed)
+	if torn, err := endsMidLine(f); err != nil {
+		return fmt.Errorf("check event log: %w", err)
+	} else if torn {
+		lines = append([]byte{'\n'}, lines...)
+	}
+
+	if _, err := f.Write(lines); err != nil {
+		return fmt.Errorf("write event log: %w", err)
+	}
+
+	// Only mark as seen once written, so failed writes are retried on the next poll
+	for _, key := range keys {
+		s.seen[key] = true
+	}
+	s.states[details.ID] = state
+	return nil
+}
+
+// entryState is the status and score of a log line, compared to tell state changes.
+func entryState(status string, homeScore, awayScore *int) string {
+	return fmt.Sprintf("%s %s-%s", status, intPtrString(homeScore), intPtrString(awayScore))
+}
+
+// endsMidLine reports whether a non-empty file does not end with a newline.
+func endsMidLine(f *os.File) (bool, error) {
+	info, err := f.Stat()
+	if err != nil || info.Size() == 0 {
+		return false, err
+	}
+	last := make([]byte, 1)
+	if _, err := f.ReadAt(last, info.Size()-1); err != nil {
+		return false, err
+	}
+	return last[0] != '\n', nil
+}
+
+// rotateIfNeeded shifts events.jsonl -> events.1.jsonl -> ... when the active file is full.
+// The oldest backup beyond MaxBackups is removed.
+func (s *Sink) rotateIfNeeded() error {
+	info, err := os.Stat(s.path)
+	if err != nil || info.Size() < MaxFileSize {
+		return nil
+	}
+
+	_ = os.Remove(s.backupPath(MaxBackups))
+	for i := MaxBackups - 1; i >= 1; i-- {
+		_ = os.Rename(s.backupPath(i), s.backupPath(i+1))
+	}
+	if err := os.Rename(s.path, s.backupPath(1)); err != nil {
+		return fmt.Errorf("rotate event log: %w", err)
+	}
+	return nil
+}
+
+// backupPath returns the path of the n-th rotated file (events.n.jsonl).
+func (s *Sink) backupPath(n int) string {
+	ext := filepath.Ext(s.path)
+	return fmt.Sprintf("%s.%d%s", s.path[:len(s.path)-len(ext)], n, ext)
+}
+
+// eventKey identifies an event within a match.
+// Falls back to type/minute/player when the provider did not assign an event ID.
+func eventKey(matchID int, event api.MatchEvent) string {
+	if event.ID != 0 {
+		return fmt.Sprintf("%d:%d", matchID, event.ID)
+	}
+	player := ""
+	if event.Player != nil {
+		player = *event.Player
+	}
+	return fmt.Sprintf("%d:%s:%d:%d:%s", matchID, event.Type, event.Minute, event.Team.ID, player)
+}
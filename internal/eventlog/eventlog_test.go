@@ -0,0 +1,108 @@
+package eventlog
+
+import (
+	"testing"
+
+	"github.com/0xjuanma/golazo/internal/api"
+)
+
+// newTestSink creates a sink in a temporary config directory.
+func newTestSink(t *testing.T) *Sink {
+	t.Helper()
+	dir := t.TempDir()
+	t.Setenv("HOME", dir)
+	t.Setenv("XDG_CONFIG_HOME", dir)
+	sink, err := NewSink()
+	if err != nil {
+		t.Fatalf("NewSink: %v", err)
+	}
+	return sink
+}
+
+func TestSinkSkipsEventsWrittenBeforeRestart(t *testing.T) {
+	sink := newTestSink(t)
+	player := "Origi"
+	details := &api.MatchDetails{
+		Match:  api.Match{ID: 7, Status: api.MatchStatusLive},
+		Events: []api.MatchEvent{{Type: "goal", Minute: 7, Player: &player}, {ID: 42, Type: "card", Minute: 30}},
+	}
+	if err := sink.Record(details); err != nil {
+		t.Fatalf("Record: %v", err)
+	}
+
+	// A new sink, as after a restart or toggling the log in settings
+	restarted, err := NewSink()
+	if err != nil {
+		t.Fatalf("NewSink: %v", err)
+	}
+	if err := restarted.Record(details); err != nil {
+		t.Fatalf("Record after restart: %v", err)
+	}
+
+	entries, err := restarted.ReadEntries(Filter{})
+	if err != nil {
+		t.Fatalf("ReadEntries: %v", err)
+	}
+	if len(entries) != 2 {
+		t.Errorf("log has %d entries after a restart; want the 2 events once", len(entries))
+	}
+}
+
+func TestUniqueEvents(t *testing.T) {
+	goal := api.MatchEvent{ID: 1, Type: "goal", Minute: 12}
+	entries := []Entry{
+		{MatchID: 1, Event: goal},
+		{MatchID: 1, Event: api.MatchEvent{ID: 2, Type: "goal", Minute: 40}},
+		{MatchID: 1, Event: goal},
+		{MatchID: 2, Event: goal},
+	}
+	if unique := uniqueEvents(entries); len(unique) != 3 {
+		t.Errorf("uniqueEvents kept %d entries; want 3", len(unique))
+	}
+}
+
+func TestSinkRecordsFullTimeWithoutNewEvents(t *testing.T) {
+	sink := newTestSink(t)
+	one, zero := 1, 0
+	details := &api.MatchDetails{
+		Match:  api.Match{ID: 7, Status: api.MatchStatusLive, HomeScore: &one, AwayScore: &zero},
+		Events: []api.MatchEvent{{ID: 1, Type: "goal", Minute: 12}},
+	}
+	if err := sink.Record(details); err != nil {
+		t.Fatalf("Record: %v", err)
+	}
+	details.Status = api.MatchStatusFinished
+	for range 2 {
+		if err := sink.Record(details); err != nil {
+			t.Fatalf("Record at full time: %v", err)
+		}
+	}
+
+	entries, err := sink.ReadEntries(Filter{})
+	if err != nil {
+		t.Fatalf("ReadEntries: %v", err)
+	}
+	if len(entries) != 2 || entries[1].Kind != KindState {
+		t.Fatalf("log = %+v; want the goal and one state record", entries)
+	}
+
+	db, err := OpenDB(entries)
+	if err != nil {
+		t.Fatalf("OpenDB: %v", err)
+	}
+	defer db.Close()
+	_, rows, err := Query(db, "SELECT match_id, winner FROM results")
+	if err != nil {
+		t.Fatalf("Query: %v", err)
+	}
+	if len(rows) != 1 {
+		t.Errorf("results = %v; want the finished match", rows)
+	}
+	_, rows, err = Query(db, "SELECT COUNT(*) FROM events")
+	if err != nil {
+		t.Fatalf("Query: %v", err)
+	}
+	if rows[0][0] != "1" {
+		t.Errorf("events has %s rows; want the goal only", rows[0][0])
+	}
+}
@@ -0,0 +1,372 @@
+package eventlog
+
+import (
+	"bufio"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/0xjuanma/golazo/internal/data"
+	"github.com/parquet-go/parquet-go"
+)
+
+// timeLayout is the timestamp format used in exported tables.
+const timeLayout = time.RFC3339
+
+// Filter selects entries for export. Zero values match everything.
+type Filter struct {
+	League string    // Case-insensitive substring of the league name
+	From   time.Time // Inclusive lower bound on LoggedAt
+	To     time.Time // Exclusive upper bound on LoggedAt
+}
+
+// Match reports whether the entry passes the filter.
+func (f Filter) Match(e Entry) bool {
+	if f.League != "" && !strings.Contains(strings.ToLower(e.League), strings.ToLower(f.League)) {
+		return false
+	}
+	if !f.From.IsZero() && e.LoggedAt.Before(f.From) {
+		return false
+	}
+	if !f.To.IsZero() && !e.LoggedAt.Before(f.To) {
+		return false
+	}
+	return true
+}
+
+// ReadEntries reads the event log, including rotated files (oldest first), applying filter.
+// Lines in older formats are migrated and malformed lines are skipped. Lines written by a
+// newer golazo stop the read with an error wrapping data.ErrIncompatibleSchema.
+func (s *Sink) ReadEntries(filter Filter) ([]Entry, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	paths := make([]string, 0, MaxBackups+1)
+	for i := MaxBackups; i >= 1; i-- {
+		paths = append(paths, s.backupPath(i))
+	}
+	paths = append(paths, s.path)
+
+	var entries []Entry
+	for _, path := range paths {
+		f, err := os.Open(path)
+		if err != nil {
+			if os.IsNotExist(err) {
+				continue
+			}
+			return nil, fmt.Errorf("open event log: %w", err)
+		}
+
+		scanner := bufio.NewScanner(f)
+		scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+		for scanner.Scan() {
+			line, _, err := lineSchema.Migrate(scanner.Bytes())
+			if errors.Is(err, data.ErrIncompatibleSchema) && data.SchemaVersion(scanner.Bytes()) > SchemaVersion {
+				f.Close()
+				return nil, fmt.Errorf("%s: %w", filepath.Base(path), err)
+			}
+			var entry Entry
+			if err != nil || json.Unmarshal(line, &entry) != nil {
+				continue
+			}
+			if filter.Match(entry) {
+				entries = append(entries, entry)
+			}
+		}
+		err = scanner.Err()
+		f.Close()
+		if err != nil {
+			return nil, fmt.Errorf("read event log: %w", err)
+		}
+	}
+
+	return entries, nil
+}
+
+// Export formats for ExportTables.
+const (
+	FormatCSV     = "csv"
+	FormatParquet = "parquet"
+)
+
+// matchRow is a row of the matches table: the latest known state of a match.
+type matchRow struct {
+	MatchID      int64     `parquet:"match_id"`
+	League       string    `parquet:"league"`
+	HomeTeam     string    `parquet:"home_team"`
+	AwayTeam     string    `parquet:"away_team"`
+	HomeScore    *int64    `parquet:"home_score,optional"`
+	AwayScore    *int64    `parquet:"away_score,optional"`
+	Status       string    `parquet:"status"`
+	LastLoggedAt time.Time `parquet:"last_logged_at,timestamp"`
+}
+
+// eventRow is a row of the events table.
+type eventRow struct {
+	MatchID       int64     `parquet:"match_id"`
+	EventID       int64     `parquet:"event_id"`
+	Minute        int64     `parquet:"minute"`
+	DisplayMinute string    `parquet:"display_minute"`
+	Type          string    `parquet:"type"`
+	EventType     *string   `parquet:"event_type,optional"`
+	Team          string    `parquet:"team"`
+	Player        *string   `parquet:"player,optional"`
+	Assist        *string   `parquet:"assist,optional"`
+	OwnGoal       *bool     `parquet:"own_goal,optional"`
+	LoggedAt      time.Time `parquet:"logged_at,timestamp"`
+}
+
+// statRow is a row of the stats table: a team's event counts in a match.
+type statRow struct {
+	MatchID       int64  `parquet:"match_id"`
+	Team          string `parquet:"team"`
+	Goals         int64  `parquet:"goals"`
+	OwnGoals      int64  `parquet:"own_goals"`
+	YellowCards   int64  `parquet:"yellow_cards"`
+	RedCards      int64  `parquet:"red_cards"`
+	Substitutions int64  `parquet:"substitutions"`
+}
+
+var (
+	matchColumns = []string{"match_id", "league", "home_team", "away_team", "home_score", "away_score", "status", "last_logged_at"}
+	eventColumns = []string{"match_id", "event_id", "minute", "display_minute", "type", "event_type", "team", "player", "assist", "own_goal", "logged_at"}
+	statColumns  = []string{"match_id", "team", "goals", "own_goals", "yellow_cards", "red_cards", "substitutions"}
+)
+
+func (r matchRow) record() []string {
+	return []string{
+		strconv.FormatInt(r.MatchID, 10), r.League, r.HomeTeam, r.AwayTeam,
+		int64PtrString(r.HomeScore), int64PtrString(r.AwayScore), r.Status, r.LastLoggedAt.Format(timeLayout),
+	}
+}
+
+func (r eventRow) record() []string {
+	ownGoal := ""
+	if r.OwnGoal != nil {
+		ownGoal = strconv.FormatBool(*r.OwnGoal)
+	}
+	return []string{
+		strconv.FormatInt(r.MatchID, 10), strconv.FormatInt(r.EventID, 10), strconv.FormatInt(r.Minute, 10), r.DisplayMinute,
+		r.Type, stringPtr(r.EventType), r.Team, stringPtr(r.Player), stringPtr(r.Assist), ownGoal, r.LoggedAt.Format(timeLayout),
+	}
+}
+
+func (r statRow) record() []string {
+	return []string{
+		strconv.FormatInt(r.MatchID, 10), r.Team, strconv.FormatInt(r.Goals, 10), strconv.FormatInt(r.OwnGoals, 10),
+		strconv.FormatInt(r.YellowCards, 10), strconv.FormatInt(r.RedCards, 10), strconv.FormatInt(r.Substitutions, 10),
+	}
+}
+
+// ExportTables writes the matches (latest known state per match), events (one row per
+// event, repeats dropped) and stats (event counts per team and match) tables to dir, as
+// matches.csv, events.csv and stats.csv or the .parquet equivalents. Returns the paths written.
+func ExportTables(entries []Entry, dir, format string) ([]string, error) {
+	if format != FormatCSV && format != FormatParquet {
+		return nil, fmt.Errorf("unknown format %q (expected csv or parquet)", format)
+	}
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, fmt.Errorf("create export directory: %w", err)
+	}
+
+	events := uniqueEvents(entries)
+	tables := []struct {
+		name         string
+		writeCSV     func(path string) error
+		writeParquet func(path string) error
+	}{
+		{
+			"matches",
+			func(path string) error { return writeCSV(path, matchColumns, matchRows(entries)) },
+			func(path string) error { return writeParquet(path, matchRows(entries)) },
+		},
+		{
+			"events",
+			func(path string) error { return writeCSV(path, eventColumns, eventRows(events)) },
+			func(path string) error { return writeParquet(path, eventRows(events)) },
+		},
+		{
+			"stats",
+			func(path string) error { return writeCSV(path, statColumns, statRows(events)) },
+			func(path string) error { return writeParquet(path, statRows(events)) },
+		},
+	}
+
+	var written []string
+	for _, table := range tables {
+		path := filepath.Join(dir, table.name+"."+format)
+		write := table.writeCSV
+		if format == FormatParquet {
+			write = table.writeParquet
+		}
+		if err := write(path); err != nil {
+			return written, err
+		}
+		written = append(written, path)
+	}
+	return written, nil
+}
+
+// matchRows returns the matches table rows.
+func matchRows(entries []Entry) []matchRow {
+	latest := latestByMatch(entries)
+	rows := make([]matchRow, 0, len(latest))
+	for _, e := range latest {
+		rows = append(rows, matchRow{
+			MatchID: int64(e.MatchID), League: e.League, HomeTeam: e.HomeTeam, AwayTeam: e.AwayTeam,
+			HomeScore: int64Ptr(e.HomeScore), AwayScore: int64Ptr(e.AwayScore), Status: e.Status,
+			LastLoggedAt: e.LoggedAt,
+		})
+	}
+	return rows
+}
+
+// eventRows returns the events table rows for event entries.
+func eventRows(events []Entry) []eventRow {
+	rows := make([]eventRow, 0, len(events))
+	for _, e := range events {
+		ev := e.Event
+		rows = append(rows, eventRow{
+			MatchID: int64(e.MatchID), EventID: int64(ev.ID), Minute: int64(ev.Minute), DisplayMinute: ev.DisplayMinute,
+			Type: ev.Type, EventType: ev.EventType, Team: ev.Team.Name, Player: ev.Player, Assist: ev.Assist,
+			OwnGoal: ev.OwnGoal, LoggedAt: e.LoggedAt,
+		})
+	}
+	return rows
+}
+
+// statRows counts goals, cards and substitutions per team and match (in first-seen order).
+// Own goals count for the team of the player who scored them.
+func statRows(events []Entry) []statRow {
+	type key struct {
+		matchID int
+		team    string
+	}
+	index := make(map[key]int)
+	var rows []statRow
+	for _, e := range events {
+		ev := e.Event
+		k := key{e.MatchID, ev.Team.Name}
+		i, ok := index[k]
+		if !ok {
+			i = len(rows)
+			index[k] = i
+			rows = append(rows, statRow{MatchID: int64(e.MatchID), Team: ev.Team.Name})
+		}
+		row := &rows[i]
+		switch ev.Type {
+		case "goal":
+			if ev.OwnGoal != nil && *ev.OwnGoal {
+				row.OwnGoals++
+			} else {
+				row.Goals++
+			}
+		case "card":
+			switch strings.ToLower(stringPtr(ev.EventType)) {
+			case "red", "redcard", "secondyellow":
+				row.RedCards++
+			default:
+				row.YellowCards++
+			}
+		case "substitution":
+			row.Substitutions++
+		}
+	}
+	return rows
+}
+
+// latestByMatch returns the most recent entry for each match (in first-seen order).
+// The latest entry, an event or a state record, carries the most recent score and status.
+func latestByMatch(entries []Entry) []Entry {
+	index := make(map[int]int)
+	var latest []Entry
+	for _, e := range entries {
+		if i, ok := index[e.MatchID]; ok {
+			latest[i] = e
+			continue
+		}
+		index[e.MatchID] = len(latest)
+		latest = append(latest, e)
+	}
+	return latest
+}
+
+// uniqueEvents returns the event entries without repeats of an event already seen in the
+// same match, e.g. written again by a golazo that lost track of what it had logged. The
+// first entry of each event is kept; state records are left out.
+func uniqueEvents(entries []Entry) []Entry {
+	seen := make(map[string]bool, len(entries))
+	unique := make([]Entry, 0, len(entries))
+	for _, e := range entries {
+		key := eventKey(e.MatchID, e.Event)
+		if e.Kind == KindState || seen[key] {
+			continue
+		}
+		seen[key] = true
+		unique = append(unique, e)
+	}
+	return unique
+}
+
+// writeCSV writes a header and rows to path, replacing any existing file.
+func writeCSV[R interface{ record() []string }](path string, header []string, rows []R) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return fmt.Errorf("create %s: %w", filepath.Base(path), err)
+	}
+	defer f.Close()
+
+	w := csv.NewWriter(f)
+	records := make([][]string, 0, len(rows)+1)
+	records = append(records, header)
+	for _, row := range rows {
+		records = append(records, row.record())
+	}
+	if err := w.WriteAll(records); err != nil {
+		return fmt.Errorf("write %s: %w", filepath.Base(path), err)
+	}
+	return nil
+}
+
+// writeParquet writes rows to path as a Parquet file, replacing any existing file.
+func writeParquet[R any](path string, rows []R) error {
+	if err := parquet.WriteFile(path, rows); err != nil {
+		return fmt.Errorf("write %s: %w", filepath.Base(path), err)
+	}
+	return nil
+}
+
+func intPtrString(v *int) string {
+	if v == nil {
+		return ""
+	}
+	return strconv.Itoa(*v)
+}
+
+func int64PtrString(v *int64) string {
+	if v == nil {
+		return ""
+	}
+	return strconv.FormatInt(*v, 10)
+}
+
+func int64Ptr(v *int) *int64 {
+	if v == nil {
+		return nil
+	}
+	n := int64(*v)
+	return &n
+}
+
+func stringPtr(v *string) string {
+	if v == nil {
+		return ""
+	}
+	return *v
+}
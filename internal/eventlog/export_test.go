@@ -0,0 +1,87 @@
+package eventlog
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/0xjuanma/golazo/internal/api"
+	"github.com/parquet-go/parquet-go"
+)
+
+// exportEntries is a finished match with a goal, an own goal, a red card and a repeated event.
+func exportEntries() []Entry {
+	home, away := api.Team{ID: 1, Name: "Arsenal"}, api.Team{ID: 2, Name: "Chelsea"}
+	two, zero := 2, 0
+	red, ownGoal := "red", true
+	logged := time.Date(2025, 3, 1, 16, 0, 0, 0, time.UTC)
+	entry := func(event api.MatchEvent) Entry {
+		return Entry{MatchID: 7, League: "Premier League", HomeTeam: home.Name, AwayTeam: away.Name,
+			HomeScore: &two, AwayScore: &zero, Status: "live", LoggedAt: logged, Event: event}
+	}
+	goal := api.MatchEvent{ID: 1, Type: "goal", Minute: 12, Team: home}
+	return []Entry{
+		entry(goal),
+		entry(api.MatchEvent{ID: 2, Type: "goal", Minute: 40, Team: away, OwnGoal: &ownGoal}),
+		entry(api.MatchEvent{ID: 3, Type: "card", Minute: 60, Team: away, EventType: &red}),
+		entry(goal),
+		{MatchID: 7, Kind: KindState, League: "Premier League", HomeTeam: home.Name, AwayTeam: away.Name,
+			HomeScore: &two, AwayScore: &zero, Status: "finished", LoggedAt: logged.Add(time.Hour)},
+	}
+}
+
+func TestExportCSV(t *testing.T) {
+	dir := t.TempDir()
+	if _, err := ExportTables(exportEntries(), dir, FormatCSV); err != nil {
+		t.Fatalf("ExportTables: %v", err)
+	}
+
+	want := map[string]string{
+		"matches.csv": "match_id,league,home_team,away_team,home_score,away_score,status,last_logged_at\n" +
+			"7,Premier League,Arsenal,Chelsea,2,0,finished,2025-03-01T17:00:00Z\n",
+		"stats.csv": "match_id,team,goals,own_goals,yellow_cards,red_cards,substitutions\n" +
+			"7,Arsenal,1,0,0,0,0\n7,Chelsea,0,1,0,1,0\n",
+	}
+	for name, content := range want {
+		got, err := os.ReadFile(filepath.Join(dir, name))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(got) != content {
+			t.Errorf("%s =\n%s\nwant\n%s", name, got, content)
+		}
+	}
+
+	events, err := os.ReadFile(filepath.Join(dir, "events.csv"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if lines := strings.Count(string(events), "\n"); lines != 4 {
+		t.Errorf("events.csv has %d lines; want a header and 3 events", lines)
+	}
+}
+
+func TestExportParquet(t *testing.T) {
+	dir := t.TempDir()
+	if _, err := ExportTables(exportEntries(), dir, FormatParquet); err != nil {
+		t.Fatalf("ExportTables: %v", err)
+	}
+
+	events, err := parquet.ReadFile[eventRow](filepath.Join(dir, "events.parquet"))
+	if err != nil {
+		t.Fatalf("read events.parquet: %v", err)
+	}
+	if len(events) != 3 || events[2].EventType == nil || *events[2].EventType != "red" {
+		t.Errorf("events.parquet = %+v; want 3 events, the last a red card", events)
+	}
+
+	stats, err := parquet.ReadFile[statRow](filepath.Join(dir, "stats.parquet"))
+	if err != nil {
+		t.Fatalf("read stats.parquet: %v", err)
+	}
+	if len(stats) != 2 || stats[0].Goals != 1 || stats[1].RedCards != 1 {
+		t.Errorf("stats.parquet = %+v; want Arsenal's goal and Chelsea's red card", stats)
+	}
+}
@@ -0,0 +1,168 @@
+package eventlog
+
+import (
+	"database/sql"
+	"fmt"
+
+	_ "modernc.org/sqlite" // Pure-Go SQLite driver (no cgo)
+)
+
+// schema defines the tables and convenience views available to golazo query.
+const schema = `
+CREATE TABLE matches (
+	match_id       INTEGER PRIMARY KEY,
+	league         TEXT,
+	home_team      TEXT,
+	away_team      TEXT,
+	home_score     INTEGER,
+	away_score     INTEGER,
+	status         TEXT,
+	last_logged_at TEXT
+);
+
+CREATE TABLE events (
+	match_id       INTEGER,
+	event_id       INTEGER,
+	minute         INTEGER,
+	display_minute TEXT,
+	type           TEXT,
+	event_type     TEXT,
+	team           TEXT,
+	player         TEXT,
+	assist         TEXT,
+	own_goal       INTEGER,
+	logged_at      TEXT
+);
+
+-- Finished matches with the winner resolved
+CREATE VIEW results AS
+SELECT match_id, league, home_team, home_score, away_score, away_team,
+	CASE
+		WHEN home_score > away_score THEN home_team
+		WHEN away_score > home_score THEN away_team
+		ELSE 'draw'
+	END AS winner
+FROM matches
+WHERE status = 'finished';
+
+-- Goal scorers (own goals excluded), most goals first
+CREATE VIEW scorers AS
+SELECT player, team, COUNT(*) AS goals
+FROM events
+WHERE type = 'goal' AND player IS NOT NULL AND COALESCE(own_goal, 0) = 0
+GROUP BY player, team
+ORDER BY goals DESC, player;
+
+-- Per-team record across finished matches
+CREATE VIEW team_form AS
+SELECT team,
+	COUNT(*) AS played,
+	SUM(gf > ga) AS won,
+	SUM(gf = ga) AS drawn,
+	SUM(gf < ga) AS lost,
+	SUM(gf) AS goals_for,
+	SUM(ga) AS goals_against,
+	SUM(CASE WHEN gf > ga THEN 3 WHEN gf = ga THEN 1 ELSE 0 END) AS points
+FROM (
+	SELECT home_team AS team, home_score AS gf, away_score AS ga FROM results
+	UNION ALL
+	SELECT away_team AS team, away_score AS gf, home_score AS ga FROM results
+)
+GROUP BY team
+ORDER BY points DESC, goals_for - goals_against DESC;
+`
+
+// OpenDB loads entries into an in-memory SQLite database with the matches and events
+// tables and the results, scorers and team_form views. The database is read-only once loaded.
+// The JSON Lines log stays the only store; the database lives for a single query and is never
+// written to disk.
+func OpenDB(entries []Entry) (*sql.DB, error) {
+	db, err := sql.Open("sqlite", ":memory:")
+	if err != nil {
+		return nil, fmt.Errorf("open database: %w", err)
+	}
+	// Each connection to :memory: is a separate database - keep exactly one
+	db.SetMaxOpenConns(1)
+
+	if err := loadDB(db, entries); err != nil {
+		db.Close()
+		return nil, err
+	}
+	return db, nil
+}
+
+// loadDB creates the schema and inserts entries, then switches the database to query-only.
+func loadDB(db *sql.DB, entries []Entry) error {
+	if _, err := db.Exec(schema); err != nil {
+		return fmt.Errorf("create schema: %w", err)
+	}
+
+	tx, err := db.Begin()
+	if err != nil {
+		return fmt.Errorf("begin load: %w", err)
+	}
+	defer tx.Rollback()
+
+	for _, e := range latestByMatch(entries) {
+		if _, err := tx.Exec(`INSERT INTO matches VALUES (?, ?, ?, ?, ?, ?, ?, ?)`,
+			e.MatchID, e.League, e.HomeTeam, e.AwayTeam, e.HomeScore, e.AwayScore, e.Status,
+			e.LoggedAt.Format(timeLayout)); err != nil {
+			return fmt.Errorf("insert match: %w", err)
+		}
+	}
+
+	for _, e := range uniqueEvents(entries) {
+		ev := e.Event
+		if _, err := tx.Exec(`INSERT INTO events VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+			e.MatchID, ev.ID, ev.Minute, ev.DisplayMinute, ev.Type, ev.EventType, ev.Team.Name,
+			ev.Player, ev.Assist, ev.OwnGoal, e.LoggedAt.Format(timeLayout)); err != nil {
+			return fmt.Errorf("insert event: %w", err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("commit load: %w", err)
+	}
+
+	if _, err := db.Exec(`PRAGMA query_only = ON`); err != nil {
+		return fmt.Errorf("set query-only: %w", err)
+	}
+	return nil
+}
+
+// Query runs a read-only SQL statement and returns the column names and rows as strings.
+// NULL values are returned as empty strings.
+func Query(db *sql.DB, query string) ([]string, [][]string, error) {
+	rows, err := db.Query(query)
+	if err != nil {
+		return nil, nil, fmt.Errorf("run query: %w", err)
+	}
+	defer rows.Close()
+
+	columns, err := rows.Columns()
+	if err != nil {
+		return nil, nil, fmt.Errorf("read columns: %w", err)
+	}
+
+	var result [][]string
+	values := make([]sql.NullString, len(columns))
+	scanArgs := make([]any, len(columns))
+	for i := range values {
+		scanArgs[i] = &values[i]
+	}
+	for rows.Next() {
+		if err := rows.Scan(scanArgs...); err != nil {
+			return nil, nil, fmt.Errorf("scan row: %w", err)
+		}
+		row := make([]string, len(columns))
+		for i, v := range values {
+			row[i] = v.String
+		}
+		result = append(result, row)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, nil, fmt.Errorf("read rows: %w", err)
+	}
+
+	return columns, result, nil
+}
@@ -1,62 +1,211 @@
 package fotmob
 
 import (
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"hash/fnv"
+	"io"
+	"slices"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/0xjuanma/golazo/internal/api"
+	"github.com/0xjuanma/golazo/internal/data"
 )
 
 // CacheConfig holds configuration for API response caching.
 type CacheConfig struct {
-	MatchesTTL      time.Duration // How long to cache match list results
-	MatchDetailsTTL time.Duration // How long to cache match details
-	LiveMatchesTTL  time.Duration // How long to cache live matches list
-	MaxMatchesCache int           // Maximum number of date entries to cache
-	MaxDetailsCache int           // Maximum number of match details to cache
+	TTL             TTLPolicy // How long each type of response is cached
+	MaxMatchesCache int       // Maximum number of date entries to cache
+	MaxDetailsCache int       // Maximum number of match details to cache
 }
 
 // DefaultCacheConfig returns sensible defaults for caching.
 func DefaultCacheConfig() CacheConfig {
 	return CacheConfig{
-		MatchesTTL:      15 * time.Minute, // Matches list cache (stats view uses client-side filtering)
-		MatchDetailsTTL: 5 * time.Minute,  // Details for live matches need fresher data
-		LiveMatchesTTL:  2 * time.Minute,  // Live matches list cache (quick nav doesn't re-fetch)
-		MaxMatchesCache: 10,               // Cache up to 10 date queries
-		MaxDetailsCache: 100,              // Cache up to 100 match details
+		TTL:             DefaultTTLPolicy(),
+		MaxMatchesCache: 10,  // Cache up to 10 date queries
+		MaxDetailsCache: 100, // Cache up to 100 match details
 	}
 }
 
+// TTLPolicy is how long each type of FotMob response is cached, from seconds for live
+// match details to days for finished ones.
+type TTLPolicy struct {
+	LiveDetails     time.Duration // Details of live matches
+	UpcomingDetails time.Duration // Details of matches yet to start (lineups appear about an hour before kickoff)
+	FinishedDetails time.Duration // Details of finished matches, which no longer change
+	Matches         time.Duration // Match lists per date
+	LiveMatches     time.Duration // Live matches list
+	Standings       time.Duration // League and group tables
+}
+
+// DefaultTTLPolicy returns the default cache TTLs.
+func DefaultTTLPolicy() TTLPolicy {
+	return TTLPolicy{
+		LiveDetails:     15 * time.Second,
+		UpcomingDetails: 10 * time.Minute,
+		FinishedDetails: 7 * 24 * time.Hour,
+		Matches:         15 * time.Minute, // Stats view uses client-side filtering
+		LiveMatches:     2 * time.Minute,  // Quick nav doesn't re-fetch
+		Standings:       time.Hour,
+	}
+}
+
+// TTLPolicyFromSettings returns DefaultTTLPolicy with the cache_ttl setting's overrides.
+func TTLPolicyFromSettings(settings *data.Settings) TTLPolicy {
+	policy := DefaultTTLPolicy()
+	overrides := map[string]*time.Duration{
+		data.CacheTTLLiveDetails:     &policy.LiveDetails,
+		data.CacheTTLUpcomingDetails: &policy.UpcomingDetails,
+		data.CacheTTLFinishedDetails: &policy.FinishedDetails,
+		data.CacheTTLMatches:         &policy.Matches,
+		data.CacheTTLLiveMatches:     &policy.LiveMatches,
+		data.CacheTTLStandings:       &policy.Standings,
+	}
+	for key, ttl := range settings.CacheTTLDurations() {
+		if field, ok := overrides[key]; ok {
+			*field = ttl
+		}
+	}
+	return policy
+}
+
+// configuredCacheConfig returns DefaultCacheConfig with the TTLs of the cache_ttl setting.
+func configuredCacheConfig() CacheConfig {
+	config := DefaultCacheConfig()
+	if settings, err := data.LoadSettings(); err == nil {
+		config.TTL = TTLPolicyFromSettings(settings)
+	}
+	return config
+}
+
+// Details returns the TTL of a match's details, by match status.
+func (p TTLPolicy) Details(details *api.MatchDetails) time.Duration {
+	if details == nil {
+		return p.UpcomingDetails
+	}
+	switch details.Status {
+	case api.MatchStatusLive:
+		return p.LiveDetails
+	case api.MatchStatusFinished:
+		return p.FinishedDetails
+	}
+	return p.UpcomingDetails
+}
+
 // cachedMatches holds cached match data with expiration.
 type cachedMatches struct {
 	matches   []api.Match
 	expiresAt time.Time
 }
 
-// cachedDetails holds cached match details with expiration.
+// cachedTables holds a league's cached tables with expiration.
+type cachedTables struct {
+	tables    []api.GroupTable
+	expiresAt time.Time
+}
+
+// cachedDetails holds cached match details with expiration. Details are stored as
+// gzip-compressed JSON (about a tenth of their decoded size), and a poll that returns
+// unchanged details is detected by hash and not re-compressed, so tracking many live
+// matches costs little memory.
 type cachedDetails struct {
-	details   *api.MatchDetails
+	gzipped   []byte
+	hash      uint64 // Of the uncompressed JSON
 	expiresAt time.Time
 }
 
+// decode returns the cached details, or nil if they can't be decoded.
+func (d cachedDetails) decode() *api.MatchDetails {
+	if d.gzipped == nil {
+		return nil
+	}
+	raw, err := gunzip(d.gzipped)
+	if err != nil {
+		return nil
+	}
+	var details api.MatchDetails
+	if err := json.Unmarshal(raw, &details); err != nil {
+		return nil
+	}
+	return &details
+}
+
 // ResponseCache provides thread-safe caching for API responses.
 type ResponseCache struct {
 	config       CacheConfig
+	ttl          atomic.Pointer[TTLPolicy] // Replaced by SetTTLPolicy
 	matchesMu    sync.RWMutex
 	matchesCache map[string]cachedMatches // key: "YYYY-MM-DD"
 	detailsMu    sync.RWMutex
 	detailsCache map[int]cachedDetails // key: matchID
 	liveMu       sync.RWMutex
 	liveCache    *cachedMatches // Single cache entry for live matches
+	tablesMu     sync.RWMutex
+	tablesCache  map[int]cachedTables // key: leagueID; bounded by the number of leagues
+	validatorsMu sync.Mutex
+	validators   map[string]validators // key: request URL
+	validatorKey []string              // Insertion order for eviction
+}
+
+// maxValidators bounds the URLs whose ETag/Last-Modified are kept for conditional requests.
+const maxValidators = 200
+
+// validators are the ETag and Last-Modified headers of a URL's last full response,
+// sent back as If-None-Match/If-Modified-Since so unchanged responses come back as 304.
+type validators struct {
+	etag         string
+	lastModified string
 }
 
 // NewResponseCache creates a new cache with the given configuration.
 func NewResponseCache(config CacheConfig) *ResponseCache {
-	return &ResponseCache{
+	c := &ResponseCache{
 		config:       config,
 		matchesCache: make(map[string]cachedMatches),
 		detailsCache: make(map[int]cachedDetails),
 		liveCache:    nil,
+		tablesCache:  make(map[int]cachedTables),
+		validators:   make(map[string]validators),
+	}
+	c.SetTTLPolicy(config.TTL)
+	return c
+}
+
+// SetTTLPolicy replaces the cache TTLs, e.g. after the cache_ttl setting changed.
+// Entries already cached keep their expiry.
+func (c *ResponseCache) SetTTLPolicy(policy TTLPolicy) {
+	c.ttl.Store(&policy)
+}
+
+// TTLPolicy returns the cache TTLs.
+func (c *ResponseCache) TTLPolicy() TTLPolicy {
+	return *c.ttl.Load()
+}
+
+// Tables retrieves a league's cached tables, returns nil if not cached or expired.
+func (c *ResponseCache) Tables(leagueID int) []api.GroupTable {
+	c.tablesMu.RLock()
+	defer c.tablesMu.RUnlock()
+
+	cached, ok := c.tablesCache[leagueID]
+	if !ok || time.Now().After(cached.expiresAt) {
+		return nil
+	}
+	return cached.tables
+}
+
+// SetTables stores a league's tables in cache with the standings TTL.
+func (c *ResponseCache) SetTables(leagueID int, tables []api.GroupTable) {
+	c.tablesMu.Lock()
+	defer c.tablesMu.Unlock()
+
+	c.tablesCache[leagueID] = cachedTables{
+		tables:    tables,
+		expiresAt: time.Now().Add(c.TTLPolicy().Standings),
 	}
 }
 
@@ -84,43 +233,70 @@ func (c *ResponseCache) SetMatches(dateKey string, matches []api.Match) {
 
 	c.matchesCache[dateKey] = cachedMatches{
 		matches:   matches,
-		expiresAt: time.Now().Add(c.config.MatchesTTL),
+		expiresAt: time.Now().Add(c.TTLPolicy().Matches),
 	}
 }
 
 // Details retrieves cached match details, returns nil if not cached or expired.
+// Each call decodes its own copy of the details.
 func (c *ResponseCache) Details(matchID int) *api.MatchDetails {
 	c.detailsMu.RLock()
-	defer c.detailsMu.RUnlock()
-
 	cached, ok := c.detailsCache[matchID]
+	c.detailsMu.RUnlock()
+
 	if !ok || time.Now().After(cached.expiresAt) {
 		return nil
 	}
-	return cached.details
+	return cached.decode()
+}
+
+// HasDetails reports whether fresh details are cached for a match, without decoding them.
+func (c *ResponseCache) HasDetails(matchID int) bool {
+	c.detailsMu.RLock()
+	cached, ok := c.detailsCache[matchID]
+	c.detailsMu.RUnlock()
+	return ok && !time.Now().After(cached.expiresAt)
+}
+
+// LastDetails returns the last details stored for a match, even when expired, or nil.
+// Reused when FotMob reports the match details as not modified.
+func (c *ResponseCache) LastDetails(matchID int) *api.MatchDetails {
+	c.detailsMu.RLock()
+	cached := c.detailsCache[matchID]
+	c.detailsMu.RUnlock()
+	return cached.decode()
 }
 
-// SetDetails stores match details in cache with TTL.
-// For finished matches, uses a longer TTL since the data won't change.
+// SetDetails stores match details in cache with the TTL for their status: seconds while
+// live, days once finished. Details that can't be encoded are not cached.
 func (c *ResponseCache) SetDetails(matchID int, details *api.MatchDetails) {
+	raw, err := json.Marshal(details)
+	if err != nil {
+		return
+	}
+	hash := hashBody(raw)
+	expiresAt := time.Now().Add(c.TTLPolicy().Details(details))
+
+	c.detailsMu.RLock()
+	cached, ok := c.detailsCache[matchID]
+	c.detailsMu.RUnlock()
+
+	gzipped := cached.gzipped
+	if !ok || cached.hash != hash {
+		if gzipped, err = gzipBody(raw); err != nil {
+			return
+		}
+	}
+
 	c.detailsMu.Lock()
 	defer c.detailsMu.Unlock()
 
 	// Evict oldest entries if cache is full
-	if len(c.detailsCache) >= c.config.MaxDetailsCache {
+	if _, ok := c.detailsCache[matchID]; !ok && len(c.detailsCache) >= c.config.MaxDetailsCache {
 		c.evictOldestDetails()
 	}
 
-	ttl := c.config.MatchDetailsTTL
-	// Use longer TTL for finished matches since they won't change
-	if details != nil && details.Status == api.MatchStatusFinished {
-		ttl = 30 * time.Minute
-	}
-
-	c.detailsCache[matchID] = cachedDetails{
-		details:   details,
-		expiresAt: time.Now().Add(ttl),
-	}
+	c.detailsCache[matchID] = cachedDetails{gzipped: gzipped, hash: hash, expiresAt: expiresAt}
 }
 
 // GetCachedMatchIDs returns all match IDs currently in the details cache.
@@ -135,6 +311,18 @@ func (c *ResponseCache) CachedMatchIDs() []int {
 	return ids
 }
 
+// Sizes returns the number of cached match days and match details (including expired entries).
+func (c *ResponseCache) Sizes() (matchDays, details int) {
+	c.matchesMu.RLock()
+	matchDays = len(c.matchesCache)
+	c.matchesMu.RUnlock()
+
+	c.detailsMu.RLock()
+	details = len(c.detailsCache)
+	c.detailsMu.RUnlock()
+	return matchDays, details
+}
+
 // ClearDetails clears all cached match details.
 func (c *ResponseCache) ClearDetails() {
 	c.detailsMu.Lock()
@@ -150,6 +338,38 @@ func (c *ResponseCache) ClearMatchDetails(matchID int) {
 	delete(c.detailsCache, matchID)
 }
 
+// Validators returns the ETag and Last-Modified of url's last full response (empty if unknown).
+func (c *ResponseCache) Validators(url string) (etag, lastModified string) {
+	c.validatorsMu.Lock()
+	defer c.validatorsMu.Unlock()
+	v := c.validators[url]
+	return v.etag, v.lastModified
+}
+
+// SetValidators records the ETag and Last-Modified of a full response for url.
+// Responses without either are forgotten, so the next request is unconditional.
+func (c *ResponseCache) SetValidators(url, etag, lastModified string) {
+	c.validatorsMu.Lock()
+	defer c.validatorsMu.Unlock()
+
+	if etag == "" && lastModified == "" {
+		delete(c.validators, url)
+		return
+	}
+	if _, ok := c.validators[url]; !ok {
+		c.validatorKey = slices.DeleteFunc(c.validatorKey, func(key string) bool {
+			_, ok := c.validators[key]
+			return !ok
+		})
+		if len(c.validatorKey) >= maxValidators {
+			delete(c.validators, c.validatorKey[0])
+			c.validatorKey = c.validatorKey[1:]
+		}
+		c.validatorKey = append(c.validatorKey, url)
+	}
+	c.validators[url] = validators{etag: etag, lastModified: lastModified}
+}
+
 // GetLiveMatches retrieves cached live matches, returns nil if not cached or expired.
 func (c *ResponseCache) LiveMatches() []api.Match {
 	c.liveMu.RLock()
@@ -168,7 +388,7 @@ func (c *ResponseCache) SetLiveMatches(matches []api.Match) {
 
 	c.liveCache = &cachedMatches{
 		matches:   matches,
-		expiresAt: time.Now().Add(c.config.LiveMatchesTTL),
+		expiresAt: time.Now().Add(c.TTLPolicy().LiveMatches),
 	}
 }
 
@@ -233,3 +453,100 @@ func (c *ResponseCache) evictOldestDetails() {
 		delete(c.detailsCache, oldestKey)
 	}
 }
+
+// maxStaleBodies bounds the last-known-good responses kept for circuit breaker fallback.
+const maxStaleBodies = 50
+
+// staleBodies keeps the last successful response body per URL, served while the
+// FotMob circuit breaker is open. Entries never expire; the oldest are evicted first.
+// Bodies are stored gzip-compressed (JSON compresses ~10x), and a poll that returns an
+// unchanged body is detected by hash and not re-compressed.
+type staleBodies struct {
+	mu     sync.Mutex
+	bodies map[string]staleBody
+	order  []string // Insertion order for eviction
+}
+
+// staleBody is a compressed response body and the hash of its uncompressed content.
+type staleBody struct {
+	gzipped []byte
+	hash    uint64
+}
+
+// get returns the last successful body for url, or nil.
+func (s *staleBodies) get(url string) []byte {
+	s.mu.Lock()
+	entry, ok := s.bodies[url]
+	s.mu.Unlock()
+	if !ok {
+		return nil
+	}
+
+	body, err := gunzip(entry.gzipped)
+	if err != nil {
+		return nil
+	}
+	return body
+}
+
+// set records a successful body for url.
+func (s *staleBodies) set(url string, body []byte) {
+	hash := hashBody(body)
+
+	s.mu.Lock()
+	if entry, ok := s.bodies[url]; ok && entry.hash == hash {
+		s.mu.Unlock()
+		return // Unchanged since the last poll
+	}
+	s.mu.Unlock()
+
+	gzipped, err := gzipBody(body)
+	if err != nil {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.bodies == nil {
+		s.bodies = make(map[string]staleBody)
+	}
+	if _, ok := s.bodies[url]; !ok {
+		if len(s.order) >= maxStaleBodies {
+			delete(s.bodies, s.order[0])
+			s.order = s.order[1:]
+		}
+		s.order = append(s.order, url)
+	}
+	s.bodies[url] = staleBody{gzipped: gzipped, hash: hash}
+}
+
+// hashBody returns the FNV-1a hash of body, which tells unchanged polls apart.
+func hashBody(body []byte) uint64 {
+	h := fnv.New64a()
+	_, _ = h.Write(body)
+	return h.Sum64()
+}
+
+// gzipBody compresses body, favoring speed since live bodies are compressed every poll.
+func gzipBody(body []byte) ([]byte, error) {
+	var buf bytes.Buffer
+	w, _ := gzip.NewWriterLevel(&buf, gzip.BestSpeed)
+	if _, err := w.Write(body); err != nil {
+		return nil, err
+	}
+	if err := w.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// gunzip decompresses a body compressed by gzipBody.
+func gunzip(gzipped []byte) ([]byte, error) {
+	r, err := gzip.NewReader(bytes.NewReader(gzipped))
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+	return io.ReadAll(r)
+}
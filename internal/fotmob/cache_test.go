@@ -0,0 +1,50 @@
+package fotmob
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/0xjuanma/golazo/internal/api"
+)
+
+func TestDetailsCacheRoundTrip(t *testing.T) {
+	cache := NewResponseCache(DefaultCacheConfig())
+	kickoff := time.Date(2025, 5, 25, 15, 0, 0, 0, time.UTC)
+	home, away, xg := 2, 1, 1.4
+	details := &api.MatchDetails{
+		Match: api.Match{
+			ID: 42, Status: api.MatchStatusLive, HomeScore: &home, AwayScore: &away, MatchTime: &kickoff,
+			HomeTeam: api.Team{ID: 1, Name: "Arsenal"}, AwayTeam: api.Team{ID: 2, Name: "Chelsea"},
+		},
+		Events:     []api.MatchEvent{{Type: "goal", Minute: 12, Team: api.Team{ID: 1, Name: "Arsenal"}}},
+		HomeLineup: &api.Lineup{Formation: "4-3-3"},
+		HomeXG:     &xg,
+		Venue:      "Emirates Stadium",
+	}
+
+	if cache.HasDetails(42) {
+		t.Fatal("HasDetails(42) before SetDetails")
+	}
+	cache.SetDetails(42, details)
+	if !cache.HasDetails(42) {
+		t.Fatal("HasDetails(42) = false after SetDetails")
+	}
+	got := cache.Details(42)
+	if !reflect.DeepEqual(got, details) {
+		t.Fatalf("Details(42) = %+v; want %+v", got, details)
+	}
+
+	// Callers get their own copy
+	got.Venue = "changed"
+	if again := cache.Details(42); again.Venue != "Emirates Stadium" {
+		t.Errorf("changing returned details changed the cache: venue %q", again.Venue)
+	}
+
+	// Unchanged details keep their compressed body
+	before := cache.detailsCache[42].gzipped
+	cache.SetDetails(42, details)
+	if after := cache.detailsCache[42].gzipped; &after[0] != &before[0] {
+		t.Error("unchanged details were compressed again")
+	}
+}
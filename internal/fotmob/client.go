@@ -3,20 +3,48 @@ package fotmob
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
 	"net/http"
+	neturl "net/url"
 	"strings"
 	"sync"
 	"time"
 
 	"github.com/0xjuanma/golazo/internal/api"
 	"github.com/0xjuanma/golazo/internal/data"
+	"github.com/0xjuanma/golazo/internal/httpx"
+	"github.com/0xjuanma/golazo/internal/trace"
 )
 
 const (
 	baseURL = "https://www.fotmob.com/api"
+	// matchPageURL is the public FotMob match page; FotMob redirects ID-only URLs to the full slug.
+	matchPageURL = "https://www.fotmob.com/match/%d"
 )
 
+// retryPolicy retries transient FotMob failures quickly; the UI is waiting on these requests.
+var retryPolicy = httpx.Policy{
+	MaxAttempts: 3,
+	BaseDelay:   500 * time.Millisecond,
+	MaxDelay:    4 * time.Second,
+	Jitter:      0.5,
+}
+
+// sharedRequestTimeout bounds a request shared by concurrent callers, retries included,
+// while any of them is still waiting for it.
+const sharedRequestTimeout = 45 * time.Second
+
+// breaker trips after repeated FotMob failures so the UI stops waiting on a failing API.
+// Shared by all clients since it tracks the provider, not a client instance.
+var breaker = httpx.NewBreaker("FotMob", 5, 30*time.Second)
+
+// MatchURL returns the public FotMob web page for a match.
+func MatchURL(matchID int) string {
+	return fmt.Sprintf(matchPageURL, matchID)
+}
+
 // ActiveLeagues returns the league IDs to use for API calls.
 // This respects user settings - if specific leagues are selected, only those are returned.
 // If no selection is made, returns all supported leagues.
@@ -30,17 +58,23 @@ var SupportedLeagues = data.AllLeagueIDs()
 
 // Client implements the api.Client interface for FotMob API
 type Client struct {
-	httpClient  *http.Client
-	baseURL     string
-	rateLimiter *RateLimiter
-	cache       *ResponseCache
-	emptyCache  *EmptyResultsCache // Persistent cache for empty league+date combinations
+	httpClient *http.Client
+	baseURL    string
+	mirrors    *mirrorSet // Base URLs tried in turn, each with its own rate limiter
+	cache      *ResponseCache
+	emptyCache *EmptyResultsCache // Persistent cache for empty league+date combinations
+	inflight   httpx.Shared       // Coalesces concurrent requests for the same URL
+	stale      staleBodies        // Last good responses, served while the circuit is open
+	kickoffs   kickoffBook        // Not-started fixtures seen by live match fetches
+	signer     Signer             // Signs requests with the x-mas header (nil when fotmob_signing is off)
 }
 
 // NewClient creates a new FotMob API client with default configuration.
-// Includes minimal rate limiting (200ms between requests) for fast concurrent requests.
+// Uses the shared FotMob token bucket (5 requests/s with small bursts) for fast concurrent requests,
+// failing over to the fotmob_mirrors base URLs when FotMob blocks or rate limits.
 // Uses default caching configuration for improved performance.
 // Initializes persistent empty results cache to skip known empty league+date combinations.
+// Requests are signed as configured by the fotmob_signing setting.
 func NewClient() *Client {
 	// Initialize empty results cache (logs error but doesn't fail)
 	emptyCache, err := NewEmptyResultsCache()
@@ -49,17 +83,39 @@ func NewClient() *Client {
 		emptyCache = nil
 	}
 
+	httpClient := data.NewHTTPClient(15 * time.Second)
 	return &Client{
-		httpClient: &http.Client{
-			Timeout: 15 * time.Second,
-		},
-		baseURL:     baseURL,
-		rateLimiter: NewRateLimiter(200 * time.Millisecond), // Minimal delay for concurrent requests
-		cache:       NewResponseCache(DefaultCacheConfig()),
-		emptyCache:  emptyCache,
+		httpClient: httpClient,
+		baseURL:    baseURL,
+		mirrors:    newMirrorSet(baseURL, configuredMirrors()),
+		cache:      NewResponseCache(configuredCacheConfig()),
+		emptyCache: emptyCache,
+		signer:     newSigner(signingMode(), httpClient),
 	}
 }
 
+// Strained reports whether FotMob is failing or rate limiting requests (circuit open, or
+// every mirror cooling down), so optional extra polling should back off.
+func (c *Client) Strained() bool {
+	return breaker.IsOpen() || c.mirrors.allDown()
+}
+
+// MockRequest stands in for a FotMob request in mock mode (--mock). It runs the --chaos
+// faults through the same retry policy and circuit breaker as real requests, so a
+// failing mock trips the breaker and Strained backs the app off as it would live.
+// Returns nil when chaos is off.
+func MockRequest(ctx context.Context) error {
+	err := breaker.Do(func() error {
+		return httpx.Retry(ctx, retryPolicy, func() error {
+			return data.MockTransportFault(ctx)
+		})
+	})
+	if err != nil {
+		return err
+	}
+	return data.MockBodyFault()
+}
+
 // Cache returns the response cache for external access (e.g., pre-fetching).
 func (c *Client) Cache() *ResponseCache {
 	return c.cache
@@ -136,25 +192,13 @@ func (c *Client) MatchesByDateWithTabs(ctx context.Context, date time.Time, tabs
 			go func(id int, tabName string) {
 				defer wg.Done()
 
-				// Apply rate limiting (minimal delay for concurrent requests)
-				c.rateLimiter.Wait()
-
 				url := fmt.Sprintf("%s/leagues?id=%d&tab=%s", c.baseURL, id, tabName)
 
-				req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
-				if err != nil {
-					// Skip this league on error - best effort aggregation
-					return
-				}
-
-				req.Header.Set("User-Agent", "Mozilla/5.0")
-
-				resp, err := c.httpClient.Do(req)
+				body, err := c.get(ctx, url)
 				if err != nil {
 					// Skip this league on request error - best effort aggregation
 					return
 				}
-				defer func() { _ = resp.Body.Close() }()
 
 				var leagueResponse struct {
 					Details struct {
@@ -168,7 +212,7 @@ func (c *Client) MatchesByDateWithTabs(ctx context.Context, date time.Time, tabs
 					} `json:"fixtures"`
 				}
 
-				if err := json.NewDecoder(resp.Body).Decode(&leagueResponse); err != nil {
+				if err := json.Unmarshal(body, &leagueResponse); err != nil {
 					// Skip this league on parse error - best effort aggregation
 					return
 				}
@@ -239,23 +283,12 @@ func (c *Client) MatchesByDateWithTabs(ctx context.Context, date time.Time, tabs
 func (c *Client) MatchesForLeagueAndDate(ctx context.Context, leagueID int, date time.Time, tab string) ([]api.Match, error) {
 	requestDateStr := date.UTC().Format("2006-01-02")
 
-	// Apply rate limiting
-	c.rateLimiter.Wait()
-
 	url := fmt.Sprintf("%s/leagues?id=%d&tab=%s", c.baseURL, leagueID, tab)
 
-	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
-	if err != nil {
-		return nil, fmt.Errorf("create request for league %d: %w", leagueID, err)
-	}
-
-	req.Header.Set("User-Agent", "Mozilla/5.0")
-
-	resp, err := c.httpClient.Do(req)
+	body, err := c.get(ctx, url)
 	if err != nil {
 		return nil, fmt.Errorf("fetch league %d: %w", leagueID, err)
 	}
-	defer func() { _ = resp.Body.Close() }()
 
 	var leagueResponse struct {
 		Details struct {
@@ -269,7 +302,7 @@ func (c *Client) MatchesForLeagueAndDate(ctx context.Context, leagueID int, date
 		} `json:"fixtures"`
 	}
 
-	if err := json.NewDecoder(resp.Body).Decode(&leagueResponse); err != nil {
+	if err := json.Unmarshal(body, &leagueResponse); err != nil {
 		return nil, fmt.Errorf("decode league %d response: %w", leagueID, err)
 	}
 
@@ -310,80 +343,116 @@ func (c *Client) MatchDetails(ctx context.Context, matchID int) (*api.MatchDetai
 	if cached := c.cache.Details(matchID); cached != nil {
 		return cached, nil
 	}
+	return c.fetchMatchDetails(ctx, matchID)
+}
 
-	// Apply rate limiting
-	c.rateLimiter.Wait()
+// StaleMatchDetails returns a match's cached details once they have expired, or nil
+// when they are still fresh (MatchDetails returns them) or were never fetched.
+func (c *Client) StaleMatchDetails(matchID int) *api.MatchDetails {
+	if c.cache.HasDetails(matchID) {
+		return nil
+	}
+	return c.cache.LastDetails(matchID)
+}
 
+// fetchMatchDetails fetches and caches match details. When FotMob reports them as not
+// modified, the last parsed details are reused instead of decoding the body again.
+func (c *Client) fetchMatchDetails(ctx context.Context, matchID int) (*api.MatchDetails, error) {
 	url := fmt.Sprintf("%s/matchDetails?matchId=%d", c.baseURL, matchID)
 
-	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+	resp, err := c.getConditional(ctx, url)
 	if err != nil {
-		return nil, fmt.Errorf("create request for match %d: %w", matchID, err)
+		return nil, fmt.Errorf("fetch match details for match %d: %w", matchID, err)
+	}
+	if last := c.cache.LastDetails(matchID); resp.notModified && last != nil {
+		c.cache.SetDetails(matchID, last)
+		return last, nil
 	}
 
-	req.Header.Set("User-Agent", "Mozilla/5.0")
+	details, err := ParseMatchDetails(resp.body)
+	if err != nil {
+		return nil, fmt.Errorf("decode match details response for match %d: %w", matchID, err)
+	}
 
-	resp, err := c.httpClient.Do(req)
+	// Cache the result
+	c.cache.SetDetails(matchID, details)
+
+	return details, nil
+}
+
+// ParseMatchDetails decodes a raw match details response, as returned by RawMatchDetails.
+func ParseMatchDetails(body []byte) (*api.MatchDetails, error) {
+	var response fotmobMatchDetails
+	if err := json.Unmarshal(body, &response); err != nil {
+		return nil, err
+	}
+	return response.toAPIMatchDetails(), nil
+}
+
+// RawMatchDetails fetches the unparsed match details response, bypassing the cache,
+// for exporting with bug reports.
+func (c *Client) RawMatchDetails(ctx context.Context, matchID int) ([]byte, error) {
+	url := fmt.Sprintf("%s/matchDetails?matchId=%d", c.baseURL, matchID)
+
+	body, err := c.get(ctx, url)
 	if err != nil {
 		return nil, fmt.Errorf("fetch match details for match %d: %w", matchID, err)
 	}
-	defer func() { _ = resp.Body.Close() }()
+	return body, nil
+}
 
-	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("unexpected status code %d for match %d", resp.StatusCode, matchID)
-	}
+// Commentary fetches the minute-by-minute text commentary of a match, newest first.
+// Commentary is never cached, as it is only useful while a match is live.
+func (c *Client) Commentary(ctx context.Context, matchID int) ([]api.CommentaryEntry, error) {
+	url := fmt.Sprintf("%s/ltc?ltcUrl=data.fotmob.com/webcl/ltc/gsm/%d_en.json.gz", c.baseURL, matchID)
 
-	var response fotmobMatchDetails
+	body, err := c.get(ctx, url)
+	if err != nil {
+		return nil, fmt.Errorf("fetch commentary for match %d: %w", matchID, err)
+	}
 
-	if err := json.NewDecoder(resp.Body).Decode(&response); err != nil {
-		return nil, fmt.Errorf("decode match details response for match %d: %w", matchID, err)
+	var response fotmobCommentary
+	if err := json.Unmarshal(body, &response); err != nil {
+		return nil, fmt.Errorf("decode commentary response for match %d: %w", matchID, err)
 	}
+	return response.entries(), nil
+}
 
-	details := response.toAPIMatchDetails()
+// Broadcasts fetches the TV stations showing a match in a country, by its ISO 3166-1
+// alpha-3 code (e.g. "GBR", "USA"). Empty when FotMob lists none there.
+func (c *Client) Broadcasts(ctx context.Context, matchID int, country string) ([]string, error) {
+	url := fmt.Sprintf("%s/tvlisting?matchId=%d&countryCode=%s", c.baseURL, matchID, country)
 
-	// Cache the result
-	c.cache.SetDetails(matchID, details)
+	body, err := c.get(ctx, url)
+	if err != nil {
+		return nil, fmt.Errorf("fetch TV listings for match %d: %w", matchID, err)
+	}
 
-	return details, nil
+	var response fotmobTVListing
+	if err := json.Unmarshal(body, &response); err != nil {
+		return nil, fmt.Errorf("decode TV listings response for match %d: %w", matchID, err)
+	}
+	return response.stations(), nil
 }
 
 // MatchDetailsForceRefresh fetches match details, bypassing the cache.
-// Use this for polling live matches to ensure fresh data.
+// Use this for polling live matches to ensure fresh data; unchanged details cost a 304.
 func (c *Client) MatchDetailsForceRefresh(ctx context.Context, matchID int) (*api.MatchDetails, error) {
-	c.cache.ClearMatchDetails(matchID)
-	return c.MatchDetails(ctx, matchID)
+	return c.fetchMatchDetails(ctx, matchID)
+}
+
+// Capabilities reports FotMob's optional features. FotMob has no multi-fixture
+// details endpoint, so batch fetches fall back to one request per match.
+func (c *Client) Capabilities() api.Capabilities {
+	return api.Capabilities{BatchDetails: false}
 }
 
-// BatchMatchDetails retrieves details for multiple matches concurrently.
-// Uses caching and rate limiting to balance speed with API limits.
+// BatchMatchDetails retrieves details for multiple matches.
+// Uses the provider's batch endpoint when it has one, otherwise concurrent per-match
+// calls; caching and rate limiting balance speed with API limits.
 // Returns a map of matchID -> details (nil if fetch failed).
 func (c *Client) BatchMatchDetails(ctx context.Context, matchIDs []int) map[int]*api.MatchDetails {
-	results := make(map[int]*api.MatchDetails)
-	var mu sync.Mutex
-	var wg sync.WaitGroup
-
-	for _, id := range matchIDs {
-		wg.Add(1)
-		go func(matchID int) {
-			defer wg.Done()
-
-			details, err := c.MatchDetails(ctx, matchID)
-			if err != nil {
-				// Store nil for failed fetches
-				mu.Lock()
-				results[matchID] = nil
-				mu.Unlock()
-				return
-			}
-
-			mu.Lock()
-			results[matchID] = details
-			mu.Unlock()
-		}(id)
-	}
-
-	wg.Wait()
-	return results
+	return api.FetchDetails(ctx, c, matchIDs)
 }
 
 // PreFetchMatchDetails fetches details for the first N matches in the background.
@@ -402,7 +471,7 @@ func (c *Client) PreFetchMatchDetails(ctx context.Context, matchIDs []int, maxPr
 	// Filter out already cached matches
 	var uncachedIDs []int
 	for _, id := range matchIDs {
-		if c.cache.Details(id) == nil {
+		if !c.cache.HasDetails(id) {
 			uncachedIDs = append(uncachedIDs, id)
 		}
 	}
@@ -468,7 +537,7 @@ func (c *Client) LeagueTable(ctx context.Context, leagueID int, leagueName strin
 	effectiveID := getParentLeagueID(leagueName, leagueID)
 
 	// Fetch standings using the effective league ID
-	return c.fetchLeagueTable(ctx, effectiveID)
+	return c.Standings(ctx, effectiveID)
 }
 
 // LeagueTableWithParent retrieves the league table/standings, using the parent league ID
@@ -486,31 +555,83 @@ func (c *Client) LeagueTableWithParent(ctx context.Context, leagueID int, league
 		effectiveID = getParentLeagueID(leagueName, leagueID)
 	}
 
-	return c.fetchLeagueTable(ctx, effectiveID)
+	return c.Standings(ctx, effectiveID)
 }
 
-// fetchLeagueTable fetches the league table for a specific league ID.
-func (c *Client) fetchLeagueTable(ctx context.Context, leagueID int) ([]api.LeagueTableEntry, error) {
-	// Apply rate limiting
-	c.rateLimiter.Wait()
-
+// LeagueDetails fetches a league's current name and country as FotMob shows them.
+func (c *Client) LeagueDetails(ctx context.Context, leagueID int) (api.League, error) {
 	url := fmt.Sprintf("%s/leagues?id=%d", c.baseURL, leagueID)
 
-	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+	body, err := c.get(ctx, url)
 	if err != nil {
-		return nil, fmt.Errorf("create request for league %d table: %w", leagueID, err)
+		return api.League{}, fmt.Errorf("fetch league %d: %w", leagueID, err)
 	}
 
-	req.Header.Set("User-Agent", "Mozilla/5.0")
+	var response struct {
+		Details struct {
+			ID          int    `json:"id"`
+			Name        string `json:"name"`
+			Country     string `json:"country"`
+			CountryCode string `json:"countryCode,omitempty"`
+		} `json:"details"`
+	}
+	if err := json.Unmarshal(body, &response); err != nil {
+		return api.League{}, fmt.Errorf("decode league %d: %w", leagueID, err)
+	}
+	if response.Details.Name == "" {
+		return api.League{}, fmt.Errorf("no details available for league %d", leagueID)
+	}
 
-	resp, err := c.httpClient.Do(req)
+	return api.League{
+		ID:          leagueID,
+		Name:        response.Details.Name,
+		Country:     response.Details.Country,
+		CountryCode: response.Details.CountryCode,
+	}, nil
+}
+
+// Standings fetches the league table for a specific league ID, with each team's
+// qualification/relegation zone. Sub-season leagues have no table of their own;
+// use LeagueTableWithParent when only a match's league is known.
+func (c *Client) Standings(ctx context.Context, leagueID int) ([]api.LeagueTableEntry, error) {
+	tables, err := c.leagueTables(ctx, leagueID)
 	if err != nil {
-		return nil, fmt.Errorf("fetch league table for league %d: %w", leagueID, err)
+		return nil, err
 	}
-	defer func() { _ = resp.Body.Close() }()
+	// The first sub-table is typically the current/most relevant season
+	return tables[0].Entries, nil
+}
 
-	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("unexpected status code %d for league %d table", resp.StatusCode, leagueID)
+// GroupTables fetches the group tables of a tournament's group stage (e.g., "Group A"
+// to "Group H" of the World Cup). Returns none for competitions without groups.
+func (c *Client) GroupTables(ctx context.Context, leagueID int) ([]api.GroupTable, error) {
+	tables, err := c.leagueTables(ctx, leagueID)
+	if err != nil {
+		return nil, err
+	}
+
+	var groups []api.GroupTable
+	for _, table := range tables {
+		name := strings.ToLower(table.Name)
+		if strings.Contains(name, "group") || strings.Contains(name, "grp") {
+			groups = append(groups, table)
+		}
+	}
+	return groups, nil
+}
+
+// leagueTables fetches every table of a league: a single unnamed table for regular
+// leagues, or the named sub-tables of multi-table competitions.
+func (c *Client) leagueTables(ctx context.Context, leagueID int) ([]api.GroupTable, error) {
+	if cached := c.cache.Tables(leagueID); cached != nil {
+		return cached, nil
+	}
+
+	url := fmt.Sprintf("%s/leagues?id=%d", c.baseURL, leagueID)
+
+	body, err := c.get(ctx, url)
+	if err != nil {
+		return nil, fmt.Errorf("fetch league table for league %d: %w", leagueID, err)
 	}
 
 	// FotMob returns table data in several formats:
@@ -518,7 +639,7 @@ func (c *Client) fetchLeagueTable(ctx context.Context, leagueID int) ([]api.Leag
 	// 2. Knockout competitions (Champions League): table[0].data.tables[0].table.all[]
 	// 3. Multi-season leagues (Liga MX, Liga Profesional): table[0].data.tables[] with
 	//    multiple sub-tables (e.g., Clausura + Apertura, or Group A + Group B).
-	//    The first sub-table is typically the current/most relevant season.
+	// 4. Tournaments (World Cup, Euro): table[0].data.tables[] with one sub-table per group.
 	var response struct {
 		Table []struct {
 			Data struct {
@@ -526,6 +647,7 @@ func (c *Client) fetchLeagueTable(ctx context.Context, leagueID int) ([]api.Leag
 				Table struct {
 					All []fotmobTableRow `json:"all"`
 				} `json:"table"`
+				Legend []fotmobTableLegend `json:"legend"`
 				// Multi-table format: knockout competitions and multi-season leagues
 				// Examples:
 				//   - Champions League: single table with all teams
@@ -535,43 +657,201 @@ func (c *Client) fetchLeagueTable(ctx context.Context, leagueID int) ([]api.Leag
 					Table struct {
 						All []fotmobTableRow `json:"all"`
 					} `json:"table"`
-					LeagueName string `json:"leagueName"` // e.g., "Clausura", "Apertura - Group A"
+					Legend     []fotmobTableLegend `json:"legend"`
+					LeagueName string              `json:"leagueName"` // e.g., "Clausura", "Apertura - Group A"
 				} `json:"tables"`
 			} `json:"data"`
 		} `json:"table"`
 	}
 
-	if err := json.NewDecoder(resp.Body).Decode(&response); err != nil {
+	if err := json.Unmarshal(body, &response); err != nil {
 		return nil, fmt.Errorf("decode league table response for league %d: %w", leagueID, err)
 	}
 
 	// Extract table rows - try regular format first, then multi-table format
-	var tableData []fotmobTableRow
+	var tables []api.GroupTable
 	if len(response.Table) > 0 {
 		data := response.Table[0].Data
-		// Try regular league format first (single table with all teams)
 		if len(data.Table.All) > 0 {
-			tableData = data.Table.All
-		} else if len(data.Tables) > 0 {
-			// Multi-table format: use first sub-table (current/most relevant season)
-			// This covers both knockout competitions and multi-season leagues
+			tables = append(tables, api.GroupTable{Entries: tableEntries(data.Table.All, data.Legend)})
+		} else {
 			for _, subTable := range data.Tables {
-				if len(subTable.Table.All) > 0 {
-					tableData = subTable.Table.All
-					break
+				if len(subTable.Table.All) == 0 {
+					continue
+				}
+				legend := subTable.Legend
+				if len(legend) == 0 {
+					legend = data.Legend
 				}
+				tables = append(tables, api.GroupTable{
+					Name:    subTable.LeagueName,
+					Entries: tableEntries(subTable.Table.All, legend),
+				})
 			}
 		}
 	}
 
-	if len(tableData) == 0 {
+	if len(tables) == 0 {
 		return nil, fmt.Errorf("no table data available for league %d", leagueID)
 	}
 
-	entries := make([]api.LeagueTableEntry, 0, len(tableData))
-	for _, row := range tableData {
-		entries = append(entries, row.toAPITableEntry())
+	c.cache.SetTables(leagueID, tables)
+	return tables, nil
+}
+
+// get fetches url and returns the response body. Concurrent calls for the same URL
+// share a single rate-limited HTTP request; the returned body must not be modified.
+// Transient failures (network errors, 429, 5xx) are retried with backoff. When FotMob keeps
+// failing the circuit breaker opens and the last good response for url is served instead.
+func (c *Client) get(ctx context.Context, url string) ([]byte, error) {
+	resp, err := c.getConditional(ctx, url)
+	return resp.body, err
+}
+
+// response is a fetched body. notModified is set when FotMob answered a conditional
+// request with 304, so body is the last good response for the URL, unchanged.
+type response struct {
+	body        []byte
+	notModified bool
+}
+
+// getConditional is get, also reporting whether the body is unchanged since the last
+// response for url. Requests for URLs whose last response had an ETag or Last-Modified
+// header are conditional, so unchanged responses cost almost nothing.
+func (c *Client) getConditional(ctx context.Context, url string) (response, error) {
+	// Concurrent requests for url share one fetch. One caller giving up doesn't fail the
+	// others; the fetch is cancelled once none of them is waiting any more.
+	val, err := c.inflight.Do(ctx, url, sharedRequestTimeout, func(ctx context.Context) (any, error) {
+		var resp response
+		err := breaker.Do(func() error {
+			return httpx.Retry(ctx, retryPolicy, func() error {
+				var err error
+				resp, err = c.getOnce(ctx, url)
+				return err
+			})
+		})
+		if err != nil {
+			if stale := c.stale.get(url); stale != nil && breaker.IsOpen() {
+				return response{body: stale}, nil
+			}
+			return response{}, err
+		}
+		if !resp.notModified {
+			c.stale.set(url, resp.body)
+		}
+		return resp, nil
+	})
+	if err != nil {
+		return response{}, err
 	}
+	return val.(response), nil
+}
 
-	return entries, nil
+// getOnce performs a single GET request, trying each mirror in turn while they block,
+// rate limit or fail. The mirror health is updated with each outcome.
+func (c *Client) getOnce(ctx context.Context, url string) (resp response, err error) {
+	path := strings.TrimPrefix(url, c.baseURL)
+	done := trace.Span(ctx, "fotmob GET "+path)
+	defer func() { done(err) }()
+
+	for _, m := range c.mirrors.order() {
+		resp, err = c.getFrom(ctx, m, url, path)
+		c.mirrors.report(m, err)
+		if err == nil || !shouldFailover(err) {
+			return resp, err
+		}
+	}
+	return response{}, err
+}
+
+// getFrom performs a rate-limited GET request for path on a mirror. Requests are signed
+// for the FotMob URL, whichever mirror serves them. When a signed request is rejected
+// with 403, the cached token is dropped and the request is signed and sent again once.
+func (c *Client) getFrom(ctx context.Context, m *mirror, url, path string) (response, error) {
+	if err := m.limiter.Wait(ctx); err != nil {
+		return response{}, err
+	}
+
+	signPath := requestPath(url)
+	resp, err := c.fetch(ctx, m.base+path, url, signPath)
+	var statusErr *httpx.StatusError
+	if c.signer == nil || !errors.As(err, &statusErr) || statusErr.StatusCode != http.StatusForbidden {
+		return resp, err
+	}
+
+	if inv, ok := c.signer.(invalidator); ok {
+		inv.Invalidate(signPath)
+	}
+	if err := m.limiter.Wait(ctx); err != nil {
+		return response{}, err
+	}
+	return c.fetch(ctx, m.base+path, url, signPath)
+}
+
+// fetch sends a GET request for reqURL, signed for signPath when a signer is configured.
+// Signing failures are not fatal: the request is sent unsigned instead. The request is
+// conditional when validators and the last good body are cached for url (the FotMob URL,
+// whichever mirror serves it); a 304 answer returns that body.
+func (c *Client) fetch(ctx context.Context, reqURL, url, signPath string) (response, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", reqURL, nil)
+	if err != nil {
+		return response{}, fmt.Errorf("create request: %w", err)
+	}
+
+	req.Header.Set("User-Agent", "Mozilla/5.0")
+	if c.signer != nil {
+		if token, err := c.signer.Sign(ctx, signPath); err == nil {
+			req.Header.Set(signatureHeader, token)
+		}
+	}
+
+	var last []byte
+	if etag, lastModified := c.cache.Validators(url); etag != "" || lastModified != "" {
+		if last = c.stale.get(url); last != nil {
+			if etag != "" {
+				req.Header.Set("If-None-Match", etag)
+			}
+			if lastModified != "" {
+				req.Header.Set("If-Modified-Since", lastModified)
+			}
+		}
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return response{}, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode == http.StatusNotModified && last != nil {
+		return response{body: last, notModified: true}, nil
+	}
+	if resp.StatusCode != http.StatusOK {
+		return response{}, &httpx.StatusError{StatusCode: resp.StatusCode}
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return response{}, err
+	}
+	c.cache.SetValidators(url, resp.Header.Get("ETag"), resp.Header.Get("Last-Modified"))
+	return response{body: body}, nil
+}
+
+// requestPath returns the path and query of rawURL, the part of a request FotMob signs.
+func requestPath(rawURL string) string {
+	u, err := neturl.Parse(rawURL)
+	if err != nil {
+		return rawURL
+	}
+	return u.RequestURI()
+}
+
+// requestHost returns the host of rawURL, which keys its shared rate limiter.
+func requestHost(rawURL string) string {
+	u, err := neturl.Parse(rawURL)
+	if err != nil {
+		return rawURL
+	}
+	return u.Host
 }
@@ -0,0 +1,154 @@
+package fotmob
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/0xjuanma/golazo/internal/api"
+)
+
+// EventPrefixOverturned marks feed entries of events FotMob no longer reports, such as a
+// goal disallowed by VAR.
+const EventPrefixOverturned = "✗"
+
+// EventDiff is what changed in a match's events between two polls.
+type EventDiff struct {
+	Added   []api.MatchEvent
+	Changed []api.MatchEvent // New version of events whose details changed (e.g., corrected scorer)
+	Removed []api.MatchEvent // Events no longer reported (e.g., overturned goals)
+}
+
+// Empty reports whether nothing changed.
+func (d EventDiff) Empty() bool {
+	return len(d.Added) == 0 && len(d.Changed) == 0 && len(d.Removed) == 0
+}
+
+// DiffEvents compares the events of two polls of a match. Events are matched by ID, or
+// by type, minute, team and player when FotMob sends none; goals without an ID leave the
+// player out, so a corrected scorer is a change rather than an overturned goal.
+func DiffEvents(oldEvents, newEvents []api.MatchEvent) EventDiff {
+	old := make(map[string]api.MatchEvent, len(oldEvents))
+	for _, event := range oldEvents {
+		old[eventKey(event)] = event
+	}
+
+	var diff EventDiff
+	seen := make(map[string]bool, len(newEvents))
+	for _, event := range newEvents {
+		key := eventKey(event)
+		seen[key] = true
+		previous, ok := old[key]
+		switch {
+		case !ok:
+			diff.Added = append(diff.Added, event)
+		case !sameEvent(previous, event):
+			diff.Changed = append(diff.Changed, event)
+		}
+	}
+	for _, event := range oldEvents {
+		if !seen[eventKey(event)] {
+			diff.Removed = append(diff.Removed, event)
+		}
+	}
+	return diff
+}
+
+// WithoutEvents returns the events of from that are not in events, e.g. to forget
+// overturned events FotMob reports again.
+func WithoutEvents(from, events []api.MatchEvent) []api.MatchEvent {
+	keys := make(map[string]bool, len(events))
+	for _, event := range events {
+		keys[eventKey(event)] = true
+	}
+	var rest []api.MatchEvent
+	for _, event := range from {
+		if !keys[eventKey(event)] {
+			rest = append(rest, event)
+		}
+	}
+	return rest
+}
+
+// eventKey identifies an event across polls.
+func eventKey(event api.MatchEvent) string {
+	if event.ID != 0 {
+		return fmt.Sprintf("id:%d", event.ID)
+	}
+	if strings.EqualFold(event.Type, "goal") {
+		return fmt.Sprintf("goal:%d:%d", event.Minute, event.Team.ID)
+	}
+	return fmt.Sprintf("%s:%d:%d:%s", strings.ToLower(event.Type), event.Minute, event.Team.ID, deref(event.Player))
+}
+
+// sameEvent reports whether two versions of an event show the same thing. The
+// timestamp is ignored: it is derived, not reported.
+func sameEvent(a, b api.MatchEvent) bool {
+	return a.Minute == b.Minute && a.DisplayMinute == b.DisplayMinute && a.Type == b.Type &&
+		a.Team.ID == b.Team.ID && deref(a.Player) == deref(b.Player) && deref(a.Assist) == deref(b.Assist) &&
+		deref(a.EventType) == deref(b.EventType) && (a.OwnGoal != nil && *a.OwnGoal) == (b.OwnGoal != nil && *b.OwnGoal)
+}
+
+// deref returns the string s points to, or "".
+func deref(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
+
+// ParseFeed builds the live updates feed: the current events plus entries for events
+// that were overturned, most recent first, without duplicates.
+func (p *LiveUpdateParser) ParseFeed(events, overturned []api.MatchEvent, homeTeam, awayTeam api.Team) []string {
+	type entry struct {
+		minute int
+		text   string
+	}
+	var entries []entry
+	for _, event := range events {
+		if text := p.formatEvent(event, homeTeam, awayTeam); text != "" {
+			entries = append(entries, entry{event.Minute, text})
+		}
+	}
+	for _, event := range overturned {
+		if text := p.formatOverturned(event, homeTeam, awayTeam); text != "" {
+			entries = append(entries, entry{event.Minute, text})
+		}
+	}
+	slices.SortStableFunc(entries, func(a, b entry) int { return b.minute - a.minute })
+
+	feed := make([]string, 0, len(entries))
+	seen := make(map[string]bool, len(entries))
+	for _, e := range entries {
+		if !seen[e.text] {
+			seen[e.text] = true
+			feed = append(feed, e.text)
+		}
+	}
+	return feed
+}
+
+// formatOverturned formats an event FotMob no longer reports. Only goals, cards and
+// other notable events get an entry; substitutions and added time are dropped silently.
+func (p *LiveUpdateParser) formatOverturned(event api.MatchEvent, homeTeam, awayTeam api.Team) string {
+	var label string
+	switch strings.ToLower(event.Type) {
+	case "goal":
+		label = "GOAL"
+	case "card":
+		label = "CARD"
+	case "substitution", "addedtime":
+		return ""
+	default:
+		label = strings.ToUpper(event.Type)
+	}
+	teamMarker := "[A]"
+	if event.Team.ID == homeTeam.ID {
+		teamMarker = "[H]"
+	}
+	player := deref(event.Player)
+	if player == "" {
+		player = "Unknown"
+	}
+	return fmt.Sprintf("%s %d' [OVERTURNED] %s %s %s", EventPrefixOverturned, event.Minute, label, player, teamMarker)
+}
@@ -0,0 +1,33 @@
+package fotmob
+
+import (
+	"testing"
+
+	"github.com/0xjuanma/golazo/internal/api"
+)
+
+func TestDiffEventsCorrectedScorer(t *testing.T) {
+	arsenal := api.Team{ID: 1, Name: "Arsenal"}
+	saka, odegaard := "Saka", "Ødegaard"
+	before := []api.MatchEvent{{Type: "goal", Minute: 12, Team: arsenal, Player: &saka}}
+	after := []api.MatchEvent{{Type: "goal", Minute: 12, Team: arsenal, Player: &odegaard}}
+
+	diff := DiffEvents(before, after)
+	if len(diff.Added) != 0 || len(diff.Removed) != 0 {
+		t.Errorf("diff = %+v; want no added or removed events", diff)
+	}
+	if len(diff.Changed) != 1 || deref(diff.Changed[0].Player) != odegaard {
+		t.Errorf("changed = %+v; want the goal credited to %s", diff.Changed, odegaard)
+	}
+}
+
+func TestDiffEventsOverturnedGoal(t *testing.T) {
+	arsenal := api.Team{ID: 1, Name: "Arsenal"}
+	card := api.MatchEvent{Type: "card", Minute: 30, Team: arsenal}
+	before := []api.MatchEvent{{Type: "goal", Minute: 12, Team: arsenal}, card}
+
+	diff := DiffEvents(before, []api.MatchEvent{card})
+	if len(diff.Removed) != 1 || diff.Removed[0].Type != "goal" || len(diff.Changed) != 0 {
+		t.Errorf("diff = %+v; want the goal removed", diff)
+	}
+}
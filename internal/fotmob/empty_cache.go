@@ -3,7 +3,6 @@ package fotmob
 
 import (
 	"encoding/json"
-	"os"
 	"path/filepath"
 	"sync"
 	"time"
@@ -16,8 +15,13 @@ const (
 	EmptyCacheFileName = "empty-results.json"
 	// EmptyCacheExpiry is the duration after which empty results expire (7 days).
 	EmptyCacheExpiry = 7 * 24 * time.Hour
+	// emptyCacheSchemaVersion is the empty results file format version.
+	emptyCacheSchemaVersion = 1
 )
 
+// emptyCacheSchema is the empty results file format and its migrations.
+var emptyCacheSchema = data.Schema{Name: "empty results cache", Version: emptyCacheSchemaVersion}
+
 // EmptyResultsCache stores date+league combinations that returned 0 matches.
 // This avoids unnecessary API calls for leagues with no matches on specific dates.
 type EmptyResultsCache struct {
@@ -49,16 +53,16 @@ func NewEmptyResultsCache() (*EmptyResultsCache, error) {
 	cache := &EmptyResultsCache{
 		filePath: filepath.Join(configDir, EmptyCacheFileName),
 		data: EmptyCacheData{
-			Version:      1,
+			Version:      emptyCacheSchemaVersion,
 			EmptyResults: make(map[string]EmptyCacheEntry),
 		},
 	}
 
 	// Load existing cache file if it exists
 	if err := cache.load(); err != nil {
-		// If file doesn't exist or is corrupted, start fresh
+		// If the file is corrupted or incompatible (and set aside), start fresh
 		cache.data = EmptyCacheData{
-			Version:      1,
+			Version:      emptyCacheSchemaVersion,
 			EmptyResults: make(map[string]EmptyCacheEntry),
 		}
 	}
@@ -104,22 +108,29 @@ func (c *EmptyResultsCache) Save() error {
 	c.mu.RLock()
 	defer c.mu.RUnlock()
 
-	data, err := json.MarshalIndent(c.data, "", "  ")
+	raw, err := json.MarshalIndent(c.data, "", "  ")
 	if err != nil {
 		return err
 	}
 
-	return os.WriteFile(c.filePath, data, 0644)
+	return data.WriteFileChecked(c.filePath, raw, 0644)
 }
 
-// load reads the cache from disk.
+// load reads the cache from disk, migrating older formats.
 func (c *EmptyResultsCache) load() error {
-	data, err := os.ReadFile(c.filePath)
-	if err != nil {
+	raw, _, err := emptyCacheSchema.Load(c.filePath)
+	if err != nil || raw == nil {
 		return err
 	}
 
-	return json.Unmarshal(data, &c.data)
+	if err := json.Unmarshal(raw, &c.data); err != nil {
+		return err
+	}
+	if c.data.EmptyResults == nil {
+		c.data.EmptyResults = make(map[string]EmptyCacheEntry)
+	}
+	c.data.Version = emptyCacheSchemaVersion
+	return nil
 }
 
 // cleanExpired removes expired entries from the cache.
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"sort"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/0xjuanma/golazo/internal/api"
@@ -29,6 +30,8 @@ func (c *Client) LiveMatches(ctx context.Context) ([]api.Match, error) {
 		return nil, fmt.Errorf("fetch matches for date %s: %w", today.Format("2006-01-02"), err)
 	}
 
+	c.kickoffs.record(matches)
+
 	// Filter for live matches only (started but not finished)
 	var liveMatches []api.Match
 	for _, match := range matches {
@@ -62,6 +65,8 @@ func (c *Client) LiveMatchesForLeague(ctx context.Context, leagueID int) ([]api.
 		return nil, err
 	}
 
+	c.kickoffs.record(matches)
+
 	// Filter for live matches only
 	var liveMatches []api.Match
 	for _, match := range matches {
@@ -77,6 +82,45 @@ func (c *Client) LiveMatchesForLeague(ctx context.Context, leagueID int) ([]api.
 	return liveMatches, nil
 }
 
+// kickoffBook remembers not-started fixtures seen while fetching live matches,
+// so callers can plan around kickoffs without extra requests.
+type kickoffBook struct {
+	mu      sync.Mutex
+	matches map[int]api.Match
+}
+
+// record stores not-started fixtures and forgets those that have since started.
+func (b *kickoffBook) record(matches []api.Match) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.matches == nil {
+		b.matches = make(map[int]api.Match)
+	}
+	for _, match := range matches {
+		if match.Status == api.MatchStatusNotStarted && match.MatchTime != nil {
+			b.matches[match.ID] = match
+		} else {
+			delete(b.matches, match.ID)
+		}
+	}
+}
+
+// UpcomingKickoffs returns not-started fixtures kicking off within the last or next
+// 24 hours, as seen by the most recent live match fetches.
+func (c *Client) UpcomingKickoffs() []api.Match {
+	c.kickoffs.mu.Lock()
+	defer c.kickoffs.mu.Unlock()
+
+	var upcoming []api.Match
+	for _, match := range c.kickoffs.matches {
+		if time.Since(*match.MatchTime).Abs() <= 24*time.Hour {
+			upcoming = append(upcoming, match)
+		}
+	}
+	return upcoming
+}
+
 // TotalLeagues returns the number of active leagues (respects user settings).
 func TotalLeagues() int {
 	return len(ActiveLeagues())
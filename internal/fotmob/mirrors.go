@@ -0,0 +1,139 @@
+package fotmob
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/0xjuanma/golazo/internal/data"
+	"github.com/0xjuanma/golazo/internal/httpx"
+)
+
+// Mirror health: a base URL is skipped for mirrorCooldown after mirrorMaxFailures
+// consecutive failures, doubling with each further failure up to mirrorMaxCooldown.
+const (
+	mirrorMaxFailures = 2
+	mirrorCooldown    = 30 * time.Second
+	mirrorMaxCooldown = 5 * time.Minute
+)
+
+// mirror is a FotMob API base URL and its health.
+type mirror struct {
+	base      string
+	limiter   *httpx.Limiter
+	failures  int       // Consecutive failures
+	downUntil time.Time // Skipped until then, unless every mirror is down
+}
+
+// mirrorSet holds www.fotmob.com/api followed by the fotmob_mirrors alternates, in
+// the order they are tried.
+type mirrorSet struct {
+	mu      sync.Mutex
+	mirrors []*mirror
+}
+
+// newMirrorSet creates the mirror set for the primary base URL and its alternates.
+func newMirrorSet(primary string, alternates []string) *mirrorSet {
+	s := &mirrorSet{}
+	for _, base := range append([]string{primary}, alternates...) {
+		base = strings.TrimRight(strings.TrimSpace(base), "/")
+		if base == "" || slices.ContainsFunc(s.mirrors, func(m *mirror) bool { return m.base == base }) {
+			continue
+		}
+		s.mirrors = append(s.mirrors, &mirror{
+			base:    base,
+			limiter: httpx.LimiterFor(requestHost(base), 5, 4), // 5 requests/s, bursts of 4 for concurrent league batches
+		})
+	}
+	return s
+}
+
+// configuredMirrors returns the fotmob_mirrors setting. Mirrors aren't used in privacy
+// mode, which only allows FotMob itself.
+func configuredMirrors() []string {
+	if data.PrivacyMode() {
+		return nil
+	}
+	settings, err := data.LoadSettings()
+	if err != nil {
+		return nil
+	}
+	return settings.FotmobMirrors
+}
+
+// order returns the mirrors to try: healthy ones in configured order, then those
+// cooling down, soonest back first, as a last resort.
+func (s *mirrorSet) order() []*mirror {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := time.Now()
+	var healthy, down []*mirror
+	for _, m := range s.mirrors {
+		if now.Before(m.downUntil) {
+			down = append(down, m)
+		} else {
+			healthy = append(healthy, m)
+		}
+	}
+	slices.SortStableFunc(down, func(a, b *mirror) int { return a.downUntil.Compare(b.downUntil) })
+	return append(healthy, down...)
+}
+
+// allDown reports whether every mirror is cooling down after repeated failures.
+func (s *mirrorSet) allDown() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := time.Now()
+	for _, m := range s.mirrors {
+		if !now.Before(m.downUntil) {
+			return false
+		}
+	}
+	return true
+}
+
+// report records the outcome of a request to m. Only errors that call for failover
+// count against its health.
+func (s *mirrorSet) report(m *mirror, err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err == nil {
+		m.failures = 0
+		m.downUntil = time.Time{}
+		return
+	}
+	if !shouldFailover(err) {
+		return
+	}
+	m.failures++
+	if m.failures >= mirrorMaxFailures {
+		m.downUntil = time.Now().Add(mirrorBackoff(m.failures))
+	}
+}
+
+// mirrorBackoff returns how long a mirror is skipped after failures consecutive failures.
+// The doubling is clamped before it can overflow, however long a mirror keeps failing.
+func mirrorBackoff(failures int) time.Duration {
+	doublings := min(max(failures-mirrorMaxFailures, 0), 16)
+	return min(mirrorCooldown<<doublings, mirrorMaxCooldown)
+}
+
+// shouldFailover reports whether err means the mirror is blocking, rate limiting or
+// unavailable (403, 429, 5xx, network errors), so the next mirror should be tried.
+func shouldFailover(err error) bool {
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+	var statusErr *httpx.StatusError
+	if errors.As(err, &statusErr) {
+		return statusErr.StatusCode == http.StatusForbidden || httpx.IsRetryable(err)
+	}
+	return httpx.IsRetryable(err)
+}
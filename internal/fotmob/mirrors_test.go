@@ -0,0 +1,28 @@
+package fotmob
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+func TestMirrorBackoff(t *testing.T) {
+	tests := []struct {
+		failures int
+		want     time.Duration
+	}{
+		{mirrorMaxFailures, mirrorCooldown},
+		{mirrorMaxFailures + 1, 2 * mirrorCooldown},
+		{mirrorMaxFailures + 3, 8 * mirrorCooldown},
+		{mirrorMaxFailures + 4, mirrorMaxCooldown},
+		{mirrorMaxFailures + 64, mirrorMaxCooldown},
+		{1000, mirrorMaxCooldown},
+		{math.MaxInt, mirrorMaxCooldown},
+	}
+
+	for _, tt := range tests {
+		if got := mirrorBackoff(tt.failures); got != tt.want {
+			t.Errorf("mirrorBackoff(%d) = %v; want %v", tt.failures, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,194 @@
+package fotmob
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/0xjuanma/golazo/internal/api"
+)
+
+// maxRecentPlayerMatches caps the recent matches of a player page.
+const maxRecentPlayerMatches = 8
+
+// fotmobPlayerPage is the player endpoint response. Each section is decoded on its
+// own, so a format change in one doesn't lose the others.
+type fotmobPlayerPage struct {
+	ID        int    `json:"id"`
+	Name      string `json:"name"`
+	BirthDate struct {
+		UTCTime string `json:"utcTime"`
+	} `json:"birthDate"`
+	PrimaryTeam struct {
+		TeamName string `json:"teamName"`
+	} `json:"primaryTeam"`
+	PositionDescription struct {
+		PrimaryPosition struct {
+			Label string `json:"label"`
+		} `json:"primaryPosition"`
+	} `json:"positionDescription"`
+	PlayerInformation json.RawMessage `json:"playerInformation"`
+	MainLeague        json.RawMessage `json:"mainLeague"`
+	RecentMatches     json.RawMessage `json:"recentMatches"`
+	InjuryInformation *struct {
+		Name           string `json:"name"`
+		ExpectedReturn string `json:"expectedReturn"`
+	} `json:"injuryInformation"` // Null when fit
+}
+
+// fotmobPlayerMatch is a recent match of the player.
+type fotmobPlayerMatch struct {
+	OpponentTeamName string `json:"opponentTeamName"`
+	IsHomeTeam       bool   `json:"isHomeTeam"`
+	MatchDate        struct {
+		UTCTime string `json:"utcTime"`
+	} `json:"matchDate"`
+	HomeScore   json.RawMessage `json:"homeScore"` // String or number
+	AwayScore   json.RawMessage `json:"awayScore"`
+	RatingProps struct {
+		Rating json.RawMessage `json:"rating"` // String or number
+		Num    json.RawMessage `json:"num"`    // Older responses
+	} `json:"ratingProps"`
+	MinutesPlayed int `json:"minutesPlayed"`
+	Goals         int `json:"goals"`
+	Assists       int `json:"assists"`
+}
+
+// Player fetches a player's page: position, age, season stats, recent match ratings
+// and injury status.
+func (c *Client) Player(ctx context.Context, playerID int) (*api.PlayerPage, error) {
+	url := fmt.Sprintf("%s/playerData?id=%d", c.baseURL, playerID)
+
+	body, err := c.get(ctx, url)
+	if err != nil {
+		return nil, fmt.Errorf("fetch player %d: %w", playerID, err)
+	}
+
+	var response fotmobPlayerPage
+	if err := json.Unmarshal(body, &response); err != nil {
+		return nil, fmt.Errorf("decode player response for player %d: %w", playerID, err)
+	}
+	if response.ID == 0 {
+		response.ID = playerID
+	}
+	return response.toAPIPlayerPage(), nil
+}
+
+// toAPIPlayerPage converts the player response.
+func (p fotmobPlayerPage) toAPIPlayerPage() *api.PlayerPage {
+	page := &api.PlayerPage{
+		ID:       p.ID,
+		Name:     p.Name,
+		Team:     p.PrimaryTeam.TeamName,
+		Position: p.PositionDescription.PrimaryPosition.Label,
+		Recent:   p.parseRecentMatches(),
+	}
+	if p.InjuryInformation != nil {
+		page.Injury = p.InjuryInformation.Name
+		page.Return = p.InjuryInformation.ExpectedReturn
+	}
+	p.parseInformation(page)
+	if page.Age == 0 {
+		if birth, err := time.Parse(time.RFC3339, p.BirthDate.UTCTime); err == nil {
+			page.Age = age(birth, time.Now())
+		}
+	}
+	page.Season, page.Stats = p.parseSeasonStats()
+	return page
+}
+
+// parseInformation reads the age and country from the player information list.
+func (p fotmobPlayerPage) parseInformation(page *api.PlayerPage) {
+	var info []struct {
+		Title string `json:"title"`
+		Value struct {
+			NumberValue json.RawMessage `json:"numberValue"`
+			Fallback    json.RawMessage `json:"fallback"` // String or number
+		} `json:"value"`
+	}
+	if len(p.PlayerInformation) == 0 || json.Unmarshal(p.PlayerInformation, &info) != nil {
+		return
+	}
+	for _, item := range info {
+		switch strings.ToLower(item.Title) {
+		case "age":
+			page.Age = parseInt(rawString(item.Value.NumberValue))
+			if page.Age == 0 {
+				page.Age = parseInt(rawString(item.Value.Fallback))
+			}
+		case "country":
+			page.Country = rawString(item.Value.Fallback)
+		}
+	}
+}
+
+// parseSeasonStats extracts the stats of the player's main league this season.
+func (p fotmobPlayerPage) parseSeasonStats() (string, []api.PlayerStat) {
+	var league struct {
+		LeagueName string `json:"leagueName"`
+		Season     string `json:"season"`
+		Stats      []struct {
+			Title string          `json:"title"`
+			Value json.RawMessage `json:"value"` // String or number
+		} `json:"stats"`
+	}
+	if len(p.MainLeague) == 0 || json.Unmarshal(p.MainLeague, &league) != nil {
+		return "", nil
+	}
+
+	stats := make([]api.PlayerStat, 0, len(league.Stats))
+	for _, s := range league.Stats {
+		value := rawString(s.Value)
+		if s.Title == "" || value == "" || value == "null" {
+			continue
+		}
+		stats = append(stats, api.PlayerStat{Label: s.Title, Value: value})
+	}
+	return strings.TrimSpace(league.LeagueName + " " + league.Season), stats
+}
+
+// parseRecentMatches extracts the player's latest matches, newest first as FotMob lists them.
+func (p fotmobPlayerPage) parseRecentMatches() []api.PlayerMatch {
+	var recent []fotmobPlayerMatch
+	if len(p.RecentMatches) == 0 || json.Unmarshal(p.RecentMatches, &recent) != nil {
+		return nil
+	}
+
+	matches := make([]api.PlayerMatch, 0, min(len(recent), maxRecentPlayerMatches))
+	for _, r := range recent {
+		if len(matches) == maxRecentPlayerMatches {
+			break
+		}
+		match := api.PlayerMatch{
+			Opponent: r.OpponentTeamName,
+			Home:     r.IsHomeTeam,
+			Minutes:  r.MinutesPlayed,
+			Goals:    r.Goals,
+			Assists:  r.Assists,
+		}
+		match.Date, _ = time.Parse(time.RFC3339, r.MatchDate.UTCTime)
+		if home, away := rawString(r.HomeScore), rawString(r.AwayScore); home != "" && away != "" && home != "null" && away != "null" {
+			match.Score = home + "-" + away
+		}
+		rating := rawString(r.RatingProps.Rating)
+		if rating == "" || rating == "null" {
+			rating = rawString(r.RatingProps.Num)
+		}
+		if rating != "null" {
+			match.Rating = rating
+		}
+		matches = append(matches, match)
+	}
+	return matches
+}
+
+// age returns the age in whole years of someone born on birth.
+func age(birth, now time.Time) int {
+	years := now.Year() - birth.Year()
+	if now.Month() < birth.Month() || (now.Month() == birth.Month() && now.Day() < birth.Day()) {
+		years--
+	}
+	return years
+}
@@ -0,0 +1,91 @@
+package fotmob
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/0xjuanma/golazo/internal/api"
+	"github.com/0xjuanma/golazo/internal/data"
+)
+
+const (
+	// SharedLiveFileName is the name of the on-disk live matches snapshot.
+	// Written by the TUI and read by companion commands (e.g., golazo tmux)
+	// so they don't have to make their own API calls.
+	SharedLiveFileName = "live-matches.json"
+	// SharedLiveMaxAge is how long a shared live snapshot is considered fresh.
+	// Matches the default in-memory live matches TTL (TTLPolicy.LiveMatches).
+	SharedLiveMaxAge = 2 * time.Minute
+	// sharedLiveSchemaVersion is the snapshot file format version.
+	sharedLiveSchemaVersion = 1
+)
+
+// sharedLiveSchema is the snapshot file format. Snapshots are short-lived, so an
+// incompatible one is ignored rather than migrated.
+var sharedLiveSchema = data.Schema{Name: "live matches snapshot", Version: sharedLiveSchemaVersion}
+
+// sharedLiveSnapshot is the JSON structure stored on disk.
+type sharedLiveSnapshot struct {
+	Version int         `json:"version"`
+	SavedAt time.Time   `json:"saved_at"`
+	Matches []api.Match `json:"matches"`
+}
+
+// sharedLivePath returns the path to the shared live matches snapshot.
+func sharedLivePath() (string, error) {
+	dir, err := data.CacheDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, SharedLiveFileName), nil
+}
+
+// SaveSharedLiveMatches persists the live matches list so other golazo processes can reuse it.
+func SaveSharedLiveMatches(matches []api.Match) error {
+	path, err := sharedLivePath()
+	if err != nil {
+		return err
+	}
+
+	raw, err := json.Marshal(sharedLiveSnapshot{
+		Version: sharedLiveSchemaVersion,
+		SavedAt: time.Now(),
+		Matches: matches,
+	})
+	if err != nil {
+		return fmt.Errorf("marshal live snapshot: %w", err)
+	}
+
+	return data.WriteFileAtomic(path, raw, 0644)
+}
+
+// SharedLiveMatches returns the shared live matches snapshot if it is younger than maxAge.
+// ok is false when there is no snapshot or it is stale.
+func SharedLiveMatches(maxAge time.Duration) (matches []api.Match, ok bool) {
+	path, err := sharedLivePath()
+	if err != nil {
+		return nil, false
+	}
+
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		return nil, false
+	}
+	if raw, _, err = sharedLiveSchema.Migrate(raw); err != nil {
+		return nil, false
+	}
+
+	var snapshot sharedLiveSnapshot
+	if err := json.Unmarshal(raw, &snapshot); err != nil {
+		return nil, false
+	}
+
+	if time.Since(snapshot.SavedAt) > maxAge {
+		return nil, false
+	}
+
+	return snapshot.Matches, true
+}
@@ -0,0 +1,344 @@
+package fotmob
+
+import (
+	"context"
+	"crypto/md5"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/0xjuanma/golazo/internal/data"
+	"github.com/0xjuanma/golazo/internal/httpx"
+	"golang.org/x/sync/singleflight"
+)
+
+const (
+	// signatureHeader is the header FotMob expects signed API requests to carry.
+	signatureHeader = "x-mas"
+	// StaticTokenEnv supplies a ready-made x-mas token (e.g. copied from the browser).
+	StaticTokenEnv = "GOLAZO_FOTMOB_XMAS"
+	// SigningKeyCredential is the credentials store secret the local signer signs with.
+	SigningKeyCredential = "fotmob_key"
+	// SigningKeyEnv supplies the signing key when neither the keyring nor the credentials
+	// store holds it.
+	SigningKeyEnv = "GOLAZO_FOTMOB_KEY"
+	// tokenEndpointURL serves x-mas tokens from FotMob itself, so it is allowed in privacy mode.
+	tokenEndpointURL = "https://www.fotmob.com/api/mytoken"
+
+	// localTokenTTL is how long a locally signed token is reused for the same path.
+	localTokenTTL = 5 * time.Minute
+	// endpointTokenTTL is how long a fetched token is reused for every path.
+	endpointTokenTTL = 30 * time.Minute
+
+	// signFailureBackoff is how long a failed signing is remembered before the backend is
+	// asked again; it doubles with each consecutive failure up to maxSignFailureBackoff.
+	signFailureBackoff    = 30 * time.Second
+	maxSignFailureBackoff = 10 * time.Minute
+	// maxCachedTokens bounds the per-path token cache.
+	maxCachedTokens = 256
+	// signTimeout bounds a signing shared by concurrent requests.
+	signTimeout = 15 * time.Second
+)
+
+// errSignerUnavailable is returned by backends that aren't configured, so the chain moves on.
+var errSignerUnavailable = errors.New("signer not configured")
+
+// Signer produces the x-mas header value for a FotMob API path (e.g. "/api/matchDetails?matchId=1").
+type Signer interface {
+	Sign(ctx context.Context, path string) (string, error)
+}
+
+// invalidator is implemented by signers that cache tokens; Invalidate drops the token
+// for path after FotMob rejects it.
+type invalidator interface {
+	Invalidate(path string)
+}
+
+// newSigner builds the signer for the fotmob_signing setting: nil when signing is off,
+// a token endpoint for URLs, and otherwise the chain of static token, local signing
+// and FotMob's token endpoint.
+func newSigner(mode string, client *http.Client) Signer {
+	switch mode {
+	case data.FotmobSigningOff:
+		return nil
+	case "", data.FotmobSigningAuto:
+		return chainSigner{
+			staticSigner{},
+			newCachedSigner(localSigner{}, localTokenTTL, true),
+			newCachedSigner(newEndpointSigner(tokenEndpointURL, client), endpointTokenTTL, false),
+		}
+	default:
+		return newCachedSigner(newEndpointSigner(mode, client), endpointTokenTTL, false)
+	}
+}
+
+// signingMode returns the fotmob_signing setting ("" when settings can't be read).
+func signingMode() string {
+	settings, err := data.LoadSettings()
+	if err != nil {
+		return ""
+	}
+	return settings.FotmobSigning
+}
+
+// chainSigner tries each backend in order and returns the first token produced.
+type chainSigner []Signer
+
+// Sign returns the first backend's token, or the last error when none could sign.
+func (c chainSigner) Sign(ctx context.Context, path string) (string, error) {
+	err := errSignerUnavailable
+	for _, s := range c {
+		token, signErr := s.Sign(ctx, path)
+		if signErr == nil {
+			return token, nil
+		}
+		if !errors.Is(signErr, errSignerUnavailable) {
+			err = signErr
+		}
+	}
+	return "", err
+}
+
+// Invalidate drops cached tokens for path in every backend.
+func (c chainSigner) Invalidate(path string) {
+	for _, s := range c {
+		if inv, ok := s.(invalidator); ok {
+			inv.Invalidate(path)
+		}
+	}
+}
+
+// staticSigner uses the token from StaticTokenEnv for every request.
+type staticSigner struct{}
+
+// Sign returns the StaticTokenEnv token.
+func (staticSigner) Sign(ctx context.Context, path string) (string, error) {
+	if token := strings.TrimSpace(os.Getenv(StaticTokenEnv)); token != "" {
+		return token, nil
+	}
+	return "", errSignerUnavailable
+}
+
+// localSigner signs requests like FotMob's web client: the path and a millisecond
+// timestamp, MD5-signed with the SigningKeyCredential secret, encoded as base64 JSON.
+type localSigner struct{}
+
+// signedBody is the payload FotMob's x-mas header carries.
+type signedBody struct {
+	URL  string `json:"url"`
+	Code int64  `json:"code"`
+	Foo  string `json:"foo"`
+}
+
+// Sign signs path with the SigningKeyCredential secret from the OS keyring or the
+// unlocked credentials store, or else SigningKeyEnv.
+func (localSigner) Sign(ctx context.Context, path string) (string, error) {
+	key, ok := data.Credential(SigningKeyCredential)
+	if !ok || key == "" {
+		key = strings.TrimSpace(os.Getenv(SigningKeyEnv))
+	}
+	if key == "" {
+		return "", errSignerUnavailable
+	}
+
+	body := signedBody{URL: path, Code: time.Now().UnixMilli(), Foo: "production"}
+	bodyJSON, err := json.Marshal(body)
+	if err != nil {
+		return "", fmt.Errorf("encode signed body: %w", err)
+	}
+	sum := md5.Sum(append(bodyJSON, key...))
+
+	header, err := json.Marshal(struct {
+		Body      signedBody `json:"body"`
+		Signature string     `json:"signature"`
+	}{body, strings.ToUpper(hex.EncodeToString(sum[:]))})
+	if err != nil {
+		return "", fmt.Errorf("encode signature: %w", err)
+	}
+	return base64.StdEncoding.EncodeToString(header), nil
+}
+
+// endpointSigner fetches a ready-made token from an endpoint answering {"x-mas": "..."}.
+// Tokens from it don't depend on the path.
+type endpointSigner struct {
+	url     string
+	client  *http.Client
+	limiter *httpx.Limiter
+}
+
+// newEndpointSigner creates a signer for the token endpoint at url. Token requests draw
+// from the endpoint host's shared rate limiter, FotMob's own when it serves the tokens.
+func newEndpointSigner(url string, client *http.Client) *endpointSigner {
+	return &endpointSigner{
+		url:     url,
+		client:  client,
+		limiter: httpx.LimiterFor(requestHost(url), 5, 4),
+	}
+}
+
+// Sign fetches a token from the endpoint.
+func (s *endpointSigner) Sign(ctx context.Context, path string) (string, error) {
+	if err := s.limiter.Wait(ctx); err != nil {
+		return "", err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "GET", s.url, nil)
+	if err != nil {
+		return "", fmt.Errorf("create token request: %w", err)
+	}
+	req.Header.Set("User-Agent", "Mozilla/5.0")
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("fetch x-mas token: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("fetch x-mas token: unexpected status code %d", resp.StatusCode)
+	}
+
+	var token struct {
+		XMas string `json:"x-mas"`
+	}
+	if err := json.NewDecoder(io.LimitReader(resp.Body, 64<<10)).Decode(&token); err != nil {
+		return "", fmt.Errorf("decode x-mas token: %w", err)
+	}
+	if token.XMas == "" {
+		return "", errors.New("token endpoint returned no x-mas token")
+	}
+	return token.XMas, nil
+}
+
+// cachedSigner reuses a backend's tokens until they expire or FotMob rejects them.
+// Path-bound tokens are cached per path; others are shared by every path. Failures are
+// remembered with a growing backoff, so a failing backend isn't asked on every request.
+type cachedSigner struct {
+	signer   Signer
+	ttl      time.Duration
+	perPath  bool
+	mu       sync.Mutex
+	tokens   map[string]cachedToken
+	inflight singleflight.Group // Concurrent misses for a key share one signing
+}
+
+// cachedToken is a token, or the error of a failed signing, and when it stops being reused.
+type cachedToken struct {
+	value    string
+	err      error
+	failures int // Consecutive failed signings, sizing the backoff
+	expires  time.Time
+}
+
+// newCachedSigner wraps signer with a token cache.
+func newCachedSigner(signer Signer, ttl time.Duration, perPath bool) *cachedSigner {
+	return &cachedSigner{signer: signer, ttl: ttl, perPath: perPath, tokens: make(map[string]cachedToken)}
+}
+
+// Sign returns the cached token for path, signing a new one when there is none. Concurrent
+// misses share one signing, which outlives the caller that started it; each caller stops
+// waiting when its own ctx is done.
+func (c *cachedSigner) Sign(ctx context.Context, path string) (string, error) {
+	key := c.key(path)
+	if token, ok := c.lookup(key); ok {
+		return token.value, token.err
+	}
+
+	shared := c.inflight.DoChan(key, func() (any, error) {
+		if token, ok := c.lookup(key); ok {
+			return token.value, token.err
+		}
+
+		ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), signTimeout)
+		defer cancel()
+		token, err := c.signer.Sign(ctx, path)
+		c.store(key, token, err)
+		return token, err
+	})
+	select {
+	case <-ctx.Done():
+		return "", ctx.Err()
+	case res := <-shared:
+		if res.Err != nil {
+			return "", res.Err
+		}
+		return res.Val.(string), nil
+	}
+}
+
+// store caches the outcome of a signing under key. Failures are kept for a backoff that
+// doubles with each consecutive one; unconfigured backends aren't cached, so they are
+// used as soon as they are set up.
+func (c *cachedSigner) store(key, token string, err error) {
+	if errors.Is(err, errSignerUnavailable) {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now := time.Now()
+	if len(c.tokens) >= maxCachedTokens {
+		c.evictLocked(now)
+	}
+	if err == nil {
+		c.tokens[key] = cachedToken{value: token, expires: now.Add(c.ttl)}
+		return
+	}
+	failures := c.tokens[key].failures + 1
+	backoff := min(signFailureBackoff<<min(failures-1, 10), maxSignFailureBackoff)
+	c.tokens[key] = cachedToken{err: err, failures: failures, expires: now.Add(backoff)}
+}
+
+// evictLocked makes room in the token cache: expired entries are dropped, or the one
+// expiring soonest when none have. Must be called with mu held.
+func (c *cachedSigner) evictLocked(now time.Time) {
+	var (
+		soonest  string
+		earliest time.Time
+	)
+	for key, token := range c.tokens {
+		if now.After(token.expires) {
+			delete(c.tokens, key)
+		} else if earliest.IsZero() || token.expires.Before(earliest) {
+			soonest, earliest = key, token.expires
+		}
+	}
+	if len(c.tokens) >= maxCachedTokens {
+		delete(c.tokens, soonest)
+	}
+}
+
+// Invalidate drops the cached token used for path.
+func (c *cachedSigner) Invalidate(path string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.tokens, c.key(path))
+}
+
+// lookup returns the unexpired token, or signing failure, stored under key.
+func (c *cachedSigner) lookup(key string) (cachedToken, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	token, ok := c.tokens[key]
+	if !ok || time.Now().After(token.expires) {
+		return cachedToken{}, false
+	}
+	return token, true
+}
+
+// key returns the cache key for path.
+func (c *cachedSigner) key(path string) string {
+	if c.perPath {
+		return path
+	}
+	return ""
+}
@@ -0,0 +1,257 @@
+package fotmob
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/0xjuanma/golazo/internal/api"
+)
+
+// maxTeamFixtures caps the upcoming fixtures of a team page.
+const maxTeamFixtures = 10
+
+// squadGroupTitles names FotMob's squad sections; unknown ones keep FotMob's title.
+var squadGroupTitles = map[string]string{
+	"coach":       "Coach",
+	"keepers":     "Goalkeepers",
+	"defenders":   "Defenders",
+	"midfielders": "Midfielders",
+	"attackers":   "Attackers",
+}
+
+// fotmobTeamPage is the team endpoint response. Each section is decoded on its own,
+// so a format change in one doesn't lose the others.
+type fotmobTeamPage struct {
+	Details struct {
+		ID        int    `json:"id"`
+		Name      string `json:"name"`
+		ShortName string `json:"shortName"`
+	} `json:"details"`
+	Overview struct {
+		Table    json.RawMessage `json:"table"`
+		TeamForm json.RawMessage `json:"teamForm"`
+	} `json:"overview"`
+	Fixtures json.RawMessage `json:"fixtures"`
+	Squad    json.RawMessage `json:"squad"`
+}
+
+// fotmobTeamForm is a recent result of the team form section.
+type fotmobTeamForm struct {
+	ResultString string `json:"resultString"` // "W", "D" or "L"
+	Score        string `json:"score"`        // e.g. "2-1"
+	TooltipText  struct {
+		HomeTeam   string          `json:"homeTeam"`
+		HomeTeamID json.RawMessage `json:"homeTeamId"` // String or number
+		AwayTeam   string          `json:"awayTeam"`
+	} `json:"tooltipText"`
+}
+
+// fotmobTeamFixture is a fixture of the team's season, finished or not.
+type fotmobTeamFixture struct {
+	ID         json.RawMessage   `json:"id"` // String or number
+	Home       fotmobFixtureTeam `json:"home"`
+	Away       fotmobFixtureTeam `json:"away"`
+	Tournament struct {
+		LeagueID int    `json:"leagueId"`
+		Name     string `json:"name"`
+	} `json:"tournament"`
+	Status struct {
+		status
+		ScoreStr string `json:"scoreStr"` // e.g., "2 - 1"
+	} `json:"status"`
+}
+
+// fotmobFixtureTeam is a team of a fixture.
+type fotmobFixtureTeam struct {
+	ID        json.RawMessage `json:"id"` // String or number
+	Name      string          `json:"name"`
+	ShortName string          `json:"shortName"`
+}
+
+// fotmobSquadMember is a player or coach of the squad section.
+type fotmobSquadMember struct {
+	ID          int    `json:"id"`
+	Name        string `json:"name"`
+	ShirtNumber *int   `json:"shirtNumber"` // Null when not assigned
+	CountryName string `json:"cname"`
+	Age         int    `json:"age"`
+}
+
+// Team fetches a team's page: league position, recent form, upcoming fixtures and squad.
+func (c *Client) Team(ctx context.Context, teamID int) (*api.TeamPage, error) {
+	url := fmt.Sprintf("%s/teams?id=%d", c.baseURL, teamID)
+
+	body, err := c.get(ctx, url)
+	if err != nil {
+		return nil, fmt.Errorf("fetch team %d: %w", teamID, err)
+	}
+
+	var response fotmobTeamPage
+	if err := json.Unmarshal(body, &response); err != nil {
+		return nil, fmt.Errorf("decode team response for team %d: %w", teamID, err)
+	}
+	if response.Details.ID == 0 {
+		response.Details.ID = teamID
+	}
+	return response.toAPITeamPage(), nil
+}
+
+// toAPITeamPage converts the team response.
+func (t fotmobTeamPage) toAPITeamPage() *api.TeamPage {
+	page := &api.TeamPage{
+		Team: api.Team{
+			ID:        t.Details.ID,
+			Name:      t.Details.Name,
+			ShortName: t.Details.ShortName,
+		},
+		Form:     t.parseForm(),
+		Fixtures: t.parseFixtures(),
+		Squad:    t.parseSquad(),
+	}
+	page.League, page.Position = t.parsePosition()
+	return page
+}
+
+// parsePosition finds the team in its league table, searching the sub-tables of
+// multi-table competitions too. Returns a zero position when the team isn't listed.
+func (t fotmobTeamPage) parsePosition() (league string, position int) {
+	var tables []struct {
+		Data struct {
+			LeagueName string `json:"leagueName"`
+			Table      struct {
+				All []fotmobTableRow `json:"all"`
+			} `json:"table"`
+			Tables []struct {
+				LeagueName string `json:"leagueName"`
+				Table      struct {
+					All []fotmobTableRow `json:"all"`
+				} `json:"table"`
+			} `json:"tables"`
+		} `json:"data"`
+	}
+	if len(t.Overview.Table) == 0 || json.Unmarshal(t.Overview.Table, &tables) != nil {
+		return "", 0
+	}
+
+	for _, table := range tables {
+		for _, row := range table.Data.Table.All {
+			if row.ID == t.Details.ID {
+				return table.Data.LeagueName, row.Idx
+			}
+		}
+		for _, sub := range table.Data.Tables {
+			for _, row := range sub.Table.All {
+				if row.ID == t.Details.ID {
+					return table.Data.LeagueName, row.Idx
+				}
+			}
+		}
+	}
+	return "", 0
+}
+
+// parseForm extracts the recent results in FotMob's order, oldest first.
+func (t fotmobTeamPage) parseForm() []api.FormResult {
+	var form []fotmobTeamForm
+	if len(t.Overview.TeamForm) == 0 || json.Unmarshal(t.Overview.TeamForm, &form) != nil {
+		return nil
+	}
+
+	results := make([]api.FormResult, 0, len(form))
+	for _, f := range form {
+		if f.ResultString == "" {
+			continue
+		}
+		home := parseInt(rawString(f.TooltipText.HomeTeamID)) == t.Details.ID
+		opponent := f.TooltipText.AwayTeam
+		if !home {
+			opponent = f.TooltipText.HomeTeam
+		}
+		results = append(results, api.FormResult{
+			Result:   strings.ToUpper(f.ResultString),
+			Score:    strings.ReplaceAll(f.Score, " ", ""),
+			Opponent: opponent,
+			Home:     home,
+		})
+	}
+	return results
+}
+
+// parseFixtures extracts the fixtures not yet finished, soonest first.
+func (t fotmobTeamPage) parseFixtures() []api.Match {
+	var fixtures struct {
+		AllFixtures struct {
+			Fixtures []fotmobTeamFixture `json:"fixtures"`
+		} `json:"allFixtures"`
+	}
+	if len(t.Fixtures) == 0 || json.Unmarshal(t.Fixtures, &fixtures) != nil {
+		return nil
+	}
+
+	var upcoming []api.Match
+	for _, f := range fixtures.AllFixtures.Fixtures {
+		match := fotmobMatch{
+			ID:     rawString(f.ID),
+			Home:   team{ID: rawString(f.Home.ID), Name: f.Home.Name, ShortName: f.Home.ShortName},
+			Away:   team{ID: rawString(f.Away.ID), Name: f.Away.Name, ShortName: f.Away.ShortName},
+			Status: f.Status.status,
+			League: league{ID: f.Tournament.LeagueID, Name: f.Tournament.Name},
+		}.toAPIMatch()
+		if match.Status == api.MatchStatusFinished || match.Status == api.MatchStatusCancelled || match.MatchTime == nil {
+			continue
+		}
+		var homeScore, awayScore int
+		if _, err := fmt.Sscanf(f.Status.ScoreStr, "%d - %d", &homeScore, &awayScore); err == nil {
+			match.HomeScore, match.AwayScore = &homeScore, &awayScore
+		}
+		upcoming = append(upcoming, match)
+	}
+
+	sort.SliceStable(upcoming, func(i, j int) bool { return upcoming[i].MatchTime.Before(*upcoming[j].MatchTime) })
+	if len(upcoming) > maxTeamFixtures {
+		upcoming = upcoming[:maxTeamFixtures]
+	}
+	return upcoming
+}
+
+// parseSquad extracts the squad sections in FotMob's order (coach, then goalkeepers to attackers).
+func (t fotmobTeamPage) parseSquad() []api.SquadGroup {
+	var squad struct {
+		Squad []struct {
+			Title   string              `json:"title"`
+			Members []fotmobSquadMember `json:"members"`
+		} `json:"squad"`
+	}
+	if len(t.Squad) == 0 || json.Unmarshal(t.Squad, &squad) != nil {
+		return nil
+	}
+
+	var groups []api.SquadGroup
+	for _, section := range squad.Squad {
+		if len(section.Members) == 0 {
+			continue
+		}
+		title, ok := squadGroupTitles[strings.ToLower(section.Title)]
+		if !ok {
+			title = section.Title
+		}
+		group := api.SquadGroup{Title: title, Members: make([]api.SquadMember, 0, len(section.Members))}
+		for _, m := range section.Members {
+			member := api.SquadMember{ID: m.ID, Name: m.Name, Country: m.CountryName, Age: m.Age}
+			if m.ShirtNumber != nil {
+				member.Number = *m.ShirtNumber
+			}
+			group.Members = append(group.Members, member)
+		}
+		groups = append(groups, group)
+	}
+	return groups
+}
+
+// rawString returns a JSON string or number as a string.
+func rawString(raw json.RawMessage) string {
+	return strings.Trim(string(raw), `"`)
+}
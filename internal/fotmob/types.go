@@ -3,6 +3,7 @@ package fotmob
 import (
 	"encoding/json"
 	"fmt"
+	"slices"
 	"sort"
 	"strconv"
 	"strings"
@@ -42,10 +43,101 @@ type status struct {
 	Cancelled *bool     `json:"cancelled"` // Can be null
 	LiveTime  *liveTime `json:"liveTime,omitempty"`
 	Score     *score    `json:"score,omitempty"`
+	Halfs     *halfs    `json:"halfs,omitempty"`  // Only in match details
+	Reason    *reason   `json:"reason,omitempty"` // How a finished match ended
+}
+
+// reason explains how a finished match ended, e.g. "Pen" after a penalty shootout.
+type reason struct {
+	Short     string `json:"short"`
+	Penalties []int  `json:"penalties,omitempty"` // Shootout score [home, away]
+}
+
+// shootout returns the penalty shootout score of the status, nil unless the match was
+// decided on penalties.
+func (s status) shootout() *api.Shootout {
+	if s.Reason == nil || len(s.Reason.Penalties) < 2 {
+		return nil
+	}
+	return &api.Shootout{HomeScore: s.Reason.Penalties[0], AwayScore: s.Reason.Penalties[1]}
+}
+
+// halfs holds when each half kicked off ("02.01.2006 15:04:05", UTC), empty until it does.
+type halfs struct {
+	FirstHalfStarted       string `json:"firstHalfStarted"`
+	SecondHalfStarted      string `json:"secondHalfStarted"`
+	FirstExtraHalfStarted  string `json:"firstExtraHalfStarted"`
+	SecondExtraHalfStarted string `json:"secondExtraHalfStarted"`
+}
+
+// eventTime returns when an event at minute plus added stoppage minutes happened: from
+// the kickoff of its half when FotMob reports it, else approximated from the match
+// kickoff with the usual breaks, else now.
+func (h *halfs) eventTime(kickoff *time.Time, minute, added int) time.Time {
+	var started string
+	var offset int // Minutes played before the half
+	if h != nil {
+		switch {
+		case minute <= 45:
+			started, offset = h.FirstHalfStarted, 0
+		case minute <= 90:
+			started, offset = h.SecondHalfStarted, 45
+		case minute <= 105:
+			started, offset = h.FirstExtraHalfStarted, 90
+		default:
+			started, offset = h.SecondExtraHalfStarted, 105
+		}
+	}
+	if t, err := time.Parse("02.01.2006 15:04:05", started); err == nil {
+		return t.Add(time.Duration(minute-offset+added) * time.Minute)
+	}
+
+	if kickoff == nil {
+		return time.Now()
+	}
+	elapsed := time.Duration(minute+added) * time.Minute
+	if minute > 45 {
+		elapsed += 15 * time.Minute // Half time
+	}
+	if minute > 90 {
+		elapsed += 5 * time.Minute // Break before extra time
+	}
+	return kickoff.Add(elapsed)
+}
+
+// addedMinutes returns the stoppage minutes of a FotMob time string ("45+2" is 2).
+func addedMinutes(timeStr string) int {
+	_, added, ok := strings.Cut(timeStr, "+")
+	if !ok {
+		return 0
+	}
+	n, err := strconv.Atoi(strings.TrimSpace(added))
+	if err != nil {
+		return 0
+	}
+	return n
 }
 
 type liveTime struct {
-	Short string `json:"short"`
+	Short     string `json:"short"`     // e.g. "67’", "45+3’", "HT"
+	MaxTime   int    `json:"maxTime"`   // End of the period being played: 45, 90, 105 or 120
+	AddedTime int    `json:"addedTime"` // Announced added time, 0 until shown
+}
+
+// clock returns the parsed live clock. Minutes past the end of the period are turned
+// into stoppage time ("92’" with maxTime 90 is 90+2').
+func (lt *liveTime) clock() *api.Clock {
+	clock := api.ParseClock(lt.Short)
+	if clock == nil {
+		return nil
+	}
+	if lt.MaxTime > 0 && clock.AddedTime == 0 && clock.Minute > lt.MaxTime {
+		clock = api.ParseClock(fmt.Sprintf("%d+%d", lt.MaxTime, clock.Minute-lt.MaxTime))
+	}
+	if clock.Minute > 0 {
+		clock.Announced = lt.AddedTime
+	}
+	return clock
 }
 
 type score struct {
@@ -104,6 +196,7 @@ func (m fotmobMatch) toAPIMatch() api.Match {
 		match.Status = api.MatchStatusLive
 		if m.Status.LiveTime != nil {
 			match.LiveTime = &m.Status.LiveTime.Short
+			match.Clock = m.Status.LiveTime.clock()
 		}
 	} else {
 		match.Status = api.MatchStatusNotStarted
@@ -114,6 +207,7 @@ func (m fotmobMatch) toAPIMatch() api.Match {
 		match.HomeScore = &m.Status.Score.Home
 		match.AwayScore = &m.Status.Score.Away
 	}
+	match.Shootout = m.Status.shootout()
 
 	return match
 }
@@ -148,7 +242,7 @@ type fotmobMatchDetails struct {
 		MatchFacts struct {
 			Events struct {
 				Events                []fotmobEventDetail `json:"events"`
-				PenaltyShootoutEvents any                 `json:"penaltyShootoutEvents,omitempty"`
+				PenaltyShootoutEvents json.RawMessage     `json:"penaltyShootoutEvents,omitempty"` // Decoded on its own by parseShootout
 			} `json:"events"`
 			Highlights *struct {
 				URL    string `json:"url"`
@@ -158,28 +252,76 @@ type fotmobMatchDetails struct {
 			InfoBox struct {
 				Stadium struct {
 					Name string `json:"name"`
+					City string `json:"city,omitempty"`
 				} `json:"Stadium,omitempty"`
 				Referee *struct {
 					Text string `json:"text"`
 				} `json:"Referee,omitempty"`
 				Attendance json.RawMessage `json:"Attendance,omitempty"` // Can be int or object
 			} `json:"infoBox,omitempty"`
+			PlayerOfTheMatch json.RawMessage `json:"playerOfTheMatch,omitempty"` // Empty object until awarded
 		} `json:"matchFacts"`
 		Stats struct {
 			Periods struct {
 				All struct {
 					Stats []fotmobStatCategory `json:"stats"`
 				} `json:"all,omitempty"`
+				FirstHalf struct {
+					Stats []fotmobStatCategory `json:"stats"`
+				} `json:"firstHalf,omitempty"`
 			} `json:"periods,omitempty"`
 		} `json:"stats,omitempty"`
 		Lineup struct {
-			Lineup   []fotmobTeamLineup `json:"lineup"`
-			HomeTeam *fotmobNewLineup   `json:"homeTeam,omitempty"`
-			AwayTeam *fotmobNewLineup   `json:"awayTeam,omitempty"`
+			Lineup     []fotmobTeamLineup `json:"lineup"`
+			HomeTeam   *fotmobNewLineup   `json:"homeTeam,omitempty"`
+			AwayTeam   *fotmobNewLineup   `json:"awayTeam,omitempty"`
+			LineupType string             `json:"lineupType,omitempty"` // "predicted" until the line-ups are announced
 		} `json:"lineup,omitempty"`
+		H2H     json.RawMessage `json:"h2h,omitempty"` // Decoded on its own so a format change cannot break the details
+		Shotmap struct {
+			Shots []fotmobShot `json:"shots"`
+		} `json:"shotmap,omitempty"`
+		Momentum json.RawMessage `json:"momentum,omitempty"` // false when FotMob has no momentum for the match
 	} `json:"content"`
 }
 
+// fotmobShot is a shot of the shotmap section.
+type fotmobShot struct {
+	EventType     string   `json:"eventType"` // "Goal", "AttemptSaved", "Miss" or "Post"
+	TeamID        int      `json:"teamId"`
+	PlayerName    string   `json:"playerName"`
+	X             float64  `json:"x"`
+	Y             float64  `json:"y"`
+	Min           int      `json:"min"`
+	IsBlocked     bool     `json:"isBlocked"`
+	IsOwnGoal     bool     `json:"isOwnGoal"`
+	ExpectedGoals *float64 `json:"expectedGoals"`
+}
+
+// fotmobH2HMatch is a previous meeting of the two teams in the h2h section.
+type fotmobH2HMatch struct {
+	MatchID json.RawMessage `json:"matchId"` // String or number
+	Time    struct {
+		UTCTime string `json:"utcTime"`
+	} `json:"time"`
+	League struct {
+		Name string `json:"name"`
+	} `json:"league"`
+	Home   fotmobH2HTeam `json:"home"`
+	Away   fotmobH2HTeam `json:"away"`
+	Status struct {
+		Finished  bool   `json:"finished"`
+		Cancelled bool   `json:"cancelled"`
+		ScoreStr  string `json:"scoreStr"` // e.g., "2 - 1"
+	} `json:"status"`
+}
+
+// fotmobH2HTeam is a team of a previous meeting.
+type fotmobH2HTeam struct {
+	ID   int    `json:"id"`
+	Name string `json:"name"`
+}
+
 // fotmobStatCategory represents a category of match statistics
 type fotmobStatCategory struct {
 	Title string           `json:"title"`
@@ -214,14 +356,19 @@ type fotmobNewLineup struct {
 	Formation string                `json:"formation"`
 	Starters  []fotmobNewPlayerInfo `json:"starters"`
 	Subs      []fotmobNewPlayerInfo `json:"subs,omitempty"`
+	Coach     *struct {
+		Name string `json:"name"`
+	} `json:"coach,omitempty"`
 }
 
 // fotmobNewPlayerInfo represents player info in the new lineup format
 type fotmobNewPlayerInfo struct {
-	ID          int    `json:"id"`
-	Name        string `json:"name"`
-	ShirtNumber string `json:"shirtNumber"`
-	Performance *struct {
+	ID                     int    `json:"id"`
+	Name                   string `json:"name"`
+	ShirtNumber            string `json:"shirtNumber"`
+	IsCaptain              bool   `json:"isCaptain,omitempty"`
+	UsualPlayingPositionID *int   `json:"usualPlayingPositionId,omitempty"` // 0 GK, 1 DF, 2 MF, 3 FW
+	Performance            *struct {
 		Rating       json.Number `json:"rating"`
 		FantasyScore string      `json:"fantasyScore,omitempty"`
 	} `json:"performance,omitempty"`
@@ -234,6 +381,7 @@ type fotmobPlayerInfo struct {
 	Shirt    int    `json:"shirt"`
 	Position string `json:"position,omitempty"`
 	Role     string `json:"role,omitempty"`
+	Captain  bool   `json:"isCaptain,omitempty"`
 	Rating   *struct {
 		Num string `json:"num"`
 	} `json:"rating,omitempty"`
@@ -265,9 +413,22 @@ type fotmobEventDetail struct {
 		Name string `json:"name"`
 		ID   string `json:"id"`
 	} `json:"swap,omitempty"` // For substitutions
-	AssistStr      string `json:"assistStr,omitempty"`
-	AssistInput    string `json:"assistInput,omitempty"`
-	AssistPlayerID *int   `json:"assistPlayerId,omitempty"`
+	AssistStr        string `json:"assistStr,omitempty"`
+	AssistInput      string `json:"assistInput,omitempty"`
+	AssistPlayerID   *int   `json:"assistPlayerId,omitempty"`
+	PenShootoutScore []int  `json:"penShootoutScore,omitempty"` // Shootout score after this kick [home, away]
+}
+
+// playerName returns the name of the event's player, "" when there is none.
+func (e fotmobEventDetail) playerName() string {
+	switch {
+	case e.Player != nil && e.Player.Name != "":
+		return e.Player.Name
+	case e.FullName != "":
+		return e.FullName
+	default:
+		return e.NameStr
+	}
 }
 
 // toAPIMatchDetails converts fotmobMatchDetails to api.MatchDetails
@@ -278,6 +439,7 @@ func (m fotmobMatchDetails) toAPIMatchDetails() *api.MatchDetails {
 	// Determine match status from header
 	var status api.MatchStatus
 	var liveTime *string
+	var clock *api.Clock
 	if m.Header.Status.Cancelled != nil && *m.Header.Status.Cancelled {
 		status = api.MatchStatusCancelled
 	} else if m.Header.Status.Finished != nil && *m.Header.Status.Finished {
@@ -286,6 +448,7 @@ func (m fotmobMatchDetails) toAPIMatchDetails() *api.MatchDetails {
 		status = api.MatchStatusLive
 		if m.Header.Status.LiveTime != nil {
 			liveTime = &m.Header.Status.LiveTime.Short
+			clock = m.Header.Status.LiveTime.clock()
 		}
 	} else {
 		status = api.MatchStatusNotStarted
@@ -323,6 +486,7 @@ func (m fotmobMatchDetails) toAPIMatchDetails() *api.MatchDetails {
 		},
 		Status:    status,
 		LiveTime:  liveTime,
+		Clock:     clock,
 		MatchTime: matchTime,
 		Round:     m.General.Round,
 	}
@@ -354,6 +518,7 @@ func (m fotmobMatchDetails) toAPIMatchDetails() *api.MatchDetails {
 	// Populate venue from infoBox
 	if m.Content.MatchFacts.InfoBox.Stadium.Name != "" {
 		details.Venue = m.Content.MatchFacts.InfoBox.Stadium.Name
+		details.VenueCity = m.Content.MatchFacts.InfoBox.Stadium.City
 	}
 
 	// Populate referee
@@ -361,7 +526,6 @@ func (m fotmobMatchDetails) toAPIMatchDetails() *api.MatchDetails {
 		details.Referee = m.Content.MatchFacts.InfoBox.Referee.Text
 	}
 
-	// Populate attendance
 	// Populate attendance (can be int or object with "number" field)
 	if len(m.Content.MatchFacts.InfoBox.Attendance) > 0 {
 		// Try to parse as int first
@@ -404,10 +568,18 @@ func (m fotmobMatchDetails) toAPIMatchDetails() *api.MatchDetails {
 	}
 
 	// Parse match statistics
-	details.Statistics = m.parseStatistics()
+	details.Statistics = parseStatistics(m.Content.Stats.Periods.All.Stats)
+	details.FirstHalfStatistics = parseStatistics(m.Content.Stats.Periods.FirstHalf.Stats)
+	details.Shots = m.parseShots()
+	details.HomeXG, details.AwayXG = expectedGoals(details)
+	details.Momentum = m.parseMomentum()
 
 	// Parse lineup information
 	m.parseLineups(details)
+	details.PlayerOfTheMatchID = m.parsePlayerOfTheMatch()
+
+	// Parse previous meetings
+	details.HeadToHead = m.parseHeadToHead(details.ID)
 
 	// Parse highlight video if available
 	if m.Content.MatchFacts.Highlights != nil {
@@ -418,25 +590,15 @@ func (m fotmobMatchDetails) toAPIMatchDetails() *api.MatchDetails {
 		}
 	}
 
-	// Parse penalty shootout results if available
-	if m.Content.MatchFacts.Events.PenaltyShootoutEvents != nil {
-		if penaltyEvents, ok := m.Content.MatchFacts.Events.PenaltyShootoutEvents.([]any); ok && len(penaltyEvents) > 0 {
-			// Get the final penalty scores from the last event
-			lastEvent := penaltyEvents[len(penaltyEvents)-1]
-			if eventMap, ok := lastEvent.(map[string]any); ok {
-				if penShootoutScore, exists := eventMap["penShootoutScore"].([]any); exists && len(penShootoutScore) >= 2 {
-					if homeScoreFloat, ok := penShootoutScore[0].(float64); ok {
-						homeScore := int(homeScoreFloat)
-						if awayScoreFloat, ok := penShootoutScore[1].(float64); ok {
-							awayScore := int(awayScoreFloat)
-							details.Penalties = &struct {
-								Home *int `json:"home,omitempty"`
-								Away *int `json:"away,omitempty"`
-							}{Home: &homeScore, Away: &awayScore}
-						}
-					}
-				}
-			}
+	// Parse the penalty shootout; its winner wins the match
+	details.Shootout = m.parseShootout()
+	if shootout := details.Shootout; shootout != nil && status == api.MatchStatusFinished && details.Winner == nil {
+		if shootout.HomeScore > shootout.AwayScore {
+			winner := "home"
+			details.Winner = &winner
+		} else if shootout.AwayScore > shootout.HomeScore {
+			winner := "away"
+			details.Winner = &winner
 		}
 	}
 
@@ -452,33 +614,27 @@ func (m fotmobMatchDetails) toAPIMatchDetails() *api.MatchDetails {
 		eventType := strings.ToLower(e.Type)
 
 		event := api.MatchEvent{
-			ID:        e.EventID,
-			Minute:    e.Time,
-			Type:      eventType,
-			Timestamp: time.Now(),
+			ID:     e.EventID,
+			Minute: e.Time,
+			Type:   eventType,
 		}
 
 		// Set display minute - use TimeStr if available (for stoppage time), otherwise format base minute
+		added := 0
 		if timeStrVal, ok := e.TimeStr.(string); ok && timeStrVal != "" {
 			// Clean up TimeStr to remove spaces around + sign (e.g., "45 + 2" -> "45+2")
 			cleanTimeStr := strings.ReplaceAll(timeStrVal, " + ", "+")
 			event.DisplayMinute = cleanTimeStr + "'"
+			added = addedMinutes(cleanTimeStr)
 		} else if timeStrInt, ok := e.TimeStr.(float64); ok && timeStrInt > 0 {
 			event.DisplayMinute = fmt.Sprintf("%.0f'", timeStrInt)
 		} else {
 			event.DisplayMinute = fmt.Sprintf("%d'", e.Time)
 		}
+		event.Timestamp = m.Header.Status.Halfs.eventTime(matchTime, e.Time, added)
 
 		// Extract player name
-		playerName := ""
-		if e.Player != nil && e.Player.Name != "" {
-			playerName = e.Player.Name
-		} else if e.FullName != "" {
-			playerName = e.FullName
-		} else if e.NameStr != "" {
-			playerName = e.NameStr
-		}
-		if playerName != "" {
+		if playerName := e.playerName(); playerName != "" {
 			event.Player = &playerName
 		}
 
@@ -560,11 +716,44 @@ func (m fotmobMatchDetails) toAPIMatchDetails() *api.MatchDetails {
 	return details
 }
 
-// parseStatistics extracts match statistics from FotMob response
-func (m fotmobMatchDetails) parseStatistics() []api.MatchStatistic {
+// parseShootout returns the penalty shootout, nil unless the match went to one. Kicks
+// come from penaltyShootoutEvents, decoded on their own so a format change cannot break
+// the details; the score from the last kick, else the header status, else the kicks.
+func (m fotmobMatchDetails) parseShootout() *api.Shootout {
+	fromStatus := m.Header.Status.shootout()
+	var kicks []fotmobEventDetail
+	if err := json.Unmarshal(m.Content.MatchFacts.Events.PenaltyShootoutEvents, &kicks); err != nil || len(kicks) == 0 {
+		return fromStatus
+	}
+
+	shootout := &api.Shootout{}
+	for _, kick := range kicks {
+		scored := kick.Type == "Goal"
+		shootout.Kicks = append(shootout.Kicks, api.ShootoutKick{
+			Home:   kick.IsHome,
+			Player: kick.playerName(),
+			Scored: scored,
+		})
+		switch {
+		case scored && kick.IsHome:
+			shootout.HomeScore++
+		case scored:
+			shootout.AwayScore++
+		}
+	}
+	if last := kicks[len(kicks)-1]; len(last.PenShootoutScore) >= 2 {
+		shootout.HomeScore, shootout.AwayScore = last.PenShootoutScore[0], last.PenShootoutScore[1]
+	} else if fromStatus != nil {
+		shootout.HomeScore, shootout.AwayScore = fromStatus.HomeScore, fromStatus.AwayScore
+	}
+	return shootout
+}
+
+// parseStatistics extracts the match statistics of a period from FotMob response
+func parseStatistics(categories []fotmobStatCategory) []api.MatchStatistic {
 	var stats []api.MatchStatistic
 
-	for _, category := range m.Content.Stats.Periods.All.Stats {
+	for _, category := range categories {
 		for _, stat := range category.Stats {
 			if len(stat.Stats) < 2 {
 				continue
@@ -591,6 +780,92 @@ func (m fotmobMatchDetails) parseStatistics() []api.MatchStatistic {
 	return stats
 }
 
+// parseShots extracts the shots of the shotmap, leaving out own goals.
+func (m fotmobMatchDetails) parseShots() []api.Shot {
+	var shots []api.Shot
+	for _, s := range m.Content.Shotmap.Shots {
+		if s.IsOwnGoal {
+			continue
+		}
+		outcome := "missed"
+		switch {
+		case s.EventType == "Goal":
+			outcome = "goal"
+		case s.IsBlocked:
+			outcome = "blocked"
+		case s.EventType == "AttemptSaved":
+			outcome = "saved"
+		case s.EventType == "Post":
+			outcome = "post"
+		}
+		shot := api.Shot{
+			Minute:  s.Min,
+			TeamID:  s.TeamID,
+			Player:  s.PlayerName,
+			Outcome: outcome,
+			X:       s.X,
+			Y:       s.Y,
+		}
+		if s.ExpectedGoals != nil {
+			shot.XG = *s.ExpectedGoals
+		}
+		shots = append(shots, shot)
+	}
+	sort.SliceStable(shots, func(i, j int) bool { return shots[i].Minute < shots[j].Minute })
+	return shots
+}
+
+// parseMomentum extracts the per-minute attacking momentum, positive for the home team.
+func (m fotmobMatchDetails) parseMomentum() []api.MomentumPoint {
+	var momentum struct {
+		Main struct {
+			Data []struct {
+				Minute float64 `json:"minute"`
+				Value  float64 `json:"value"`
+			} `json:"data"`
+		} `json:"main"`
+	}
+	if len(m.Content.Momentum) == 0 || json.Unmarshal(m.Content.Momentum, &momentum) != nil {
+		return nil
+	}
+
+	points := make([]api.MomentumPoint, 0, len(momentum.Main.Data))
+	for _, d := range momentum.Main.Data {
+		points = append(points, api.MomentumPoint{Minute: d.Minute, Value: max(min(d.Value, 100), -100)})
+	}
+	sort.SliceStable(points, func(i, j int) bool { return points[i].Minute < points[j].Minute })
+	return points
+}
+
+// expectedGoals returns the match xG of both teams: FotMob's own figure when the
+// statistics have one, otherwise the cumulative xG of the shots so far. Returns nil
+// values when neither is available. Adds the xG statistic when it was missing.
+func expectedGoals(details *api.MatchDetails) (home, away *float64) {
+	for _, stat := range details.Statistics {
+		if stat.Key != "expected_goals" {
+			continue
+		}
+		homeXG, errHome := strconv.ParseFloat(stat.HomeValue, 64)
+		awayXG, errAway := strconv.ParseFloat(stat.AwayValue, 64)
+		if errHome == nil && errAway == nil {
+			return &homeXG, &awayXG
+		}
+	}
+
+	if len(details.Shots) == 0 {
+		return nil, nil
+	}
+	homeXG := api.ShotsXG(details.Shots, details.HomeTeam.ID)
+	awayXG := api.ShotsXG(details.Shots, details.AwayTeam.ID)
+	details.Statistics = append(details.Statistics, api.MatchStatistic{
+		Key:       "expected_goals",
+		Label:     "Expected goals (xG)",
+		HomeValue: strconv.FormatFloat(homeXG, 'f', 2, 64),
+		AwayValue: strconv.FormatFloat(awayXG, 'f', 2, 64),
+	})
+	return &homeXG, &awayXG
+}
+
 // formatStatValue converts a stat value (can be int, float, or string) to string
 func formatStatValue(val any) string {
 	switch v := val.(type) {
@@ -609,76 +884,152 @@ func formatStatValue(val any) string {
 	}
 }
 
+// maxHeadToHead is the number of previous meetings kept.
+const maxHeadToHead = 10
+
+// parseHeadToHead extracts the most recent finished meetings, newest first,
+// skipping the match itself.
+func (m fotmobMatchDetails) parseHeadToHead(matchID int) []api.PastMeeting {
+	var h2h struct {
+		Matches []fotmobH2HMatch `json:"matches"`
+	}
+	if len(m.Content.H2H) == 0 || json.Unmarshal(m.Content.H2H, &h2h) != nil {
+		return nil
+	}
+
+	var meetings []api.PastMeeting
+	for _, h := range h2h.Matches {
+		if !h.Status.Finished || h.Status.Cancelled || strings.Trim(string(h.MatchID), `"`) == strconv.Itoa(matchID) {
+			continue
+		}
+		var homeScore, awayScore int
+		if _, err := fmt.Sscanf(h.Status.ScoreStr, "%d - %d", &homeScore, &awayScore); err != nil {
+			continue
+		}
+		date, _ := time.Parse(time.RFC3339, h.Time.UTCTime)
+		meetings = append(meetings, api.PastMeeting{
+			Date:      date,
+			League:    h.League.Name,
+			HomeTeam:  api.Team{ID: h.Home.ID, Name: h.Home.Name},
+			AwayTeam:  api.Team{ID: h.Away.ID, Name: h.Away.Name},
+			HomeScore: homeScore,
+			AwayScore: awayScore,
+		})
+	}
+
+	sort.SliceStable(meetings, func(i, j int) bool { return meetings[i].Date.After(meetings[j].Date) })
+	if len(meetings) > maxHeadToHead {
+		meetings = meetings[:maxHeadToHead]
+	}
+	return meetings
+}
+
 // parseLineups extracts lineup information from FotMob response
 // Supports both old format (lineup.lineup[]) and new format (lineup.homeTeam/awayTeam)
 func (m fotmobMatchDetails) parseLineups(details *api.MatchDetails) {
 	// Try new format first (homeTeam/awayTeam structure)
-	if m.Content.Lineup.HomeTeam != nil {
-		details.HomeFormation = m.Content.Lineup.HomeTeam.Formation
-		details.HomeStarting = convertNewLineupPlayers(m.Content.Lineup.HomeTeam.Starters)
-		details.HomeSubstitutes = convertNewLineupPlayers(m.Content.Lineup.HomeTeam.Subs)
-	}
-	if m.Content.Lineup.AwayTeam != nil {
-		details.AwayFormation = m.Content.Lineup.AwayTeam.Formation
-		details.AwayStarting = convertNewLineupPlayers(m.Content.Lineup.AwayTeam.Starters)
-		details.AwaySubstitutes = convertNewLineupPlayers(m.Content.Lineup.AwayTeam.Subs)
-	}
+	details.HomeLineup = convertNewLineup(m.Content.Lineup.HomeTeam)
+	details.AwayLineup = convertNewLineup(m.Content.Lineup.AwayTeam)
 
 	// If new format didn't provide data, try old format
-	if len(details.HomeStarting) == 0 && len(details.AwayStarting) == 0 {
+	if details.HomeLineup == nil && details.AwayLineup == nil {
 		for _, lineup := range m.Content.Lineup.Lineup {
-			isHome := lineup.TeamID == m.General.HomeTeam.ID
-
-			// Set formation
-			if isHome {
-				details.HomeFormation = lineup.Formation
+			if lineup.TeamID == m.General.HomeTeam.ID {
+				details.HomeLineup = convertLegacyLineup(lineup)
 			} else {
-				details.AwayFormation = lineup.Formation
+				details.AwayLineup = convertLegacyLineup(lineup)
 			}
+		}
+	}
 
-			// Extract starting players from the nested players array
-			var starting []api.PlayerInfo
-			for _, row := range lineup.Players {
-				for _, p := range row {
-					player := api.PlayerInfo{
-						ID:       p.ID,
-						Name:     p.Name,
-						Number:   p.Shirt,
-						Position: p.Position,
-					}
-					if p.Rating != nil {
-						player.Rating = p.Rating.Num
-					}
-					starting = append(starting, player)
-				}
-			}
+	// Before kickoff FotMob may list predicted line-ups, replaced by the announced ones
+	// about an hour before the match
+	details.LineupsPredicted = (details.HomeLineup != nil || details.AwayLineup != nil) && m.Content.Lineup.LineupType == "predicted"
 
-			// Extract substitutes
-			var substitutes []api.PlayerInfo
-			for _, p := range lineup.Bench {
-				player := api.PlayerInfo{
-					ID:       p.ID,
-					Name:     p.Name,
-					Number:   p.Shirt,
-					Position: p.Position,
-				}
-				if p.Rating != nil {
-					player.Rating = p.Rating.Num
-				}
-				substitutes = append(substitutes, player)
-			}
+	// Flat fields used by the formations dialog
+	if l := details.HomeLineup; l != nil {
+		details.HomeFormation = l.Formation
+		details.HomeStarting = l.Starting
+		details.HomeSubstitutes = l.Bench
+	}
+	if l := details.AwayLineup; l != nil {
+		details.AwayFormation = l.Formation
+		details.AwayStarting = l.Starting
+		details.AwaySubstitutes = l.Bench
+	}
+}
 
-			if isHome {
-				details.HomeStarting = starting
-				details.HomeSubstitutes = substitutes
-			} else {
-				details.AwayStarting = starting
-				details.AwaySubstitutes = substitutes
-			}
+// parsePlayerOfTheMatch returns the ID of the player of the match, or 0 when not awarded.
+func (m fotmobMatchDetails) parsePlayerOfTheMatch() int {
+	var potm struct {
+		ID int `json:"id"`
+	}
+	if len(m.Content.MatchFacts.PlayerOfTheMatch) == 0 || json.Unmarshal(m.Content.MatchFacts.PlayerOfTheMatch, &potm) != nil {
+		return 0
+	}
+	return potm.ID
+}
+
+// convertNewLineup converts a new format team lineup; nil when no starters are announced.
+func convertNewLineup(team *fotmobNewLineup) *api.Lineup {
+	if team == nil || len(team.Starters) == 0 {
+		return nil
+	}
+	lineup := &api.Lineup{
+		TeamID:    team.ID,
+		Formation: team.Formation,
+		Starting:  convertNewLineupPlayers(team.Starters),
+		Bench:     convertNewLineupPlayers(team.Subs),
+	}
+	if team.Coach != nil {
+		lineup.Coach = team.Coach.Name
+	}
+	return lineup
+}
+
+// convertLegacyLineup converts an old format team lineup; nil when no starters are announced.
+func convertLegacyLineup(team fotmobTeamLineup) *api.Lineup {
+	// Starting players are grouped by position rows
+	var starting []api.PlayerInfo
+	for _, row := range team.Players {
+		for _, p := range row {
+			starting = append(starting, convertLegacyPlayer(p))
 		}
 	}
+	if len(starting) == 0 {
+		return nil
+	}
+
+	bench := make([]api.PlayerInfo, 0, len(team.Bench))
+	for _, p := range team.Bench {
+		bench = append(bench, convertLegacyPlayer(p))
+	}
+	return &api.Lineup{
+		TeamID:    team.TeamID,
+		Formation: team.Formation,
+		Starting:  starting,
+		Bench:     bench,
+	}
 }
 
+// convertLegacyPlayer converts old format player info to API format
+func convertLegacyPlayer(p fotmobPlayerInfo) api.PlayerInfo {
+	player := api.PlayerInfo{
+		ID:       p.ID,
+		Name:     p.Name,
+		Number:   p.Shirt,
+		Position: p.Position,
+		Captain:  p.Captain,
+	}
+	if p.Rating != nil {
+		player.Rating = p.Rating.Num
+	}
+	return player
+}
+
+// playingPositions maps FotMob's usualPlayingPositionId to a position code.
+var playingPositions = map[int]string{0: "GK", 1: "DF", 2: "MF", 3: "FW"}
+
 // convertNewLineupPlayers converts new format player info to API format
 func convertNewLineupPlayers(players []fotmobNewPlayerInfo) []api.PlayerInfo {
 	result := make([]api.PlayerInfo, 0, len(players))
@@ -687,9 +1038,13 @@ func convertNewLineupPlayers(players []fotmobNewPlayerInfo) []api.PlayerInfo {
 		_, _ = fmt.Sscanf(p.ShirtNumber, "%d", &number)
 
 		player := api.PlayerInfo{
-			ID:     p.ID,
-			Name:   p.Name,
-			Number: number,
+			ID:      p.ID,
+			Name:    p.Name,
+			Number:  number,
+			Captain: p.IsCaptain,
+		}
+		if p.UsualPlayingPositionID != nil {
+			player.Position = playingPositions[*p.UsualPlayingPositionID]
 		}
 		if p.Performance != nil {
 			player.Rating = string(p.Performance.Rating)
@@ -713,6 +1068,14 @@ type fotmobTableRow struct {
 	ScoresStr   string `json:"scoresStr"`   // e.g., "42-17"
 	GoalConDiff int    `json:"goalConDiff"` // Goal difference
 	Pts         int    `json:"pts"`         // Points
+	QualColor   string `json:"qualColor"`   // Zone color, e.g. "#2AD572" (empty outside zones)
+}
+
+// fotmobTableLegend names a table zone and the row indices it covers.
+type fotmobTableLegend struct {
+	Title   string `json:"title"` // e.g., "Champions League", "Relegation"
+	Color   string `json:"color"`
+	Indices []int  `json:"indices"` // 0-based rows
 }
 
 // toAPITableEntry converts fotmobTableRow to api.LeagueTableEntry
@@ -736,9 +1099,33 @@ func (r fotmobTableRow) toAPITableEntry() api.LeagueTableEntry {
 		GoalsAgainst:   goalsAgainst,
 		GoalDifference: r.GoalConDiff,
 		Points:         r.Pts,
+		ZoneColor:      r.QualColor,
 	}
 }
 
+// tableEntries converts table rows, naming each row's zone from the table legend.
+func tableEntries(rows []fotmobTableRow, legend []fotmobTableLegend) []api.LeagueTableEntry {
+	zones := make(map[int]fotmobTableLegend)
+	for _, l := range legend {
+		for _, i := range l.Indices {
+			zones[i] = l
+		}
+	}
+
+	entries := make([]api.LeagueTableEntry, 0, len(rows))
+	for i, row := range rows {
+		entry := row.toAPITableEntry()
+		if zone, ok := zones[i]; ok {
+			entry.Zone = zone.Title
+			if entry.ZoneColor == "" {
+				entry.ZoneColor = zone.Color
+			}
+		}
+		entries = append(entries, entry)
+	}
+	return entries
+}
+
 // Helper function to parse int from string
 // Returns 0 if parsing fails (for required fields)
 func parseInt(s string) int {
@@ -751,3 +1138,71 @@ func parseInt(s string) int {
 	}
 	return val
 }
+
+// fotmobTVListing is the TV listing of a match in one country.
+type fotmobTVListing struct {
+	Stations []struct {
+		Name string `json:"name"`
+	} `json:"stations"`
+}
+
+// stations returns the station names in listing order, without blanks or duplicates.
+func (l fotmobTVListing) stations() []string {
+	var names []string
+	for _, s := range l.Stations {
+		name := strings.TrimSpace(s.Name)
+		if name != "" && !slices.Contains(names, name) {
+			names = append(names, name)
+		}
+	}
+	return names
+}
+
+// fotmobCommentary is the live text commentary ("ltc") of a match.
+type fotmobCommentary struct {
+	Events []struct {
+		Text string `json:"text"`
+		Type string `json:"type"` // e.g. "goal", "yellow_card", "substitution"; "" or "comment" for plain lines
+		Time struct {
+			Main  json.RawMessage `json:"main"`  // Minute, a number or a string
+			Added json.RawMessage `json:"added"` // Stoppage time minute, if any
+		} `json:"time"`
+	} `json:"events"`
+}
+
+// entries converts the commentary, keeping the feed's newest-first order and
+// dropping empty lines.
+func (c fotmobCommentary) entries() []api.CommentaryEntry {
+	entries := make([]api.CommentaryEntry, 0, len(c.Events))
+	for _, e := range c.Events {
+		text := strings.TrimSpace(e.Text)
+		if text == "" {
+			continue
+		}
+
+		var minute string
+		main := strings.Trim(string(e.Time.Main), `"`)
+		if main != "" && main != "null" && main != "0" {
+			minute = main
+			if added := strings.Trim(string(e.Time.Added), `"`); added != "" && added != "null" && added != "0" {
+				minute += "+" + strings.TrimPrefix(added, "+")
+			}
+			minute += "'"
+		}
+
+		eventType := strings.ToLower(e.Type)
+		switch {
+		case eventType == "comment":
+			eventType = ""
+		case strings.Contains(eventType, "card"):
+			eventType = "card"
+		case strings.Contains(eventType, "goal"):
+			eventType = "goal"
+		case strings.Contains(eventType, "sub"):
+			eventType = "substitution"
+		}
+
+		entries = append(entries, api.CommentaryEntry{Minute: minute, Type: eventType, Text: text})
+	}
+	return entries
+}
@@ -0,0 +1,48 @@
+package httpx
+
+import (
+	"bytes"
+	"compress/flate"
+	"compress/gzip"
+	"compress/zlib"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+)
+
+// ReadBody reads the response body. Requests made without an explicit Accept-Encoding
+// get gzip decoded by the transport and are returned as-is; gzip or deflate bodies a
+// server sends anyway are decoded here.
+func ReadBody(resp *http.Response) ([]byte, error) {
+	raw, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("read body: %w", err)
+	}
+	if resp.Uncompressed {
+		return raw, nil
+	}
+
+	switch strings.ToLower(strings.TrimSpace(resp.Header.Get("Content-Encoding"))) {
+	case "", "identity":
+		return raw, nil
+	case "gzip", "x-gzip":
+		r, err := gzip.NewReader(bytes.NewReader(raw))
+		if err != nil {
+			return nil, fmt.Errorf("decode gzip body: %w", err)
+		}
+		defer r.Close()
+		return io.ReadAll(r)
+	case "deflate":
+		// RFC 9110 deflate is zlib-wrapped, but some servers send raw DEFLATE
+		if r, err := zlib.NewReader(bytes.NewReader(raw)); err == nil {
+			defer r.Close()
+			return io.ReadAll(r)
+		}
+		r := flate.NewReader(bytes.NewReader(raw))
+		defer r.Close()
+		return io.ReadAll(r)
+	default:
+		return nil, fmt.Errorf("unsupported content encoding %q", resp.Header.Get("Content-Encoding"))
+	}
+}
@@ -0,0 +1,146 @@
+package httpx
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// ErrCircuitOpen is returned while a provider's circuit breaker is open.
+var ErrCircuitOpen = errors.New("circuit open")
+
+var (
+	breakersMu sync.Mutex
+	breakers   []*Breaker
+)
+
+// Breaker is a per-provider circuit breaker. It opens after Threshold consecutive
+// failures and rejects calls until Cooldown has passed, then lets a single probe
+// through (half-open). A successful probe closes the circuit; a failed one reopens it.
+type Breaker struct {
+	name      string
+	threshold int
+	cooldown  time.Duration
+
+	mu         sync.Mutex
+	failures   int
+	openedAt   time.Time // Zero while closed
+	probing    bool      // A half-open probe is in flight
+	generation uint64    // Incremented each time the circuit opens
+}
+
+// ticket identifies an admitted call: the generation it was admitted in and whether it
+// is the half-open probe. Outcomes of calls admitted before the circuit last opened are
+// ignored, so a slow call can neither close the circuit nor fail the probe.
+type ticket struct {
+	generation uint64
+	probe      bool
+}
+
+// NewBreaker creates a breaker for the named provider (e.g., "FotMob") and registers
+// it so OpenCircuits can report its state.
+func NewBreaker(name string, threshold int, cooldown time.Duration) *Breaker {
+	b := &Breaker{
+		name:      name,
+		threshold: max(threshold, 1),
+		cooldown:  cooldown,
+	}
+	breakersMu.Lock()
+	breakers = append(breakers, b)
+	breakersMu.Unlock()
+	return b
+}
+
+// Do runs fn unless the circuit is open, recording the outcome.
+// Returns an error wrapping ErrCircuitOpen when the call is rejected.
+func (b *Breaker) Do(fn func() error) error {
+	t, ok := b.allow()
+	if !ok {
+		return fmt.Errorf("%s: %w", b.name, ErrCircuitOpen)
+	}
+	err := fn()
+	b.record(t, err)
+	return err
+}
+
+// IsOpen reports whether the breaker is currently rejecting calls (open or half-open).
+func (b *Breaker) IsOpen() bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return !b.openedAt.IsZero()
+}
+
+// allow reports whether a call may proceed, returning its ticket for record.
+func (b *Breaker) allow() (ticket, bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.openedAt.IsZero() {
+		return ticket{generation: b.generation}, true
+	}
+	if b.probing || time.Since(b.openedAt) < b.cooldown {
+		return ticket{}, false
+	}
+	b.probing = true
+	return ticket{generation: b.generation, probe: true}, true
+}
+
+// record updates the breaker with the result of the call admitted with t.
+func (b *Breaker) record(t ticket, err error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if t.generation != b.generation {
+		return // Admitted before the circuit last opened
+	}
+	if t.probe {
+		b.probing = false
+	}
+
+	if !isProviderFailure(err) {
+		b.failures = 0
+		b.openedAt = time.Time{}
+		return
+	}
+
+	b.failures++
+	if t.probe || b.failures >= b.threshold {
+		b.open()
+	}
+}
+
+// open opens the circuit, starting a new generation. Must be called with mu held.
+func (b *Breaker) open() {
+	b.openedAt = time.Now()
+	b.generation++
+}
+
+// isProviderFailure reports whether err indicates the provider is unhealthy.
+// Client-side cancellation and ordinary 4xx responses (other than 429) don't count.
+func isProviderFailure(err error) bool {
+	if err == nil || errors.Is(err, context.Canceled) {
+		return false
+	}
+	var statusErr *StatusError
+	if errors.As(err, &statusErr) {
+		return statusErr.StatusCode == http.StatusTooManyRequests || statusErr.StatusCode >= 500
+	}
+	return true
+}
+
+// OpenCircuits returns the names of providers whose circuit is currently open.
+func OpenCircuits() []string {
+	breakersMu.Lock()
+	defer breakersMu.Unlock()
+
+	var names []string
+	for _, b := range breakers {
+		if b.IsOpen() {
+			names = append(names, b.name)
+		}
+	}
+	return names
+}
@@ -0,0 +1,75 @@
+package httpx
+
+import (
+	"io"
+	"math/rand/v2"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Chaos configures fault injection for development (--chaos): artificial latency,
+// synthetic 429/500 responses and malformed JSON bodies, to exercise the retry,
+// circuit breaker and degradation paths.
+type Chaos struct {
+	MaxLatency    time.Duration // Each request is delayed by a random duration up to this
+	ErrorRate     float64       // Share of requests answered with a synthetic 429 or 500 (0-1)
+	MalformedRate float64       // Share of successful responses whose body is replaced with broken JSON (0-1)
+}
+
+// Enabled reports whether any fault is injected.
+func (c Chaos) Enabled() bool {
+	return c.MaxLatency > 0 || c.ErrorRate > 0 || c.MalformedRate > 0
+}
+
+// ChaosTransport wraps an http.RoundTripper with fault injection.
+type ChaosTransport struct {
+	Base  http.RoundTripper
+	Chaos Chaos
+}
+
+// RoundTrip delays the request, then either fails it with a synthetic status or
+// sends it and possibly corrupts the response body.
+func (t *ChaosTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if t.Chaos.MaxLatency > 0 {
+		delay := rand.N(t.Chaos.MaxLatency)
+		select {
+		case <-time.After(delay):
+		case <-req.Context().Done():
+			return nil, req.Context().Err()
+		}
+	}
+
+	if rand.Float64() < t.Chaos.ErrorRate {
+		status := http.StatusInternalServerError
+		if rand.IntN(2) == 0 {
+			status = http.StatusTooManyRequests
+		}
+		return chaosResponse(req, status, "chaos: injected "+http.StatusText(status)), nil
+	}
+
+	resp, err := t.Base.RoundTrip(req)
+	if err != nil || resp.StatusCode != http.StatusOK || rand.Float64() >= t.Chaos.MalformedRate {
+		return resp, err
+	}
+	_ = resp.Body.Close()
+	malformed := chaosResponse(req, http.StatusOK, `{"chaos": "truncated`)
+	malformed.Header.Set("Content-Type", "application/json")
+	return malformed, nil
+}
+
+// chaosResponse builds an uncompressed synthetic response.
+func chaosResponse(req *http.Request, status int, body string) *http.Response {
+	return &http.Response{
+		Status:        strconv.Itoa(status) + " " + http.StatusText(status),
+		StatusCode:    status,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        make(http.Header),
+		Body:          io.NopCloser(strings.NewReader(body)),
+		ContentLength: int64(len(body)),
+		Request:       req,
+	}
+}
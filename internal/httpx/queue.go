@@ -0,0 +1,118 @@
+package httpx
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// Queue staggers a burst of requests: while open, callers are released one at a time,
+// spacing apart, most urgent (lowest priority value) first. Once the window closes,
+// Wait returns immediately and everyone still queued is released.
+type Queue struct {
+	mu      sync.Mutex
+	spacing time.Duration
+	until   time.Time // Queue is open until this time
+	next    time.Time // Earliest time the next caller may be released
+	waiting []*queued
+	seq     int
+	timer   *time.Timer
+}
+
+// queued is a caller blocked in Wait.
+type queued struct {
+	priority int
+	seq      int // Arrival order, breaks priority ties
+	ready    chan struct{}
+}
+
+// NewQueue creates a closed queue releasing callers spacing apart while open.
+func NewQueue(spacing time.Duration) *Queue {
+	return &Queue{spacing: spacing}
+}
+
+// Open gates callers through the queue for the next window.
+func (q *Queue) Open(window time.Duration) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	q.until = time.Now().Add(window)
+}
+
+// Wait blocks until the caller's turn, the queue closes or ctx is done.
+func (q *Queue) Wait(ctx context.Context, priority int) error {
+	q.mu.Lock()
+	now := time.Now()
+	if !now.Before(q.until) {
+		q.mu.Unlock()
+		return nil
+	}
+	if len(q.waiting) == 0 && !now.Before(q.next) {
+		q.next = now.Add(q.spacing)
+		q.mu.Unlock()
+		return nil
+	}
+
+	q.seq++
+	w := &queued{priority: priority, seq: q.seq, ready: make(chan struct{})}
+	q.waiting = append(q.waiting, w)
+	q.schedule(now)
+	q.mu.Unlock()
+
+	select {
+	case <-w.ready:
+		return nil
+	case <-ctx.Done():
+		q.mu.Lock()
+		q.remove(w)
+		q.mu.Unlock()
+		return ctx.Err()
+	}
+}
+
+// release lets the most urgent caller through, or everyone once the queue has closed.
+func (q *Queue) release() {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	q.timer = nil
+
+	now := time.Now()
+	if !now.Before(q.until) {
+		for _, w := range q.waiting {
+			close(w.ready)
+		}
+		q.waiting = nil
+		return
+	}
+	if len(q.waiting) == 0 {
+		return
+	}
+
+	best := q.waiting[0]
+	for _, w := range q.waiting[1:] {
+		if w.priority < best.priority || (w.priority == best.priority && w.seq < best.seq) {
+			best = w
+		}
+	}
+	q.remove(best)
+	close(best.ready)
+	q.next = now.Add(q.spacing)
+	q.schedule(now)
+}
+
+// schedule arms the release timer when callers are waiting and none is armed.
+func (q *Queue) schedule(now time.Time) {
+	if q.timer != nil || len(q.waiting) == 0 {
+		return
+	}
+	q.timer = time.AfterFunc(max(q.next.Sub(now), 0), q.release)
+}
+
+// remove drops w from the waiting callers.
+func (q *Queue) remove(w *queued) {
+	for i, other := range q.waiting {
+		if other == w {
+			q.waiting = append(q.waiting[:i], q.waiting[i+1:]...)
+			return
+		}
+	}
+}
@@ -0,0 +1,87 @@
+// Package httpx provides shared HTTP helpers for the API clients.
+package httpx
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand/v2"
+	"net"
+	"net/http"
+	"time"
+)
+
+// Policy configures retries with exponential backoff and jitter.
+type Policy struct {
+	MaxAttempts int              // Total attempts including the first (values < 1 mean 1)
+	BaseDelay   time.Duration    // Delay before the second attempt; doubles each retry
+	MaxDelay    time.Duration    // Upper bound on a single delay (0 = no bound)
+	Jitter      float64          // Random spread as a fraction of the delay (0.2 = ±20%)
+	Retryable   func(error) bool // Classifies errors; nil uses IsRetryable
+}
+
+// StatusError is returned for unexpected HTTP status codes.
+type StatusError struct {
+	StatusCode int
+}
+
+func (e *StatusError) Error() string {
+	return fmt.Sprintf("unexpected status code %d", e.StatusCode)
+}
+
+// Retry calls fn until it succeeds, returns a non-retryable error, attempts run out,
+// or ctx is done. The last error is returned.
+func Retry(ctx context.Context, p Policy, fn func() error) error {
+	retryable := p.Retryable
+	if retryable == nil {
+		retryable = IsRetryable
+	}
+
+	var err error
+	for attempt := 0; attempt < max(p.MaxAttempts, 1); attempt++ {
+		if attempt > 0 {
+			timer := time.NewTimer(p.Delay(attempt))
+			select {
+			case <-ctx.Done():
+				timer.Stop()
+				return err
+			case <-timer.C:
+			}
+		}
+
+		if err = fn(); err == nil || !retryable(err) {
+			return err
+		}
+	}
+	return err
+}
+
+// Delay returns the jittered backoff before the given retry (1 = first retry).
+func (p Policy) Delay(retry int) time.Duration {
+	delay := p.BaseDelay << (retry - 1)
+	if p.MaxDelay > 0 && (delay > p.MaxDelay || delay <= 0) {
+		delay = p.MaxDelay
+	}
+	if p.Jitter > 0 {
+		spread := float64(delay) * p.Jitter
+		delay += time.Duration((rand.Float64()*2 - 1) * spread)
+	}
+	return max(delay, 0)
+}
+
+// IsRetryable reports whether err is likely transient: network errors and timeouts,
+// 429 Too Many Requests, and 5xx responses. Context cancellation and other
+// status codes are not retried.
+func IsRetryable(err error) bool {
+	if err == nil || errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+
+	var statusErr *StatusError
+	if errors.As(err, &statusErr) {
+		return statusErr.StatusCode == http.StatusTooManyRequests || statusErr.StatusCode >= 500
+	}
+
+	var netErr net.Error
+	return errors.As(err, &netErr)
+}
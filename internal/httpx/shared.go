@@ -0,0 +1,83 @@
+package httpx
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// Shared coalesces concurrent calls with the same key into one, like singleflight, but
+// the call runs on its own context that is cancelled once every caller waiting for it has
+// given up. One caller leaving doesn't fail the others, and a call nobody waits for any
+// more stops instead of running to completion. The zero value is ready to use.
+type Shared struct {
+	mu    sync.Mutex
+	calls map[string]*sharedCall
+}
+
+// sharedCall is a call in flight and the callers waiting for it.
+type sharedCall struct {
+	waiters int
+	cancel  context.CancelFunc
+	done    chan struct{}
+	val     any
+	err     error
+}
+
+// Do runs fn for key unless a call for key is already in flight, then waits for its
+// result until ctx is done. fn's context carries ctx's values, is bounded by timeout and
+// is cancelled when the last waiting caller leaves.
+func (g *Shared) Do(ctx context.Context, key string, timeout time.Duration, fn func(ctx context.Context) (any, error)) (any, error) {
+	g.mu.Lock()
+	if g.calls == nil {
+		g.calls = make(map[string]*sharedCall)
+	}
+	call, ok := g.calls[key]
+	if !ok {
+		callCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), timeout)
+		call = &sharedCall{cancel: cancel, done: make(chan struct{})}
+		g.calls[key] = call
+		go g.run(callCtx, key, call, fn)
+	}
+	call.waiters++
+	g.mu.Unlock()
+
+	select {
+	case <-call.done:
+		return call.val, call.err
+	case <-ctx.Done():
+		g.leave(key, call)
+		return nil, ctx.Err()
+	}
+}
+
+// run runs a call and publishes its result.
+func (g *Shared) run(ctx context.Context, key string, call *sharedCall, fn func(ctx context.Context) (any, error)) {
+	defer call.cancel()
+	call.val, call.err = fn(ctx)
+
+	g.mu.Lock()
+	g.forget(key, call)
+	g.mu.Unlock()
+	close(call.done)
+}
+
+// leave removes a waiter, cancelling the call when it was the last one. The abandoned
+// call is forgotten right away, so a new caller starts a fresh call instead of joining
+// one that is being cancelled.
+func (g *Shared) leave(key string, call *sharedCall) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	call.waiters--
+	if call.waiters == 0 {
+		call.cancel()
+		g.forget(key, call)
+	}
+}
+
+// forget removes call from the calls in flight, unless a newer call for key replaced it.
+func (g *Shared) forget(key string, call *sharedCall) {
+	if g.calls[key] == call {
+		delete(g.calls, key)
+	}
+}
@@ -0,0 +1,99 @@
+package httpx
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestSharedCancelsAfterLastWaiter(t *testing.T) {
+	var g Shared
+	started := make(chan struct{})
+	cancelled := make(chan struct{})
+	fn := func(ctx context.Context) (any, error) {
+		close(started)
+		<-ctx.Done()
+		close(cancelled)
+		return nil, ctx.Err()
+	}
+
+	first, cancelFirst := context.WithCancel(t.Context())
+	second, cancelSecond := context.WithCancel(t.Context())
+	errs := make(chan error, 2)
+	go func() { _, err := g.Do(first, "k", time.Minute, fn); errs <- err }()
+	<-started
+	go func() { _, err := g.Do(second, "k", time.Minute, fn); errs <- err }()
+
+	// Wait for the second caller to join the call
+	for {
+		g.mu.Lock()
+		waiters := g.calls["k"].waiters
+		g.mu.Unlock()
+		if waiters == 2 {
+			break
+		}
+		time.Sleep(time.Millisecond)
+	}
+
+	cancelFirst()
+	if err := <-errs; !errors.Is(err, context.Canceled) {
+		t.Fatalf("first caller got %v; want context.Canceled", err)
+	}
+	select {
+	case <-cancelled:
+		t.Fatal("call cancelled while a caller was still waiting")
+	case <-time.After(20 * time.Millisecond):
+	}
+
+	cancelSecond()
+	<-errs
+	select {
+	case <-cancelled:
+	case <-time.After(time.Second):
+		t.Fatal("call not cancelled after the last caller left")
+	}
+}
+
+func TestSharedCoalesces(t *testing.T) {
+	var g Shared
+	release := make(chan struct{})
+	calls := 0
+	fn := func(ctx context.Context) (any, error) {
+		calls++
+		<-release
+		return "body", nil
+	}
+
+	results := make(chan any, 2)
+	go func() { v, _ := g.Do(t.Context(), "k", time.Minute, fn); results <- v }()
+	for {
+		g.mu.Lock()
+		_, running := g.calls["k"]
+		g.mu.Unlock()
+		if running {
+			break
+		}
+		time.Sleep(time.Millisecond)
+	}
+	go func() { v, _ := g.Do(t.Context(), "k", time.Minute, fn); results <- v }()
+	for {
+		g.mu.Lock()
+		waiters := g.calls["k"].waiters
+		g.mu.Unlock()
+		if waiters == 2 {
+			break
+		}
+		time.Sleep(time.Millisecond)
+	}
+	close(release)
+
+	for range 2 {
+		if v := <-results; v != "body" {
+			t.Errorf("result = %v; want body", v)
+		}
+	}
+	if calls != 1 {
+		t.Errorf("fn ran %d times; want 1", calls)
+	}
+}
@@ -0,0 +1,183 @@
+package notify
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"runtime"
+	"strings"
+	"sync"
+
+	"github.com/0xjuanma/golazo/internal/api"
+	"github.com/0xjuanma/golazo/internal/constants"
+	"github.com/gen2brain/beeep"
+)
+
+// Action IDs offered on kickoff reminders.
+const (
+	ActionSnooze   = "snooze"
+	ActionHalfTime = "halftime"
+)
+
+// Action is a button shown on a notification.
+type Action struct {
+	ID    string
+	Label string
+}
+
+// ReminderActions are the buttons offered on kickoff reminders.
+var ReminderActions = []Action{
+	{ID: ActionSnooze, Label: "Snooze 10m"},
+	{ID: ActionHalfTime, Label: "Notify at HT"},
+}
+
+var (
+	actionBackend     string // "notify-send", "alerter" or "" (no button support)
+	actionBackendOnce sync.Once
+)
+
+// detectActionBackend finds a notification tool that supports buttons:
+// notify-send with --action (libnotify 0.7.10+) on Linux, alerter on macOS.
+func detectActionBackend() string {
+	actionBackendOnce.Do(func() {
+		switch runtime.GOOS {
+		case "linux", "freebsd", "openbsd", "netbsd":
+			if _, err := exec.LookPath("notify-send"); err != nil {
+				return
+			}
+			help, _ := exec.Command("notify-send", "--help").CombinedOutput()
+			if strings.Contains(string(help), "--action") {
+				actionBackend = "notify-send"
+			}
+		case "darwin":
+			if _, err := exec.LookPath("alerter"); err == nil {
+				actionBackend = "alerter"
+			}
+		}
+	})
+	return actionBackend
+}
+
+// ActionsSupported reports whether notifications on this system can show buttons.
+func ActionsSupported() bool {
+	return detectActionBackend() != ""
+}
+
+// NotifyWithActions shows a notification with buttons and blocks until the user clicks
+// one or dismisses it, returning the chosen action ID ("" when dismissed).
+// Without button support a plain notification is shown and "" is returned immediately,
+// so callers should run this off the UI goroutine and treat "" as "no action".
+func (n *DesktopNotifier) NotifyWithActions(title, message string, actions []Action) (string, error) {
+	if !n.enabled {
+		return "", nil
+	}
+
+	// Play terminal beep via stderr (bypasses bubbletea's stdout capture)
+	_, _ = os.Stderr.WriteString("\a")
+
+	switch detectActionBackend() {
+	case "notify-send":
+		args := []string{"--app-name=golazo", "--wait"}
+		if icon := getIconPath(); icon != "" {
+			args = append(args, "--icon="+icon)
+		}
+		for _, a := range actions {
+			args = append(args, fmt.Sprintf("--action=%s=%s", a.ID, a.Label))
+		}
+		args = append(args, title, message)
+		out, err := exec.Command("notify-send", args...).Output()
+		if err != nil {
+			return "", fmt.Errorf("notify-send: %w", err)
+		}
+		return matchAction(strings.TrimSpace(string(out)), actions), nil
+
+	case "alerter":
+		labels := make([]string, len(actions))
+		for i, a := range actions {
+			labels[i] = a.Label
+		}
+		out, err := exec.Command("alerter",
+			"-title", title,
+			"-message", message,
+			"-actions", strings.Join(labels, ","),
+			"-timeout", "300",
+		).Output()
+		if err != nil {
+			return "", fmt.Errorf("alerter: %w", err)
+		}
+		// alerter prints the clicked label, or @TIMEOUT/@CLOSED/@CONTENTCLICKED
+		return matchAction(strings.TrimSpace(string(out)), actions), nil
+
+	default:
+		// Graceful degradation: plain notification without buttons
+		_ = beeep.Notify(title, message, getIconPath())
+		return "", nil
+	}
+}
+
+// matchAction maps backend output (an action ID or label) to an action ID.
+func matchAction(output string, actions []Action) string {
+	for _, a := range actions {
+		if output == a.ID || output == a.Label {
+			return a.ID
+		}
+	}
+	return ""
+}
+
+// Reminder sends a kickoff reminder with snooze/half-time buttons where supported.
+// Blocks until the user responds; returns the chosen action ID ("" for none).
+func (n *DesktopNotifier) Reminder(match api.Match) (string, error) {
+	kickoff := "soon"
+	if match.MatchTime != nil {
+		kickoff = "at " + match.MatchTime.Local().Format("15:04")
+	}
+	message := fmt.Sprintf("%s vs %s kicks off %s\n%s",
+		teamName(match.HomeTeam), teamName(match.AwayTeam), kickoff, match.League.Name)
+	return n.NotifyWithActions(constants.NotificationTitleKickoff, message, ReminderActions)
+}
+
+// HalfTime sends a plain half-time score notification.
+func (n *DesktopNotifier) HalfTime(details *api.MatchDetails) error {
+	if !n.enabled || details == nil {
+		return nil
+	}
+
+	_, _ = os.Stderr.WriteString("\a")
+
+	homeScore, awayScore := 0, 0
+	if details.HomeScore != nil {
+		homeScore = *details.HomeScore
+	}
+	if details.AwayScore != nil {
+		awayScore = *details.AwayScore
+	}
+	message := fmt.Sprintf("%s %d - %d %s",
+		teamName(details.HomeTeam), homeScore, awayScore, teamName(details.AwayTeam))
+	_ = beeep.Notify(constants.NotificationTitleHalfTime, message, getIconPath())
+	return nil
+}
+
+// Lineups sends a plain notification that a match's confirmed line-ups are out.
+func (n *DesktopNotifier) Lineups(details *api.MatchDetails) error {
+	if !n.enabled || details == nil {
+		return nil
+	}
+
+	_, _ = os.Stderr.WriteString("\a")
+
+	message := fmt.Sprintf("%s vs %s: confirmed line-ups are out", teamName(details.HomeTeam), teamName(details.AwayTeam))
+	if details.HomeFormation != "" && details.AwayFormation != "" {
+		message += fmt.Sprintf("\n%s vs %s", details.HomeFormation, details.AwayFormation)
+	}
+	_ = beeep.Notify(constants.NotificationTitleLineups, message, getIconPath())
+	return nil
+}
+
+// teamName returns the short team name, falling back to the full name.
+func teamName(team api.Team) string {
+	if team.ShortName != "" {
+		return team.ShortName
+	}
+	return team.Name
+}
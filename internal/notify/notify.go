@@ -96,6 +96,17 @@ func (n *DesktopNotifier) Goal(event api.MatchEvent, homeTeam, awayTeam api.Team
 	return nil
 }
 
+// Alert sends a plain notification, such as a milestone alert.
+func (n *DesktopNotifier) Alert(title, message string) error {
+	if !n.enabled {
+		return nil
+	}
+
+	_, _ = os.Stderr.WriteString("\a")
+	_ = beeep.Notify(title, message, getIconPath())
+	return nil
+}
+
 // formatGoalMessage creates the notification message for a goal.
 // Format: "Scorer (Team) 34' | Home 2-1 Away"
 func formatGoalMessage(event api.MatchEvent, homeTeam, awayTeam api.Team, homeScore, awayScore int) string {
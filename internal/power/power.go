@@ -0,0 +1,116 @@
+// Package power detects whether the machine is running on battery power.
+package power
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// checkInterval is how often the power source is queried in the background; detection
+// may run a system tool taking up to a second.
+const checkInterval = time.Minute
+
+var (
+	onBattery   atomic.Bool
+	monitorOnce sync.Once
+)
+
+// OnBattery reports whether the machine is running on battery power.
+// Uses sysfs or upower on Linux, pmset (IOKit) on macOS and WMI on Windows.
+// Returns false on desktops, unsupported systems or when detection fails.
+//
+// It never blocks: the first call starts a goroutine that detects the power source right
+// away and then every checkInterval, and calls return its latest result (false until the
+// first detection is done).
+func OnBattery() bool {
+	monitorOnce.Do(func() { go monitor() })
+	return onBattery.Load()
+}
+
+// monitor keeps onBattery up to date for the rest of the process.
+func monitor() {
+	ticker := time.NewTicker(checkInterval)
+	defer ticker.Stop()
+	for {
+		onBattery.Store(detect())
+		<-ticker.C
+	}
+}
+
+// detect queries the platform's power source.
+func detect() bool {
+	switch runtime.GOOS {
+	case "linux":
+		if battery, ok := detectSysfs("/sys/class/power_supply"); ok {
+			return battery
+		}
+		return detectUpower()
+	case "darwin":
+		// pmset reports the IOKit power source, e.g. "Now drawing from 'Battery Power'"
+		out, err := exec.Command("pmset", "-g", "batt").Output()
+		return err == nil && strings.Contains(string(out), "'Battery Power'")
+	case "windows":
+		// Win32_Battery.BatteryStatus 1 means discharging
+		out, err := exec.Command("powershell", "-NoProfile", "-Command",
+			"(Get-CimInstance -ClassName Win32_Battery).BatteryStatus").Output()
+		return err == nil && strings.TrimSpace(string(out)) == "1"
+	default:
+		return false
+	}
+}
+
+// detectSysfs inspects Linux power supplies: on battery when a battery is present
+// and no mains/USB supply is online. ok is false when no battery is found.
+func detectSysfs(dir string) (battery, ok bool) {
+	supplies, err := os.ReadDir(dir)
+	if err != nil {
+		return false, false
+	}
+
+	hasBattery := false
+	for _, supply := range supplies {
+		path := filepath.Join(dir, supply.Name())
+		switch readTrimmed(filepath.Join(path, "type")) {
+		case "Battery":
+			// Peripheral batteries (mice, headsets) report scope "Device"
+			if readTrimmed(filepath.Join(path, "scope")) != "Device" {
+				hasBattery = true
+			}
+		case "Mains", "USB":
+			if readTrimmed(filepath.Join(path, "online")) == "1" {
+				return false, true
+			}
+		}
+	}
+	return hasBattery, hasBattery
+}
+
+// detectUpower asks UPower whether the system runs on battery.
+func detectUpower() bool {
+	out, err := exec.Command("upower", "-d").Output()
+	if err != nil {
+		return false
+	}
+	for line := range strings.SplitSeq(string(out), "\n") {
+		key, value, found := strings.Cut(line, ":")
+		if found && strings.TrimSpace(key) == "on-battery" {
+			return strings.TrimSpace(value) == "yes"
+		}
+	}
+	return false
+}
+
+// readTrimmed returns a sysfs attribute without surrounding whitespace ("" on error).
+func readTrimmed(path string) string {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return ""
+	}
+	return strings.TrimSpace(string(content))
+}
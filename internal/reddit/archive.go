@@ -0,0 +1,166 @@
+package reddit
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/0xjuanma/golazo/internal/data"
+	"github.com/0xjuanma/golazo/internal/httpx"
+	"github.com/0xjuanma/golazo/internal/trace"
+)
+
+const (
+	// archiveHost serves the Arctic Shift Reddit archive API.
+	archiveHost = "arctic-shift.photon-reddit.com"
+	// ArchiveAfter is the match age after which goal links are looked up in the archive.
+	// Reddit's own search ranks older posts poorly.
+	ArchiveAfter = 3 * 24 * time.Hour
+)
+
+// archiveBreaker stops querying the archive after repeated failures.
+var archiveBreaker = httpx.NewBreaker("Reddit archive", 3, 2*time.Minute)
+
+// ArchiveFetcher searches r/soccer posts through the Arctic Shift archive API.
+// It indexes posts by creation time, so old goal clips are found as easily as new ones.
+type ArchiveFetcher struct {
+	httpClient  *http.Client
+	baseURL     string
+	rateLimiter *httpx.Limiter
+}
+
+// archiveSearchResponse is the Arctic Shift post search response.
+// Posts use Reddit's own JSON shape.
+type archiveSearchResponse struct {
+	Data  []redditPost `json:"data"`
+	Error string       `json:"error"`
+}
+
+// NewArchiveFetcher creates a fetcher for the Arctic Shift archive.
+func NewArchiveFetcher() *ArchiveFetcher {
+	return &ArchiveFetcher{
+		httpClient:  data.NewHTTPClient(15 * time.Second),
+		baseURL:     "https://" + archiveHost + "/api/posts/search",
+		rateLimiter: httpx.LimiterFor(archiveHost, 1, 2), // 1 request/s, bursts of 2
+	}
+}
+
+// Search finds Media posts in r/soccer whose titles match query, created within
+// 12 hours of matchTime. The archive has no relevance ranking, so sort only picks
+// the order: "new" returns newest first, anything else oldest first (goal order).
+func (f *ArchiveFetcher) Search(ctx context.Context, query string, limit int, matchTime time.Time, window SearchWindow, sort string) ([]SearchResult, error) {
+	params := url.Values{}
+	params.Set("subreddit", "soccer")
+	params.Set("title", strings.ReplaceAll(query, "'", "")) // Full-text search ignores minute marks
+	params.Set("after", fmt.Sprint(matchTime.Add(-window.Before).Unix()))
+	params.Set("before", fmt.Sprint(matchTime.Add(window.After).Unix()))
+	params.Set("limit", fmt.Sprint(min(max(limit, 1), 100)))
+	if sort == "new" {
+		params.Set("sort", "desc")
+	} else {
+		params.Set("sort", "asc")
+	}
+
+	var resp archiveSearchResponse
+	err := archiveBreaker.Do(func() error {
+		return f.getJSON(ctx, f.baseURL+"?"+params.Encode(), &resp)
+	})
+	if err != nil {
+		return nil, err
+	}
+	if resp.Error != "" {
+		return nil, fmt.Errorf("archive API error: %s", resp.Error)
+	}
+
+	results := make([]SearchResult, 0, len(resp.Data))
+	for i := range resp.Data {
+		result := resp.Data[i].toSearchResult()
+		// Only include posts with Media flair
+		if result.Flair == "Media" {
+			results = append(results, result)
+		}
+	}
+	return results, nil
+}
+
+// getJSON performs a rate-limited GET request and decodes the JSON response into v.
+func (f *ArchiveFetcher) getJSON(ctx context.Context, reqURL string, v any) (err error) {
+	if err := f.rateLimiter.Wait(ctx); err != nil {
+		return err
+	}
+
+	done := trace.Span(ctx, "archive GET "+strings.TrimPrefix(reqURL, f.baseURL))
+	defer func() { done(err) }()
+
+	req, err := http.NewRequestWithContext(ctx, "GET", reqURL, nil)
+	if err != nil {
+		return fmt.Errorf("create request: %w", err)
+	}
+
+	resp, err := f.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("fetch from archive: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != http.StatusOK {
+		return &httpx.StatusError{StatusCode: resp.StatusCode}
+	}
+	body, err := httpx.ReadBody(resp)
+	if err != nil {
+		return fmt.Errorf("read response: %w", err)
+	}
+	if err := json.Unmarshal(body, v); err != nil {
+		return fmt.Errorf("parse response: %w", err)
+	}
+	return nil
+}
+
+// ArchiveFallbackFetcher searches Reddit for recent matches and the archive for
+// matches older than ArchiveAfter, falling back to Reddit if the archive fails.
+// Match threads always come from Reddit.
+type ArchiveFallbackFetcher struct {
+	Recent  Fetcher
+	Archive Fetcher
+}
+
+// Search routes the search by match age.
+func (f *ArchiveFallbackFetcher) Search(ctx context.Context, query string, limit int, matchTime time.Time, window SearchWindow, sort string) ([]SearchResult, error) {
+	if matchTime.IsZero() || time.Since(matchTime) < ArchiveAfter {
+		return f.Recent.Search(ctx, query, limit, matchTime, window, sort)
+	}
+	results, err := f.Archive.Search(ctx, query, limit, matchTime, window, sort)
+	if err != nil {
+		return f.Recent.Search(ctx, query, limit, matchTime, window, sort)
+	}
+	return results, nil
+}
+
+// FindMatchThread delegates to the recent fetcher.
+func (f *ArchiveFallbackFetcher) FindMatchThread(ctx context.Context, homeTeam, awayTeam string, matchTime time.Time) (*MatchThread, error) {
+	tf, ok := f.Recent.(ThreadFetcher)
+	if !ok {
+		return nil, nil
+	}
+	return tf.FindMatchThread(ctx, homeTeam, awayTeam, matchTime)
+}
+
+// Comments delegates to the recent fetcher.
+func (f *ArchiveFallbackFetcher) Comments(ctx context.Context, threadID string, limit int) ([]Comment, error) {
+	tf, ok := f.Recent.(ThreadFetcher)
+	if !ok {
+		return nil, nil
+	}
+	return tf.Comments(ctx, threadID, limit)
+}
+
+// UseArchive makes the client look up goal links for matches older than ArchiveAfter
+// in the Arctic Shift archive.
+func (c *Client) UseArchive() {
+	c.fetcher = &ArchiveFallbackFetcher{Recent: c.fetcher, Archive: NewArchiveFetcher()}
+	c.debugLog("Goal link archive fallback enabled")
+}
@@ -1,10 +1,15 @@
 package reddit
 
 import (
+	"bufio"
+	"bytes"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
 	"os"
 	"path/filepath"
+	"slices"
 	"sync"
 	"time"
 
@@ -13,21 +18,61 @@ import (
 
 const (
 	goalLinksFileName = "goal_links.json"
-	// CacheTTL defines how long goal links are stored.
+	// CacheTTL defines how long goal links are stored by default.
 	// 7 days keeps the cache file small while covering recent matches.
 	CacheTTL = 7 * 24 * time.Hour // 7 days
-	// NotFoundTTL defines how long to cache "not found" results.
+	// DefaultCacheMaxEntries caps the number of cached goal links by default.
+	// Least recently used entries are evicted beyond it.
+	DefaultCacheMaxEntries = 5000
+	// NotFoundTTL defines how long to cache "not found" results without an explicit expiry.
 	// Shorter than CacheTTL since links might appear later.
 	NotFoundTTL = 5 * time.Minute // 5 minutes
+	// NotFoundTTLLive defines how long a "not found" result is kept for live matches.
+	// Clips often appear 10-30 minutes after the goal, so retry after that window.
+	NotFoundTTLLive = 30 * time.Minute
+	// NotFoundTTLFinished defines how long a "not found" result is kept for finished matches.
+	// Late uploads are rare once the match is over, so retry at most once a day.
+	NotFoundTTLFinished = 24 * time.Hour
 	// NotFoundMarker is a special URL indicating "searched but not found"
 	NotFoundMarker = "__NOT_FOUND__"
+	// cacheSchemaVersion is the goal link file format version.
+	// v1 was a bare JSON array of single-URL links; v2 wraps the links and adds mirrors.
+	cacheSchemaVersion = 2
 )
 
+// cacheFile is the on-disk goal link cache format.
+type cacheFile struct {
+	Version int        `json:"version"`
+	Links   []GoalLink `json:"links"`
+}
+
 // GoalLinkCache provides persistent storage for goal replay links.
+// The cache holds at most maxEntries links (least recently used are evicted)
+// for at most maxAge each.
 type GoalLinkCache struct {
-	mu       sync.RWMutex
-	links    map[string]GoalLink // key: "matchID:minute"
-	filePath string
+	mu         sync.RWMutex
+	links      map[string]GoalLink // key: "matchID:minute"
+	filePath   string
+	maxEntries int
+	maxAge     time.Duration
+	stats      CacheStats
+}
+
+// CacheStats reports goal link cache usage since startup.
+type CacheStats struct {
+	Entries   int // Current entries, including "not found" markers
+	Hits      int // Get calls answered from the cache (including "not found" markers)
+	Misses    int // Get calls that found nothing or an expired entry
+	Evictions int // Entries evicted to respect the size cap
+}
+
+// HitRate returns the share of Get calls answered from the cache (0-1).
+func (s CacheStats) HitRate() float64 {
+	total := s.Hits + s.Misses
+	if total == 0 {
+		return 0
+	}
+	return float64(s.Hits) / float64(total)
 }
 
 // NewGoalLinkCache creates a new cache, loading existing data from disk.
@@ -38,52 +83,90 @@ func NewGoalLinkCache() (*GoalLinkCache, error) {
 	}
 
 	cache := &GoalLinkCache{
-		links:    make(map[string]GoalLink),
-		filePath: filepath.Join(dir, goalLinksFileName),
+		links:      make(map[string]GoalLink),
+		filePath:   filepath.Join(dir, goalLinksFileName),
+		maxEntries: DefaultCacheMaxEntries,
+		maxAge:     CacheTTL,
 	}
 
 	// Load existing cache from disk (silently ignore errors - start with empty cache)
 	_ = cache.load()
 
-	// Clean expired entries on startup to keep file size manageable
-	_ = cache.CleanExpired()
+	// Compact on startup to keep file size manageable
+	_ = cache.Compact()
 
 	return cache, nil
 }
 
+// SetLimits changes the entry cap and maximum link age (values <= 0 keep the current
+// limit), then compacts the cache to apply them.
+func (c *GoalLinkCache) SetLimits(maxEntries int, maxAge time.Duration) error {
+	c.mu.Lock()
+	if maxEntries > 0 {
+		c.maxEntries = maxEntries
+	}
+	if maxAge > 0 {
+		c.maxAge = maxAge
+	}
+	c.mu.Unlock()
+	return c.Compact()
+}
+
+// Stats returns cache usage statistics.
+func (c *GoalLinkCache) Stats() CacheStats {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	stats := c.stats
+	stats.Entries = len(c.links)
+	return stats
+}
+
 // makeKey creates a cache key from matchID and minute.
 func makeKey(key GoalLinkKey) string {
 	return fmt.Sprintf("%d:%d", key.MatchID, key.Minute)
 }
 
 // Get retrieves a goal link from cache if it exists and is not expired.
-// Returns nil if not cached or expired.
-// To distinguish "not found" from "not cached", use Exists().
+// Returns nil if not cached or expired. A hit marks the entry as recently used.
+// To distinguish "not found" from "not cached", use IsNotFound().
 func (c *GoalLinkCache) Get(key GoalLinkKey) *GoalLink {
-	c.mu.RLock()
-	defer c.mu.RUnlock()
+	c.mu.Lock()
+	defer c.mu.Unlock()
 
 	cacheKey := makeKey(key)
 	link, ok := c.links[cacheKey]
-	if !ok {
-		return nil
+	if !ok || c.expired(link) {
+		c.stats.Misses++
+		return nil // Expired entries allow a retry
 	}
 
-	// Check if this is a "not found" marker
+	c.stats.Hits++
+	link.LastUsedAt = time.Now()
+	c.links[cacheKey] = link
+
+	// Not-found markers are returned to indicate "searched but not found"
+	return &link
+}
+
+// expired reports whether a link is past its TTL.
+// Uses different TTLs for regular links vs "not found" markers.
+func (c *GoalLinkCache) expired(link GoalLink) bool {
 	if link.URL == NotFoundMarker {
-		// Check TTL for not-found entries (shorter)
-		if time.Since(link.FetchedAt) > NotFoundTTL {
-			return nil // Expired, allow retry
-		}
-		return &link // Return marker to indicate "searched but not found"
+		return link.notFoundExpired()
 	}
+	return time.Since(link.FetchedAt) > c.maxAge
+}
 
-	// Check if expired for regular entries
-	if time.Since(link.FetchedAt) > CacheTTL {
-		return nil
+// expiresAt returns when a link expires, by the same TTLs as expired.
+func (c *GoalLinkCache) expiresAt(link GoalLink) time.Time {
+	if link.URL != NotFoundMarker {
+		return link.FetchedAt.Add(c.maxAge)
 	}
-
-	return &link
+	if !link.ExpiresAt.IsZero() {
+		return link.ExpiresAt
+	}
+	return link.FetchedAt.Add(NotFoundTTL)
 }
 
 // IsNotFound returns true if the cached entry is a "not found" marker.
@@ -91,14 +174,25 @@ func IsNotFound(link *GoalLink) bool {
 	return link != nil && link.URL == NotFoundMarker
 }
 
-// SetNotFound stores a "not found" marker in the cache.
-// This prevents re-fetching goals that weren't found on Reddit.
-func (c *GoalLinkCache) SetNotFound(matchID, minute int) error {
+// notFoundExpired reports whether a "not found" marker has expired.
+// Markers without an explicit expiry (older cache files) fall back to NotFoundTTL.
+func (l GoalLink) notFoundExpired() bool {
+	if !l.ExpiresAt.IsZero() {
+		return time.Now().After(l.ExpiresAt)
+	}
+	return time.Since(l.FetchedAt) > NotFoundTTL
+}
+
+// SetNotFound stores a "not found" marker in the cache that expires after ttl.
+// This prevents re-fetching goals that weren't found on Reddit until the marker expires.
+func (c *GoalLinkCache) SetNotFound(matchID, minute int, ttl time.Duration) error {
+	now := time.Now()
 	return c.Set(GoalLink{
 		MatchID:   matchID,
 		Minute:    minute,
 		URL:       NotFoundMarker,
-		FetchedAt: time.Now(),
+		FetchedAt: now,
+		ExpiresAt: now.Add(ttl),
 	})
 }
 
@@ -108,11 +202,44 @@ func (c *GoalLinkCache) Set(link GoalLink) error {
 	defer c.mu.Unlock()
 
 	key := makeKey(GoalLinkKey{MatchID: link.MatchID, Minute: link.Minute})
+	link.LastUsedAt = time.Now()
 	c.links[key] = link
+	c.evictLocked()
 
 	return c.saveLocked()
 }
 
+// evictLocked removes least recently used entries beyond maxEntries
+// (must hold write lock). Returns whether anything was evicted.
+func (c *GoalLinkCache) evictLocked() bool {
+	excess := len(c.links) - c.maxEntries
+	if c.maxEntries <= 0 || excess <= 0 {
+		return false
+	}
+
+	keys := make([]string, 0, len(c.links))
+	for key := range c.links {
+		keys = append(keys, key)
+	}
+	slices.SortFunc(keys, func(a, b string) int {
+		return c.links[a].lastUsed().Compare(c.links[b].lastUsed())
+	})
+	for _, key := range keys[:excess] {
+		delete(c.links, key)
+	}
+	c.stats.Evictions += excess
+	return true
+}
+
+// lastUsed returns when the link was last read or stored.
+// Links from older cache files fall back to when they were fetched.
+func (l GoalLink) lastUsed() time.Time {
+	if l.LastUsedAt.IsZero() {
+		return l.FetchedAt
+	}
+	return l.LastUsedAt
+}
+
 // All returns all cached goal links for a match.
 func (c *GoalLinkCache) All(matchID int) []GoalLink {
 	c.mu.RLock()
@@ -120,7 +247,7 @@ func (c *GoalLinkCache) All(matchID int) []GoalLink {
 
 	var result []GoalLink
 	for _, link := range c.links {
-		if link.MatchID == matchID && time.Since(link.FetchedAt) <= CacheTTL {
+		if link.MatchID == matchID && time.Since(link.FetchedAt) <= c.maxAge {
 			result = append(result, link)
 		}
 	}
@@ -133,7 +260,7 @@ func (c *GoalLinkCache) Clear() error {
 	defer c.mu.Unlock()
 
 	c.links = make(map[string]GoalLink)
-	return c.saveLocked()
+	return c.writeLocked(false)
 }
 
 // CleanExpired removes expired entries from the cache.
@@ -142,34 +269,48 @@ func (c *GoalLinkCache) CleanExpired() error {
 	c.mu.Lock()
 	defer c.mu.Unlock()
 
+	// Only save if something was cleaned
+	if c.cleanExpiredLocked() {
+		return c.saveLocked()
+	}
+	return nil
+}
+
+// cleanExpiredLocked removes expired entries (must hold write lock).
+// Returns whether anything was removed.
+func (c *GoalLinkCache) cleanExpiredLocked() bool {
 	cleaned := false
 	for key, link := range c.links {
-		age := time.Since(link.FetchedAt)
-
-		// Use shorter TTL for "not found" entries
-		if link.URL == NotFoundMarker {
-			if age > NotFoundTTL {
-				delete(c.links, key)
-				cleaned = true
-			}
-		} else {
-			if age > CacheTTL {
-				delete(c.links, key)
-				cleaned = true
-			}
+		if c.expired(link) {
+			delete(c.links, key)
+			cleaned = true
 		}
 	}
+	return cleaned
+}
 
-	// Only save if something was cleaned
-	if cleaned {
-		return c.saveLocked()
+// Compact drops expired entries, evicts least recently used entries beyond the
+// size cap and rewrites the cache file. Runs on startup.
+func (c *GoalLinkCache) Compact() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	cleaned := c.cleanExpiredLocked()
+	evicted := c.evictLocked()
+	if !cleaned && !evicted {
+		return nil
 	}
-	return nil
+	return c.saveLocked()
 }
 
-// load reads the cache from disk.
+// load reads the cache from disk, holding the cross-process file lock while reading.
 func (c *GoalLinkCache) load() error {
-	data, err := os.ReadFile(c.filePath)
+	unlock, err := data.LockFile(c.filePath)
+	if err != nil {
+		return err
+	}
+	raw, recovered, err := data.ReadFileChecked(c.filePath)
+	unlock()
 	if err != nil {
 		if os.IsNotExist(err) {
 			return nil // No cache file yet, that's fine
@@ -177,40 +318,209 @@ func (c *GoalLinkCache) load() error {
 		return fmt.Errorf("read cache file: %w", err)
 	}
 
-	var links []GoalLink
-	if err := json.Unmarshal(data, &links); err != nil {
+	current, migrated, err := cacheSchema.Migrate(raw)
+	if err != nil {
+		if errors.Is(err, data.ErrIncompatibleSchema) {
+			// Keep the old file for a golazo that can read it and rebuild from scratch
+			_, _ = data.SetAside(c.filePath, data.SchemaVersion(raw))
+		}
+		return err
+	}
+	var file cacheFile
+	if err := json.Unmarshal(current, &file); err != nil {
 		return fmt.Errorf("parse cache file: %w", err)
 	}
 
 	// Convert to map
-	for _, link := range links {
+	for _, link := range file.Links {
 		key := makeKey(GoalLinkKey{MatchID: link.MatchID, Minute: link.Minute})
 		c.links[key] = link
 	}
 
+	if migrated || recovered {
+		return c.saveLocked()
+	}
 	return nil
 }
 
-// saveLocked persists the cache to disk (must hold write lock).
+// cacheSchema is the goal link file format and its migrations.
+var cacheSchema = data.Schema{
+	Name:       "goal link cache",
+	Version:    cacheSchemaVersion,
+	Migrations: map[int]data.Migration{1: migrateV1},
+}
+
+// migrateV1 wraps a v1 bare array of links and upgrades the links to mirrors.
+func migrateV1(raw []byte) ([]byte, error) {
+	var links []GoalLink
+	if err := json.Unmarshal(raw, &links); err != nil {
+		return nil, err
+	}
+	migrateLinks(links)
+	return json.Marshal(cacheFile{Version: 2, Links: links})
+}
+
+// migrateLinks upgrades v1 links in place: each found link becomes its own single mirror.
+func migrateLinks(links []GoalLink) {
+	for i := range links {
+		link := &links[i]
+		if link.URL == NotFoundMarker || len(link.Mirrors) > 0 {
+			continue
+		}
+		link.Mirrors = []Mirror{{
+			URL:      link.URL,
+			Host:     mirrorHost(link.URL),
+			PostURL:  link.PostURL,
+			VideoURL: link.VideoURL,
+			DashURL:  link.DashURL,
+		}}
+	}
+}
+
+// saveLocked persists the cache to disk (must hold write lock), merged with the links
+// other golazo processes saved since it was read. Other processes are excluded by the
+// file lock, and the file is replaced atomically with a checksum so a crash mid-write
+// never leaves it truncated.
 func (c *GoalLinkCache) saveLocked() error {
+	return c.writeLocked(true)
+}
+
+// writeLocked writes the cache file under the file lock (must hold write lock), first
+// merging the file's links into the cache when merge is set.
+func (c *GoalLinkCache) writeLocked(merge bool) error {
+	unlock, err := data.LockFile(c.filePath)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	if merge {
+		c.mergeFileLocked()
+	}
+
 	// Convert map to slice for JSON
 	links := make([]GoalLink, 0, len(c.links))
 	for _, link := range c.links {
 		links = append(links, link)
 	}
 
-	data, err := json.MarshalIndent(links, "", "  ")
+	raw, err := json.MarshalIndent(cacheFile{Version: cacheSchemaVersion, Links: links}, "", "  ")
 	if err != nil {
 		return fmt.Errorf("marshal cache: %w", err)
 	}
 
-	if err := os.WriteFile(c.filePath, data, 0644); err != nil {
+	if err := data.WriteFileChecked(c.filePath, raw, 0644); err != nil {
 		return fmt.Errorf("write cache file: %w", err)
 	}
 
 	return nil
 }
 
+// mergeFileLocked merges the links in the cache file into the cache (must hold write lock
+// and the file lock). For links cached under the same key, the one expiring last wins;
+// expired links in the file are skipped. An unreadable file is left to be overwritten.
+func (c *GoalLinkCache) mergeFileLocked() {
+	raw, _, err := data.ReadFileChecked(c.filePath)
+	if err != nil {
+		return
+	}
+	current, _, err := cacheSchema.Migrate(raw)
+	if err != nil {
+		return
+	}
+	var file cacheFile
+	if err := json.Unmarshal(current, &file); err != nil {
+		return
+	}
+
+	for _, link := range file.Links {
+		if c.expired(link) {
+			continue
+		}
+		key := makeKey(GoalLinkKey{MatchID: link.MatchID, Minute: link.Minute})
+		if cached, ok := c.links[key]; ok && !c.expiresAt(link).After(c.expiresAt(cached)) {
+			continue
+		}
+		c.links[key] = link
+	}
+	c.evictLocked()
+}
+
+// Export writes the cached goal links to w as JSON lines, one link per line, ordered by
+// match and minute. "Not found" markers and expired links are skipped.
+// Returns the number of links written.
+func (c *GoalLinkCache) Export(w io.Writer) (int, error) {
+	c.mu.RLock()
+	links := make([]GoalLink, 0, len(c.links))
+	for _, link := range c.links {
+		if link.URL != NotFoundMarker && !c.expired(link) {
+			links = append(links, link)
+		}
+	}
+	c.mu.RUnlock()
+
+	slices.SortFunc(links, func(a, b GoalLink) int {
+		if a.MatchID != b.MatchID {
+			return a.MatchID - b.MatchID
+		}
+		return a.Minute - b.Minute
+	})
+
+	enc := json.NewEncoder(w)
+	for i, link := range links {
+		if err := enc.Encode(link); err != nil {
+			return i, fmt.Errorf("write goal link: %w", err)
+		}
+	}
+	return len(links), nil
+}
+
+// Import merges goal links from JSON lines written by Export and persists the cache.
+// A link replaces a cached one only if it was fetched more recently; "not found"
+// markers and links older than the cache's maximum age are skipped.
+// Returns the number of links added or updated.
+func (c *GoalLinkCache) Import(r io.Reader) (int, error) {
+	var links []GoalLink
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	for line := 1; scanner.Scan(); line++ {
+		text := bytes.TrimSpace(scanner.Bytes())
+		if len(text) == 0 {
+			continue
+		}
+		var link GoalLink
+		if err := json.Unmarshal(text, &link); err != nil {
+			return 0, fmt.Errorf("parse line %d: %w", line, err)
+		}
+		links = append(links, link)
+	}
+	if err := scanner.Err(); err != nil {
+		return 0, fmt.Errorf("read goal links: %w", err)
+	}
+	migrateLinks(links) // Exports from older versions may lack mirrors
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	imported := 0
+	for _, link := range links {
+		if link.MatchID == 0 || link.URL == "" || link.URL == NotFoundMarker || c.expired(link) {
+			continue
+		}
+		key := makeKey(GoalLinkKey{MatchID: link.MatchID, Minute: link.Minute})
+		if existing, ok := c.links[key]; ok && existing.URL != NotFoundMarker && !existing.FetchedAt.Before(link.FetchedAt) {
+			continue
+		}
+		c.links[key] = link
+		imported++
+	}
+	if imported == 0 {
+		return 0, nil
+	}
+	c.evictLocked()
+	return imported, c.saveLocked()
+}
+
 // Size returns the number of cached goal links.
 func (c *GoalLinkCache) Size() int {
 	c.mu.RLock()
@@ -1,14 +1,22 @@
 package reddit
 
 import (
+	"bytes"
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
-	"io"
 	"net/http"
 	"net/url"
+	"slices"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
+
+	"github.com/0xjuanma/golazo/internal/data"
+	"github.com/0xjuanma/golazo/internal/httpx"
+	"github.com/0xjuanma/golazo/internal/trace"
 )
 
 // DebugLogger is a function type for debug logging
@@ -17,7 +25,7 @@ type DebugLogger func(message string)
 // Fetcher defines the interface for fetching data from Reddit.
 // Uses Reddit's public JSON API for goal link retrieval.
 type Fetcher interface {
-	Search(query string, limit int, matchTime time.Time, sort string) ([]SearchResult, error)
+	Search(ctx context.Context, query string, limit int, matchTime time.Time, window SearchWindow, sort string) ([]SearchResult, error)
 }
 
 // PublicJSONFetcher uses Reddit's public JSON endpoints (no auth required).
@@ -25,56 +33,34 @@ type Fetcher interface {
 type PublicJSONFetcher struct {
 	httpClient  *http.Client
 	userAgent   string
-	rateLimiter *rateLimiter
-}
-
-// rateLimiter implements simple rate limiting for Reddit API.
-type rateLimiter struct {
-	mu          sync.Mutex
-	lastRequest time.Time
-	minInterval time.Duration
-}
-
-func newRateLimiter(requestsPerMinute int) *rateLimiter {
-	interval := time.Minute / time.Duration(requestsPerMinute)
-	return &rateLimiter{
-		minInterval: interval,
-	}
+	rateLimiter *httpx.Limiter
+	inflight    httpx.Shared // Coalesces concurrent identical searches
 }
 
-func (r *rateLimiter) wait() {
-	r.mu.Lock()
-	defer r.mu.Unlock()
+// redditHost identifies Reddit's rate limit budget, shared by every Reddit fetcher.
+const redditHost = "www.reddit.com"
 
-	elapsed := time.Since(r.lastRequest)
-	if elapsed < r.minInterval {
-		time.Sleep(r.minInterval - elapsed)
-	}
-	r.lastRequest = time.Now()
-}
+// sharedSearchTimeout bounds a search shared by concurrent callers, including its wait
+// for the rate limiter, while any of them is still waiting for it.
+const sharedSearchTimeout = 30 * time.Second
 
 // NewPublicJSONFetcher creates a new fetcher using public Reddit JSON API.
 func NewPublicJSONFetcher() *PublicJSONFetcher {
 	return &PublicJSONFetcher{
-		httpClient: &http.Client{
-			Timeout: 10 * time.Second,
-		},
+		httpClient: data.NewHTTPClient(10 * time.Second),
 		// Reddit requires a descriptive User-Agent
 		userAgent:   "golazo:v1.0.0 (by /u/golazo_app)",
-		rateLimiter: newRateLimiter(10), // 10 requests per minute for public API
+		rateLimiter: httpx.LimiterFor(redditHost, 10.0/60, 2), // 10 requests per minute, bursts of 2
 	}
 }
 
 // Search performs a search on r/soccer for Media posts matching the query.
-// matchTime is used to filter results to posts created around the match date.
+// Results are limited to posts created within window around matchTime.
 // sort controls the result ordering (e.g., "relevance", "top", "new", "hot").
-func (f *PublicJSONFetcher) Search(query string, limit int, matchTime time.Time, sort string) ([]SearchResult, error) {
-	f.rateLimiter.wait()
-
-	// Build timestamp range for filtering (match day only ±12 hours)
-	// Goal videos are posted very soon after goals happen - limit to match day
-	startTime := matchTime.Add(-12 * time.Hour).Unix()
-	endTime := matchTime.Add(12 * time.Hour).Unix()
+func (f *PublicJSONFetcher) Search(ctx context.Context, query string, limit int, matchTime time.Time, window SearchWindow, sort string) ([]SearchResult, error) {
+	// Build timestamp range for filtering (the match day by default)
+	startTime := matchTime.Add(-window.Before).Unix()
+	endTime := matchTime.Add(window.After).Unix()
 
 	// Default to relevance if sort is empty
 	if sort == "" {
@@ -92,43 +78,83 @@ func (f *PublicJSONFetcher) Search(query string, limit int, matchTime time.Time,
 		limit,
 	)
 
-	req, err := http.NewRequest("GET", searchURL, nil)
+	// Concurrent identical searches share one request; each caller gets its own slice.
+	// One caller giving up doesn't fail the others; the request is cancelled once none of
+	// them is waiting any more.
+	val, err := f.inflight.Do(ctx, searchURL, sharedSearchTimeout, func(ctx context.Context) (any, error) {
+		var results []SearchResult
+		err := breaker.Do(func() error {
+			var err error
+			results, err = f.search(ctx, searchURL)
+			return err
+		})
+		return results, err
+	})
 	if err != nil {
-		return nil, fmt.Errorf("create request: %w", err)
+		return nil, err
+	}
+	return slices.Clone(val.([]SearchResult)), nil
+}
+
+// search performs a rate-limited search request and returns the Media-flaired posts.
+func (f *PublicJSONFetcher) search(ctx context.Context, searchURL string) ([]SearchResult, error) {
+	var searchResp redditSearchResponse
+	if err := f.getJSON(ctx, searchURL, &searchResp); err != nil {
+		return nil, err
+	}
+
+	results := make([]SearchResult, 0, len(searchResp.Data.Children))
+	for _, child := range searchResp.Data.Children {
+		result := child.Data.toSearchResult()
+		// Only include posts with Media flair
+		if result.Flair == "Media" {
+			results = append(results, result)
+		}
+	}
+
+	return results, nil
+}
+
+// getJSON performs a rate-limited GET request and decodes the JSON response into v.
+func (f *PublicJSONFetcher) getJSON(ctx context.Context, reqURL string, v any) (err error) {
+	if err := f.rateLimiter.Wait(ctx); err != nil {
+		return err
+	}
+
+	done := trace.Span(ctx, "reddit GET "+strings.TrimPrefix(reqURL, "https://www.reddit.com"))
+	defer func() { done(err) }()
+
+	req, err := http.NewRequestWithContext(ctx, "GET", reqURL, nil)
+	if err != nil {
+		return fmt.Errorf("create request: %w", err)
 	}
 	req.Header.Set("User-Agent", f.userAgent)
 
 	resp, err := f.httpClient.Do(req)
 	if err != nil {
-		return nil, fmt.Errorf("fetch from reddit: %w", err)
+		return fmt.Errorf("fetch from reddit: %w", err)
 	}
 	defer func() { _ = resp.Body.Close() }()
 
+	// The status decides retries, so check it before the body can fail to read
 	if resp.StatusCode != http.StatusOK {
-		body, _ := io.ReadAll(resp.Body)
-		return nil, fmt.Errorf("reddit API error: status %d, body: %s", resp.StatusCode, string(body))
+		return fmt.Errorf("reddit API error: %w", &httpx.StatusError{StatusCode: resp.StatusCode})
 	}
 
-	body, err := io.ReadAll(resp.Body)
+	body, err := httpx.ReadBody(resp)
 	if err != nil {
-		return nil, fmt.Errorf("read response: %w", err)
+		return fmt.Errorf("read response: %w", err)
 	}
 
-	var searchResp redditSearchResponse
-	if err := json.Unmarshal(body, &searchResp); err != nil {
-		return nil, fmt.Errorf("parse response: %w", err)
+	// Reddit serves an HTML CAPTCHA/block page with 200 when it suspects a bot
+	if trimmed := bytes.TrimSpace(body); len(trimmed) > 0 && trimmed[0] == '<' {
+		return fmt.Errorf("reddit returned HTML instead of JSON (likely CAPTCHA)")
 	}
 
-	results := make([]SearchResult, 0, len(searchResp.Data.Children))
-	for _, child := range searchResp.Data.Children {
-		result := child.Data.toSearchResult()
-		// Only include posts with Media flair
-		if result.Flair == "Media" {
-			results = append(results, result)
-		}
+	if err := json.Unmarshal(body, v); err != nil {
+		return fmt.Errorf("parse response: %w", err)
 	}
-
-	return results, nil
+	return nil
 }
 
 // Client provides goal replay link fetching from Reddit r/soccer.
@@ -137,6 +163,9 @@ type Client struct {
 	fetcher     Fetcher // Reddit public API fetcher
 	cache       *GoalLinkCache
 	debugLogger DebugLogger // Optional debug logger function
+	traces      searchTraces
+	windows     atomic.Pointer[map[int]SearchWindow] // Search windows by league ID (SetSearchWindows)
+	matcher     Matcher                              // nil uses NewMatcher(DefaultWeights)
 }
 
 // debugLog is a helper method to safely call the debug logger if it exists
@@ -168,6 +197,7 @@ func NewClientWithDebug(debugLogger DebugLogger) (*Client, error) {
 	}
 
 	debugLogger("Initializing Reddit client with public API")
+	debugLogger(fmt.Sprintf("Goal link cache: %d entries", cache.Stats().Entries))
 
 	return &Client{
 		fetcher:     NewPublicJSONFetcher(),
@@ -176,6 +206,20 @@ func NewClientWithDebug(debugLogger DebugLogger) (*Client, error) {
 	}, nil
 }
 
+// SetMatcher replaces how search results are matched to goals, e.g. with tuned weights.
+// Call it before searching.
+func (c *Client) SetMatcher(m Matcher) {
+	c.matcher = m
+}
+
+// Matcher returns how search results are matched to goals.
+func (c *Client) Matcher() Matcher {
+	if c.matcher == nil {
+		return defaultMatcher
+	}
+	return c.matcher
+}
+
 // NewClientWithFetcher creates a new Reddit client with a custom fetcher.
 // Use this for testing with custom fetchers.
 func NewClientWithFetcher(fetcher Fetcher, cache *GoalLinkCache) *Client {
@@ -186,13 +230,16 @@ func NewClientWithFetcher(fetcher Fetcher, cache *GoalLinkCache) *Client {
 }
 
 // GoalLink retrieves a cached goal link or fetches from Reddit if not cached.
-// Returns nil if the goal link was previously searched but not found.
-func (c *Client) GoalLink(goal GoalInfo) (*GoalLink, error) {
+// Returns nil if the goal link was previously searched but not found and the
+// "not found" marker has not expired yet (see NotFoundTTLLive/NotFoundTTLFinished).
+// Only searches that reached Reddit and found nothing are cached as "not found";
+// failed searches (open circuit, CAPTCHA, rate limiting) return an error.
+func (c *Client) GoalLink(ctx context.Context, goal GoalInfo) (*GoalLink, error) {
 	key := GoalLinkKey{MatchID: goal.MatchID, Minute: goal.Minute}
 
 	// Check cache first (includes "not found" markers)
 	if link := c.cache.Get(key); link != nil {
-		// If this is a "not found" marker, return nil (don't re-search)
+		// If this is an unexpired "not found" marker, return nil (don't re-search yet)
 		if IsNotFound(link) {
 			return nil, nil
 		}
@@ -200,7 +247,7 @@ func (c *Client) GoalLink(goal GoalInfo) (*GoalLink, error) {
 	}
 
 	// Search Reddit for the goal
-	link, err := c.searchForGoal(goal)
+	link, err := c.searchForGoal(ctx, goal)
 	if err != nil {
 		// Don't cache errors - allow retry
 		return nil, err
@@ -210,23 +257,50 @@ func (c *Client) GoalLink(goal GoalInfo) (*GoalLink, error) {
 		// Cache the result (silently ignore cache errors - best-effort)
 		_ = c.cache.Set(*link)
 	} else {
-		// Cache "not found" to avoid re-searching
-		_ = c.cache.SetNotFound(goal.MatchID, goal.Minute)
+		// Cache "not found" to avoid re-searching until the marker expires
+		_ = c.cache.SetNotFound(goal.MatchID, goal.Minute, notFoundTTL(goal))
 	}
 
 	return link, nil
 }
 
-// BatchSize is the maximum number of goals to fetch per batch.
-// Reduced to make requests even more spaced out.
-const BatchSize = 3
+// notFoundTTL returns how long a "not found" result should be cached for a goal.
+// Live matches are retried sooner since clips usually appear shortly after the goal.
+func notFoundTTL(goal GoalInfo) time.Duration {
+	if goal.IsFinished {
+		return NotFoundTTLFinished
+	}
+	return NotFoundTTLLive
+}
+
+// GoalLinkWorkers is the number of goals searched concurrently.
+// All workers share the fetcher's rate limiter, so this bounds in-flight
+// searches without increasing the request rate.
+const GoalLinkWorkers = 3
+
+// GoalLinkProgress reports the outcome of a single goal lookup during GoalLinksWithProgress.
+type GoalLinkProgress struct {
+	Key   GoalLinkKey
+	Link  *GoalLink // nil when no link was found
+	Done  int       // Goals processed so far
+	Total int       // Goals that needed a lookup
+	Found int       // Links found so far
+}
 
-// BatchDelay is the delay between batches to avoid rate limiting.
-const BatchDelay = 5 * time.Second
+// ProgressFunc receives a GoalLinkProgress after each goal lookup completes.
+// It may be called concurrently from worker goroutines, but calls are serialized.
+type ProgressFunc func(GoalLinkProgress)
 
 // GoalLinks retrieves links for multiple goals, using cache where available.
-// Goals are de-duplicated and batched to avoid rate limiting.
-func (c *Client) GoalLinks(goals []GoalInfo) map[GoalLinkKey]*GoalLink {
+// Goals are de-duplicated and fetched by a bounded pool of workers.
+func (c *Client) GoalLinks(ctx context.Context, goals []GoalInfo) map[GoalLinkKey]*GoalLink {
+	return c.GoalLinksWithProgress(ctx, goals, nil)
+}
+
+// GoalLinksWithProgress is like GoalLinks but calls onProgress after each uncached goal
+// is looked up, so callers can show progress (e.g., "3/8 goal links found").
+// onProgress may be nil.
+func (c *Client) GoalLinksWithProgress(ctx context.Context, goals []GoalInfo, onProgress ProgressFunc) map[GoalLinkKey]*GoalLink {
 	results := make(map[GoalLinkKey]*GoalLink)
 
 	// De-duplicate goals by key and filter out already-cached goals
@@ -254,79 +328,149 @@ func (c *Client) GoalLinks(goals []GoalInfo) map[GoalLinkKey]*GoalLink {
 		uncachedGoals = append(uncachedGoals, goal)
 	}
 
-	// Fetch uncached goals in batches with conservative delays
-	for i := 0; i < len(uncachedGoals); i += BatchSize {
-		// Add delay between batches (not before first batch)
-		if i > 0 {
-			time.Sleep(BatchDelay)
-		}
+	if len(uncachedGoals) == 0 {
+		return results
+	}
 
-		// Process batch
-		end := i + BatchSize
-		end = min(end, len(uncachedGoals))
+	jobs := make(chan GoalInfo)
+	var (
+		mu    sync.Mutex
+		wg    sync.WaitGroup
+		done  int
+		found int
+	)
 
-		for _, goal := range uncachedGoals[i:end] {
-			key := GoalLinkKey{MatchID: goal.MatchID, Minute: goal.Minute}
-			link, err := c.GoalLink(goal)
-			if err == nil && link != nil {
-				results[key] = link
+	workers := min(GoalLinkWorkers, len(uncachedGoals))
+	for range workers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for goal := range jobs {
+				key := GoalLinkKey{MatchID: goal.MatchID, Minute: goal.Minute}
+				link, err := c.GoalLink(ctx, goal)
+				if err != nil {
+					link = nil
+				}
+
+				mu.Lock()
+				done++
+				if link != nil {
+					found++
+					results[key] = link
+				}
+				if onProgress != nil {
+					onProgress(GoalLinkProgress{
+						Key:   key,
+						Link:  link,
+						Done:  done,
+						Total: len(uncachedGoals),
+						Found: found,
+					})
+				}
+				mu.Unlock()
 			}
-		}
+		}()
 	}
 
+	for _, goal := range uncachedGoals {
+		jobs <- goal
+	}
+	close(jobs)
+	wg.Wait()
+
 	return results
 }
 
-// searchForGoal searches Reddit for a specific goal with conservative retry logic.
-func (c *Client) searchForGoal(goal GoalInfo) (*GoalLink, error) {
-	// Conservative retry logic - Reddit is very aggressive with CAPTCHA detection
-	maxRetries := 2               // Reduced from 3
-	baseDelay := 60 * time.Second // Increased delay between retries
-
-	var lastErr error
-	for attempt := range maxRetries {
-		if attempt > 0 {
-			// Exponential backoff: 30s, 60s, 120s
-			delay := time.Duration(attempt) * baseDelay
-			time.Sleep(delay)
-		}
-
-		result, err := c.searchForGoalOnce(goal)
-		if err == nil {
-			return result, nil
-		}
-
-		lastErr = err
+// searchForGoal searches Reddit for a specific goal within its competition's search
+// window, then within the widened window when the searches succeeded but found nothing
+// for a finished match. A failed first pass is returned as is: widening would only send
+// more requests to a provider that is already failing.
+func (c *Client) searchForGoal(ctx context.Context, goal GoalInfo) (*GoalLink, error) {
+	goal.Window = c.searchWindow(goal.LeagueID)
+	result, err := c.searchForGoalWithRetry(ctx, goal)
+	if err != nil {
+		return nil, err
+	}
+	if result != nil || !goal.IsFinished {
+		return result, nil
+	}
+	goal.Window = goal.Window.Widened()
+	c.debugLog(fmt.Sprintf("No goal link for %d:%d, searching again %s before to %s after kick-off",
+		goal.MatchID, goal.Minute, goal.Window.Before, goal.Window.After))
+	return c.searchForGoalWithRetry(ctx, goal)
+}
 
-		// Check if this is a CAPTCHA/rate limit error
-		if strings.Contains(err.Error(), "CAPTCHA") ||
-			strings.Contains(err.Error(), "blocking requests") ||
-			strings.Contains(err.Error(), "rate limit") ||
-			strings.Contains(err.Error(), "HTML instead of JSON") {
-			// Don't retry CAPTCHA errors - Reddit is very aggressive, just give up
+// searchForGoalWithRetry searches Reddit for a specific goal with conservative retry logic.
+func (c *Client) searchForGoalWithRetry(ctx context.Context, goal GoalInfo) (*GoalLink, error) {
+	var result *GoalLink
+	err := httpx.Retry(ctx, searchRetryPolicy, func() error {
+		var err error
+		result, err = c.searchForGoalOnce(ctx, goal)
+		if err != nil && isBlockedError(err) {
 			c.debugLog(fmt.Sprintf("Reddit blocking goal %d:%d: giving up immediately", goal.MatchID, goal.Minute))
-			return nil, err
 		}
-
-		// For other errors, retry on next attempt
+		return err
+	})
+	if err != nil {
+		return nil, err
 	}
+	return result, nil
+}
 
-	if lastErr != nil {
-		return nil, lastErr
-	}
-	return nil, nil // No match found after all retries
+// searchRetryPolicy is conservative - Reddit is very aggressive with CAPTCHA detection.
+// Blocking responses are never retried; other failures get one retry after ~60s.
+var searchRetryPolicy = httpx.Policy{
+	MaxAttempts: 2,
+	BaseDelay:   60 * time.Second,
+	Jitter:      0.2,
+	Retryable: func(err error) bool {
+		return !isBlockedError(err) && !errors.Is(err, httpx.ErrCircuitOpen)
+	},
+}
+
+// breaker stops searching Reddit after repeated failures; cached goal links are still served.
+var breaker = httpx.NewBreaker("Reddit", 3, 2*time.Minute)
+
+// isBlockedError reports whether Reddit is blocking us (CAPTCHA/rate limit).
+func isBlockedError(err error) bool {
+	msg := err.Error()
+	return strings.Contains(msg, "CAPTCHA") ||
+		strings.Contains(msg, "blocking requests") ||
+		strings.Contains(msg, "rate limit") ||
+		strings.Contains(msg, "HTML instead of JSON")
 }
 
 // searchForGoalOnce performs a single search attempt for a goal.
-func (c *Client) searchForGoalOnce(goal GoalInfo) (*GoalLink, error) {
+// Returns nil without an error when the searches found no match, and the last search
+// error when every search failed, so failures are retried rather than cached as "not found".
+func (c *Client) searchForGoalOnce(ctx context.Context, goal GoalInfo) (*GoalLink, error) {
+	matcher := c.Matcher()
+	trace := &SearchTrace{Goal: goal, At: time.Now(), matcher: matcher}
+	defer func() { c.traces.add(*trace) }()
+
+	// searched is set once any search succeeds; searchErr keeps the last failure
+	var (
+		searched  bool
+		searchErr error
+	)
+	noMatch := func() (*GoalLink, error) {
+		if !searched {
+			return nil, fmt.Errorf("search goal %d:%d: %w", goal.MatchID, goal.Minute, searchErr)
+		}
+		return nil, nil
+	}
+
 	// Strategy 1: Both teams + minute (most specific, try first)
 	query1 := fmt.Sprintf("%s %s %d'", goal.HomeTeam, goal.AwayTeam, goal.Minute)
 	c.debugLog(fmt.Sprintf("Reddit search query: '%s' for goal %d:%d (%s vs %s)",
 		query1, goal.MatchID, goal.Minute, goal.HomeTeam, goal.AwayTeam))
-	results1, err := c.fetcher.Search(query1, 15, goal.MatchTime, "relevance")
+	results1, err := c.fetcher.Search(ctx, query1, 15, goal.MatchTime, goal.Window, "relevance")
+	trace.attempt(strategyTeams, query1, "relevance", results1, err)
 	if err != nil {
+		searchErr = err
 		c.debugLog(fmt.Sprintf("Reddit search failed for query '%s': %v", query1, err))
 	} else {
+		searched = true
 		c.debugLog(fmt.Sprintf("Reddit search returned %d results for query '%s'", len(results1), query1))
 		// Debug: log the first few result titles
 		for i, result := range results1 {
@@ -337,19 +481,13 @@ func (c *Client) searchForGoalOnce(goal GoalInfo) (*GoalLink, error) {
 	}
 	if err == nil {
 		// Check if we found a good match with the first strategy
-		match := findBestMatch(results1, goal)
-		c.debugLog(fmt.Sprintf("findBestMatch result for goal %d:%d (score %d-%d): %v", goal.MatchID, goal.Minute, goal.HomeScore, goal.AwayScore, match != nil))
-		if match != nil {
-			c.debugLog(fmt.Sprintf("Found goal link for %d:%d: %s (post: %s)", goal.MatchID, goal.Minute, match.URL, match.PostURL))
+		ranked := RankMatches(matcher, results1, goal)
+		c.debugLog(fmt.Sprintf("findBestMatch result for goal %d:%d (score %d-%d): %v", goal.MatchID, goal.Minute, goal.HomeScore, goal.AwayScore, len(ranked) > 0))
+		if len(ranked) > 0 {
+			c.debugLog(fmt.Sprintf("Found goal link for %d:%d: %s (post: %s, score %d, %d mirrors)", goal.MatchID, goal.Minute, ranked[0].URL, ranked[0].PostURL, ranked[0].MatchScore, len(ranked)))
 			// Found a match, return it immediately to avoid additional API calls
-			return &GoalLink{
-				MatchID:   goal.MatchID,
-				Minute:    goal.Minute,
-				URL:       match.URL,
-				Title:     match.Title,
-				PostURL:   match.PostURL,
-				FetchedAt: time.Now(),
-			}, nil
+			trace.won(strategyTeams, ranked)
+			return newGoalLink(goal, ranked), nil
 		}
 	}
 
@@ -360,6 +498,30 @@ func (c *Client) searchForGoalOnce(goal GoalInfo) (*GoalLink, error) {
 		allResults = append(allResults, results1...)
 	}
 
+	// Strategy 1b: Scorer name + minute (goal posts are usually titled with the scorer)
+	// Only runs when the scorer is known, and returns early to avoid additional API calls
+	scorer := strings.TrimSpace(goal.ScorerName)
+	if scorer != "" {
+		queryScorer := fmt.Sprintf("%s %d'", scorer, goal.Minute)
+		c.debugLog(fmt.Sprintf("Reddit search query (scorer strategy): '%s' for goal %d:%d", queryScorer, goal.MatchID, goal.Minute))
+		resultsScorer, err := c.fetcher.Search(ctx, queryScorer, 15, goal.MatchTime, goal.Window, "relevance")
+		trace.attempt(strategyScorer, queryScorer, "relevance", resultsScorer, err)
+		if err != nil {
+			searchErr = err
+			c.debugLog(fmt.Sprintf("Reddit search failed for scorer strategy query '%s': %v", queryScorer, err))
+		} else {
+			searched = true
+			c.debugLog(fmt.Sprintf("Reddit search returned %d results for scorer strategy query '%s'", len(resultsScorer), queryScorer))
+			allResults = append(allResults, resultsScorer...)
+
+			if ranked := RankMatches(matcher, resultsScorer, goal); len(ranked) > 0 {
+				c.debugLog(fmt.Sprintf("Found goal link (scorer strategy) for %d:%d: %s (post: %s, score %d, %d mirrors)", goal.MatchID, goal.Minute, ranked[0].URL, ranked[0].PostURL, ranked[0].MatchScore, len(ranked)))
+				trace.won(strategyScorer, ranked)
+				return newGoalLink(goal, ranked), nil
+			}
+		}
+	}
+
 	// Strategy 2: Try with just the scoring team + minute
 	// Determine which team scored
 	scoringTeam := goal.AwayTeam
@@ -368,10 +530,13 @@ func (c *Client) searchForGoalOnce(goal GoalInfo) (*GoalLink, error) {
 	}
 	query2 := fmt.Sprintf("%s %d'", scoringTeam, goal.Minute)
 	c.debugLog(fmt.Sprintf("Reddit search query (strategy 2): '%s' for goal %d:%d", query2, goal.MatchID, goal.Minute))
-	results2, err := c.fetcher.Search(query2, 15, goal.MatchTime, "relevance")
+	results2, err := c.fetcher.Search(ctx, query2, 15, goal.MatchTime, goal.Window, "relevance")
+	trace.attempt(strategyScoringTeam, query2, "relevance", results2, err)
 	if err != nil {
+		searchErr = err
 		c.debugLog(fmt.Sprintf("Reddit search failed for strategy 2 query '%s': %v", query2, err))
 	} else {
+		searched = true
 		c.debugLog(fmt.Sprintf("Reddit search returned %d results for strategy 2 query '%s'", len(results2), query2))
 		allResults = append(allResults, results2...)
 	}
@@ -387,18 +552,12 @@ func (c *Client) searchForGoalOnce(goal GoalInfo) (*GoalLink, error) {
 	}
 
 	// Check if strategies 1+2 found a match before trying strategy 3
-	match := findBestMatch(uniqueResults, goal)
-	if match != nil {
+	ranked := RankMatches(matcher, uniqueResults, goal)
+	if len(ranked) > 0 {
 		c.debugLog(fmt.Sprintf("Strategy 1+2 match found for goal %d:%d, skipping strategy 3", goal.MatchID, goal.Minute))
-		c.debugLog(fmt.Sprintf("Found goal link for %d:%d: %s (post: %s)", goal.MatchID, goal.Minute, match.URL, match.PostURL))
-		return &GoalLink{
-			MatchID:   goal.MatchID,
-			Minute:    goal.Minute,
-			URL:       match.URL,
-			Title:     match.Title,
-			PostURL:   match.PostURL,
-			FetchedAt: time.Now(),
-		}, nil
+		c.debugLog(fmt.Sprintf("Found goal link for %d:%d: %s (post: %s, score %d, %d mirrors)", goal.MatchID, goal.Minute, ranked[0].URL, ranked[0].PostURL, ranked[0].MatchScore, len(ranked)))
+		trace.won(strategyScoringTeam, ranked) // Earlier strategies already returned their matches
+		return newGoalLink(goal, ranked), nil
 	}
 
 	// Strategy 3: Try with short/alternative team names + sort by top (upvotes)
@@ -412,7 +571,7 @@ func (c *Client) searchForGoalOnce(goal GoalInfo) (*GoalLink, error) {
 
 	if !homeShortDifferent && !awayShortDifferent {
 		c.debugLog(fmt.Sprintf("Skipping strategy 3 for goal %d:%d: short names empty or identical to full names", goal.MatchID, goal.Minute))
-		return nil, nil // No match found across all strategies
+		return noMatch() // No match found across all strategies
 	}
 
 	// Build query using short names where they differ, falling back to full names
@@ -427,10 +586,13 @@ func (c *Client) searchForGoalOnce(goal GoalInfo) (*GoalLink, error) {
 
 	query3 := fmt.Sprintf("%s %s %d'", homeQuery, awayQuery, goal.Minute)
 	c.debugLog(fmt.Sprintf("Reddit search query (strategy 3): '%s' for goal %d:%d", query3, goal.MatchID, goal.Minute))
-	results3, err := c.fetcher.Search(query3, 15, goal.MatchTime, "top")
+	results3, err := c.fetcher.Search(ctx, query3, 15, goal.MatchTime, goal.Window, "top")
+	trace.attempt(strategyShortNames, query3, "top", results3, err)
 	if err != nil {
+		searchErr = err
 		c.debugLog(fmt.Sprintf("Reddit search failed for strategy 3 query '%s': %v", query3, err))
 	} else {
+		searched = true
 		c.debugLog(fmt.Sprintf("Reddit search returned %d results for strategy 3 query '%s'", len(results3), query3))
 		// Debug: log the first few result titles
 		for i, result := range results3 {
@@ -448,21 +610,45 @@ func (c *Client) searchForGoalOnce(goal GoalInfo) (*GoalLink, error) {
 	}
 
 	// Find the best matching result across all strategies
-	match = findBestMatch(uniqueResults, goal)
-	c.debugLog(fmt.Sprintf("findBestMatch result (strategy 3) for goal %d:%d: %v", goal.MatchID, goal.Minute, match != nil))
-	if match == nil {
-		return nil, nil // No match found, but not an error
+	ranked = RankMatches(matcher, uniqueResults, goal)
+	c.debugLog(fmt.Sprintf("findBestMatch result (strategy 3) for goal %d:%d: %v", goal.MatchID, goal.Minute, len(ranked) > 0))
+	if len(ranked) == 0 {
+		return noMatch() // No match found, an error only if every search failed
 	}
 
-	c.debugLog(fmt.Sprintf("Found goal link (strategy 3) for %d:%d: %s (post: %s)", goal.MatchID, goal.Minute, match.URL, match.PostURL))
+	c.debugLog(fmt.Sprintf("Found goal link (strategy 3) for %d:%d: %s (post: %s, score %d, %d mirrors)", goal.MatchID, goal.Minute, ranked[0].URL, ranked[0].PostURL, ranked[0].MatchScore, len(ranked)))
+	trace.won(strategyShortNames, ranked)
+	return newGoalLink(goal, ranked), nil
+}
+
+// newGoalLink builds a goal's link from its ranked matches: the best match is
+// selected and every match is kept as a mirror.
+func newGoalLink(goal GoalInfo, ranked []ScoredResult) *GoalLink {
+	mirrors := make([]Mirror, len(ranked))
+	for i, r := range ranked {
+		mirrors[i] = Mirror{
+			URL:      r.URL,
+			Host:     mirrorHost(r.URL),
+			Score:    r.MatchScore,
+			PostURL:  r.PostURL,
+			VideoURL: r.VideoURL,
+			DashURL:  r.DashURL,
+		}
+	}
+
+	best := ranked[0]
 	return &GoalLink{
 		MatchID:   goal.MatchID,
 		Minute:    goal.Minute,
-		URL:       match.URL,
-		Title:     match.Title,
-		PostURL:   match.PostURL,
+		URL:       best.URL,
+		Title:     best.Title,
+		PostURL:   best.PostURL,
+		VideoURL:  best.VideoURL,
+		DashURL:   best.DashURL,
 		FetchedAt: time.Now(),
-	}, nil
+		Score:     best.MatchScore,
+		Mirrors:   mirrors,
+	}
 }
 
 // ClearCache clears the goal link cache.
@@ -0,0 +1,126 @@
+package reddit
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/0xjuanma/golazo/internal/httpx"
+)
+
+// redirectTransport sends every request to a test server.
+type redirectTransport struct {
+	target *url.URL
+}
+
+func (t redirectTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	req = req.Clone(req.Context())
+	req.URL.Scheme = t.target.Scheme
+	req.URL.Host = t.target.Host
+	return http.DefaultTransport.RoundTrip(req)
+}
+
+func TestSearchRetriesAfterTooManyRequests(t *testing.T) {
+	policy := searchRetryPolicy
+	searchRetryPolicy.BaseDelay = time.Millisecond
+	t.Cleanup(func() { searchRetryPolicy = policy })
+
+	kickoff := time.Date(2019, 5, 7, 19, 0, 0, 0, time.UTC)
+	var requests atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// Both searches of the first attempt are rate limited
+		if requests.Add(1) <= 2 {
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		_, _ = fmt.Fprintf(w, `{"data": {"children": [{"data": {
+			"title": "Liverpool [4] - 0 Barcelona - Divock Origi 79'",
+			"url": "https://v.redd.it/origi", "permalink": "/r/soccer/comments/origi/",
+			"link_flair_text": "Media", "created_utc": %d, "upvote_ratio": 0.9}}]}}`, kickoff.Add(2*time.Hour).Unix())
+	}))
+	defer srv.Close()
+
+	target, _ := url.Parse(srv.URL)
+	fetcher := &PublicJSONFetcher{
+		httpClient:  &http.Client{Transport: redirectTransport{target: target}},
+		rateLimiter: httpx.NewLimiter(100, 10),
+	}
+	c := NewClientWithFetcher(fetcher, nil)
+
+	goal := GoalInfo{
+		MatchID: 1, HomeTeam: "Liverpool", AwayTeam: "Barcelona", Minute: 79, DisplayMinute: "79'",
+		HomeScore: 4, IsHomeTeam: true, MatchTime: kickoff, Window: DefaultSearchWindow,
+	}
+	link, err := c.searchForGoalWithRetry(t.Context(), goal)
+	if err != nil {
+		t.Fatalf("searchForGoalWithRetry: %v", err)
+	}
+	if link == nil || link.URL != "https://v.redd.it/origi" {
+		t.Fatalf("link = %+v; want the clip found on retry", link)
+	}
+	if n := requests.Load(); n != 3 {
+		t.Errorf("sent %d requests; want 2 rate limited and 1 retry", n)
+	}
+}
+
+// windowFetcher records the search window of every search and answers with err.
+type windowFetcher struct {
+	windows []SearchWindow
+	err     error
+}
+
+func (f *windowFetcher) Search(_ context.Context, _ string, _ int, _ time.Time, window SearchWindow, _ string) ([]SearchResult, error) {
+	f.windows = append(f.windows, window)
+	return nil, f.err
+}
+
+func TestSearchWidensOnlyAfterMiss(t *testing.T) {
+	policy := searchRetryPolicy
+	searchRetryPolicy.MaxAttempts = 1
+	t.Cleanup(func() { searchRetryPolicy = policy })
+
+	goal := GoalInfo{MatchID: 1, HomeTeam: "Liverpool", AwayTeam: "Barcelona", Minute: 79, IsFinished: true, MatchTime: time.Now()}
+	widened := DefaultSearchWindow.Widened()
+
+	missed := &windowFetcher{}
+	if _, err := NewClientWithFetcher(missed, nil).searchForGoal(t.Context(), goal); err != nil {
+		t.Fatalf("searchForGoal after a miss: %v", err)
+	}
+	if last := missed.windows[len(missed.windows)-1]; last != widened {
+		t.Errorf("after a miss, last search window = %+v; want widened %+v", last, widened)
+	}
+
+	failing := &windowFetcher{err: &httpx.StatusError{StatusCode: http.StatusServiceUnavailable}}
+	if _, err := NewClientWithFetcher(failing, nil).searchForGoal(t.Context(), goal); err == nil {
+		t.Fatal("searchForGoal with failing searches returned no error")
+	}
+	for _, window := range failing.windows {
+		if window == widened {
+			t.Fatal("failed first pass was followed by a widened search")
+		}
+	}
+}
+
+func TestGetJSONReportsStatusBeforeBody(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// An error page claiming a compression it doesn't use
+		w.Header().Set("Content-Encoding", "gzip")
+		w.WriteHeader(http.StatusTooManyRequests)
+		_, _ = w.Write([]byte("slow down"))
+	}))
+	defer srv.Close()
+
+	fetcher := &PublicJSONFetcher{httpClient: srv.Client(), rateLimiter: httpx.NewLimiter(100, 10)}
+	var v any
+	err := fetcher.getJSON(t.Context(), srv.URL, &v)
+	var statusErr *httpx.StatusError
+	if !errors.As(err, &statusErr) || statusErr.StatusCode != http.StatusTooManyRequests {
+		t.Errorf("getJSON error = %v; want a 429 StatusError", err)
+	}
+}
@@ -0,0 +1,67 @@
+package reddit
+
+import (
+	"cmp"
+	"slices"
+	"time"
+
+	"github.com/0xjuanma/golazo/internal/api"
+)
+
+// GoalInfos returns the goals of a match to search clips for, in match order, each with
+// the running score it made.
+func GoalInfos(details *api.MatchDetails) []GoalInfo {
+	var events []api.MatchEvent
+	for _, event := range details.Events {
+		if event.Type == "goal" {
+			events = append(events, event)
+		}
+	}
+	slices.SortStableFunc(events, func(a, b api.MatchEvent) int {
+		if c := a.Timestamp.Compare(b.Timestamp); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.Minute, b.Minute)
+	})
+
+	var goals []GoalInfo
+	homeScore, awayScore := 0, 0
+	for _, event := range events {
+		scorer := ""
+		if event.Player != nil {
+			scorer = *event.Player
+		}
+
+		// Own goals are credited to the team they count for
+		isHome := event.Team.ID == details.HomeTeam.ID
+		if isHome {
+			homeScore++
+		} else {
+			awayScore++
+		}
+
+		// Get match time for date-based Reddit search
+		matchTime := time.Now() // Default to now for live matches
+		if details.MatchTime != nil {
+			matchTime = *details.MatchTime
+		}
+
+		goals = append(goals, GoalInfo{
+			MatchID:       details.ID,
+			HomeTeam:      details.HomeTeam.Name,
+			AwayTeam:      details.AwayTeam.Name,
+			HomeTeamShort: details.HomeTeam.ShortName,
+			AwayTeamShort: details.AwayTeam.ShortName,
+			ScorerName:    scorer,
+			Minute:        event.Minute,
+			DisplayMinute: event.DisplayMinute,
+			HomeScore:     homeScore,
+			AwayScore:     awayScore,
+			IsHomeTeam:    isHome,
+			IsFinished:    details.Status == api.MatchStatusFinished,
+			MatchTime:     matchTime,
+			LeagueID:      details.League.ID,
+		})
+	}
+	return goals
+}
@@ -3,112 +3,236 @@ package reddit
 import (
 	"fmt"
 	"regexp"
+	"slices"
 	"strconv"
 	"strings"
 	"time"
+
+	"github.com/0xjuanma/golazo/internal/api"
+	"github.com/0xjuanma/golazo/internal/data"
 )
 
-// Matcher provides loose matching for Reddit goal post titles.
-// Example titles:
+// Matcher scores Reddit search results against a goal. The client uses
+// NewMatcher(DefaultWeights) unless SetMatcher gives it another, so matching can be
+// tuned and tested on its own. Example titles:
 //   - "Wolves [3] - 0 West Ham - Mateus Mane 41'"
 //   - "Manchester United [2] - 1 Liverpool - Marcus Rashford 67'"
 //   - "Barcelona 0 - [1] Real Madrid - Vinicius Jr 89'"
+type Matcher interface {
+	// Score scores each result against the goal, in order.
+	Score(results []SearchResult, goal GoalInfo) []ScoredResult
+}
 
-// findBestMatch finds the best matching search result for a goal.
-// Uses loose matching: checks for team names, minute, and date proximity.
-func findBestMatch(results []SearchResult, goal GoalInfo) *SearchResult {
-	if len(results) == 0 {
-		return nil
-	}
+// ScoredResult is a search result scored against a goal.
+type ScoredResult struct {
+	SearchResult
+	MatchScore int    // Match score, plus the ranking bonus when accepted
+	Rejected   string // Why it is not a clip of the goal; empty when accepted
+}
 
-	// Normalize team names for comparison
-	homeNorm := normalizeTeamName(goal.HomeTeam)
-	awayNorm := normalizeTeamName(goal.AwayTeam)
-	minutePattern := buildMinutePattern(goal)
+// Weights are the points a search result earns or loses per matching signal. A result
+// needs MinScore from the match signals to count as a clip of the goal; the ranking
+// bonuses then only order the accepted ones.
+type Weights struct {
+	// Match signals
+	TeamName      int // Per team name (or catalogue alias) in the title; one is required
+	Minute        int // Goal minute (±2) in the title
+	ScoreMatch    int // Running score at the goal in the title
+	ScoreMismatch int // Subtracted when the title has another score: likely another goal
+	NoScore       int // Subtracted when the title has no score
+	ScoringSide   int // The scoring team's score is bracketed
+	WrongSide     int // Subtracted when the other team's score is bracketed
+	Scorer        int // Scorer's name in the title
+	NearKickoff   int // Posted from 6h before to 12h after kickoff
+	MaxUpvotes    int // One point per 100 upvotes, up to this
+	MinScore      int // Needed to count as a clip of the goal
+
+	// Ranking bonuses
+	MaxUpvoteRatio int // Upvote ratio of 1.0 (no downvotes); ratios at or below 0.5 earn nothing
+	MaxRecency     int // Posted within recencyWindow after the goal
+	EarlyPost      int // Subtracted when posted before the goal happened (likely another goal)
+	MaxSimilarity  int // Every team/scorer token found in the title
+	TrustedHost    int // Clip hosted on a known-good mirror
+}
 
-	// Build score pattern for validation (e.g., "1-0", "2-1", etc.)
-	scorePattern := buildScorePattern(goal.HomeScore, goal.AwayScore)
+// DefaultWeights are the weights golazo matches goal links with.
+var DefaultWeights = Weights{
+	TeamName:      10,
+	Minute:        25,
+	ScoreMatch:    25,
+	ScoreMismatch: 30,
+	NoScore:       15,
+	ScoringSide:   5,
+	WrongSide:     10,
+	Scorer:        15,
+	NearKickoff:   5,
+	MaxUpvotes:    5,
+	MinScore:      45, // Score match + minute match, or either with both team names and more
+
+	MaxUpvoteRatio: 5,
+	MaxRecency:     10,
+	EarlyPost:      5,
+	MaxSimilarity:  10,
+	TrustedHost:    5,
+}
 
-	var bestMatch *SearchResult
-	bestScore := 0
+// defaultMatcher matches with DefaultWeights.
+var defaultMatcher = NewMatcher(DefaultWeights)
 
-	for i := range results {
-		result := &results[i]
-		titleLower := strings.ToLower(result.Title)
+// NewMatcher returns a Matcher scoring results with weights.
+func NewMatcher(weights Weights) Matcher {
+	return weightedMatcher{weights: weights}
+}
 
-		score := 0
+// weightedMatcher is the Matcher of NewMatcher.
+type weightedMatcher struct {
+	weights Weights
+}
 
-		// Filter by date: post must be within reasonable time of match
-		// Allow posts from 1 day before to 2 days after the match
-		if !goal.MatchTime.IsZero() {
-			postDate := result.CreatedAt
-			matchStart := goal.MatchTime.Add(-24 * time.Hour)
-			matchEnd := goal.MatchTime.Add(48 * time.Hour)
+// Score scores each result against the goal: the match signals, then the ranking bonus
+// when the result is accepted.
+func (w weightedMatcher) Score(results []SearchResult, goal GoalInfo) []ScoredResult {
+	m := newGoalMatcher(goal, w.weights)
+	scored := make([]ScoredResult, len(results))
+	for i, result := range results {
+		score, rejected := m.score(result)
+		if rejected == "" {
+			score += rankingBonus(result, goal, w.weights)
+		}
+		scored[i] = ScoredResult{SearchResult: result, MatchScore: score, Rejected: rejected}
+	}
+	return scored
+}
 
-			if postDate.Before(matchStart) || postDate.After(matchEnd) {
-				continue // Post is outside the valid date range
-			}
+// FindBestMatch returns the best clip of the goal among the results, or nil when none
+// is accepted.
+func FindBestMatch(m Matcher, results []SearchResult, goal GoalInfo) *SearchResult {
+	ranked := RankMatches(m, results, goal)
+	if len(ranked) == 0 {
+		return nil
+	}
+	return &ranked[0].SearchResult
+}
 
-			// Bonus for posts very close to match time (within 12 hours)
-			if postDate.After(goal.MatchTime.Add(-6*time.Hour)) && postDate.Before(goal.MatchTime.Add(12*time.Hour)) {
-				score += 5
-			}
+// RankMatches returns every result the matcher accepts as a clip of the goal, best first.
+// Results sharing a URL are kept once, with their best score.
+func RankMatches(m Matcher, results []SearchResult, goal GoalInfo) []ScoredResult {
+	if len(results) == 0 {
+		return nil
+	}
+
+	var ranked []ScoredResult
+	seen := make(map[string]int) // URL -> index in ranked
+	for _, result := range m.Score(results, goal) {
+		if result.Rejected != "" {
+			continue
 		}
+		if j, ok := seen[result.URL]; ok {
+			ranked[j].MatchScore = max(ranked[j].MatchScore, result.MatchScore)
+			continue
+		}
+		seen[result.URL] = len(ranked)
+		ranked = append(ranked, result)
+	}
 
-		// Check for team names (required)
-		homeFound := containsTeamName(titleLower, homeNorm)
-		awayFound := containsTeamName(titleLower, awayNorm)
+	// Stable sort keeps the search order among equal scores, as before
+	slices.SortStableFunc(ranked, func(a, b ScoredResult) int {
+		return b.MatchScore - a.MatchScore
+	})
+	return ranked
+}
 
-		if !homeFound && !awayFound {
-			continue // Must have at least one team name
-		}
+// goalMatcher scores search results against one goal.
+type goalMatcher struct {
+	goal          GoalInfo
+	weights       Weights
+	homeNorm      string
+	awayNorm      string
+	homeAliases   []string // Normalized catalogue aliases (e.g., "spurs"), matched as whole words
+	awayAliases   []string
+	scorerNorm    string
+	hasScorer     bool
+	minutePattern *regexp.Regexp
+}
+
+// newGoalMatcher prepares the normalized names and patterns for a goal.
+func newGoalMatcher(goal GoalInfo, weights Weights) goalMatcher {
+	m := goalMatcher{
+		goal:          goal,
+		weights:       weights,
+		homeNorm:      normalizeTeamName(goal.HomeTeam),
+		awayNorm:      normalizeTeamName(goal.AwayTeam),
+		homeAliases:   teamAliases(goal.HomeTeam, goal.HomeTeamShort),
+		awayAliases:   teamAliases(goal.AwayTeam, goal.AwayTeamShort),
+		minutePattern: buildMinutePattern(goal),
+	}
+	if goal.ScorerName != "" {
+		m.scorerNorm = normalizeName(goal.ScorerName)
+		m.hasScorer = true
+	}
+	return m
+}
 
-		if homeFound {
-			score += 10
+// score returns a result's match score (without rankingBonus). rejected explains
+// why the result is not a clip of the goal, or is empty when it matches.
+func (m goalMatcher) score(result SearchResult) (score int, rejected string) {
+	goal, w := m.goal, m.weights
+	titleLower := strings.ToLower(result.Title)
+
+	// Filter by date: post must be within reasonable time of match
+	// Allow posts from 1 day before to 2 days after the match, or the wider search window
+	if !goal.MatchTime.IsZero() {
+		postDate := result.CreatedAt
+		window := goal.Window
+		if window == (SearchWindow{}) {
+			window = DefaultSearchWindow
 		}
-		if awayFound {
-			score += 10
+		if !window.accepts(postDate, goal.MatchTime) {
+			return 0, "outside match dates"
 		}
 
-		// Check for minute (highly valuable, but strict)
-		minuteFound := minutePattern.MatchString(result.Title)
-		if minuteFound {
-			score += 25
+		// Bonus for posts very close to match time
+		if postDate.After(goal.MatchTime.Add(-6*time.Hour)) && postDate.Before(goal.MatchTime.Add(12*time.Hour)) {
+			score += w.NearKickoff
 		}
+	}
 
-		// Check for score match (required for high confidence)
-		scoreMatch := scorePattern.MatchString(result.Title)
-		if scoreMatch {
-			score += 20 // High bonus for score match
-		} else {
-			// If score doesn't match, heavily penalize this result
-			score -= 15
-		}
+	// Check for team names (required)
+	homeFound := containsTeamName(titleLower, m.homeNorm) || containsAlias(titleLower, m.homeAliases)
+	awayFound := containsTeamName(titleLower, m.awayNorm) || containsAlias(titleLower, m.awayAliases)
 
-		// Check for scorer name if available
-		if goal.ScorerName != "" {
-			scorerNorm := normalizeName(goal.ScorerName)
-			if containsName(titleLower, scorerNorm) {
-				score += 15
-			}
-		}
+	if !homeFound && !awayFound {
+		return score, "no team name" // Must have at least one team name
+	}
 
-		// Prefer higher Reddit score (upvotes) as tiebreaker
-		score += min(result.Score/100, 5) // Max 5 points from upvotes
+	if homeFound {
+		score += w.TeamName
+	}
+	if awayFound {
+		score += w.TeamName
+	}
 
-		if score > bestScore {
-			bestScore = score
-			bestMatch = result
-		}
+	// Check for minute (highly valuable, but strict)
+	if m.minutePattern.MatchString(result.Title) {
+		score += w.Minute
 	}
 
-	// Require minimum score for a match, with higher requirement for score matches
-	minScore := 45 // Require score match + minute match + team names
-	if bestScore < minScore {
-		return nil
+	// Check the score line against the running score (required for high confidence)
+	score += scoreSignal(result.Title, goal, w)
+
+	// Check for scorer name if available
+	if m.hasScorer && containsName(titleLower, m.scorerNorm) {
+		score += w.Scorer
 	}
 
-	return bestMatch
+	// Prefer higher Reddit score (upvotes) as tiebreaker
+	score += min(result.Score/100, w.MaxUpvotes)
+
+	// Require minimum score for a match, with higher requirement for score matches
+	if score < w.MinScore {
+		return score, fmt.Sprintf("score %d < %d", score, w.MinScore)
+	}
+	return score, ""
 }
 
 // normalizeTeamName converts a team name to a normalized form for matching.
@@ -134,6 +258,35 @@ func normalizeTeamName(name string) string {
 	return strings.TrimSpace(norm)
 }
 
+// teamAliases returns the normalized catalogue names of a team other than its own,
+// e.g. "spurs" and "thfc" for Tottenham Hotspur.
+func teamAliases(name, shortName string) []string {
+	entry, ok := data.CurrentCatalogue().Team(api.Team{Name: name, ShortName: shortName})
+	if !ok {
+		return nil
+	}
+	own := normalizeTeamName(name)
+	var aliases []string
+	for _, alias := range entry.Names() {
+		if norm := normalizeTeamName(alias); norm != "" && norm != own && !slices.Contains(aliases, norm) {
+			aliases = append(aliases, norm)
+		}
+	}
+	return aliases
+}
+
+// containsAlias checks if a title contains one of the aliases as whole words, so
+// short aliases like "om" don't match inside other words.
+func containsAlias(title string, aliases []string) bool {
+	padded := " " + normalizeTeamName(title) + " "
+	for _, alias := range aliases {
+		if strings.Contains(padded, " "+alias+" ") {
+			return true
+		}
+	}
+	return false
+}
+
 // normalizeName converts a player name to a normalized form for matching.
 func normalizeName(name string) string {
 	norm := strings.ToLower(name)
@@ -250,20 +403,6 @@ func buildMinutePattern(goal GoalInfo) *regexp.Regexp {
 	return compiled
 }
 
-// buildScorePattern creates a regex to match the score at the time of goal.
-// Matches various score formats like "1-0", "2-1", "[1-0]", etc.
-func buildScorePattern(homeScore, awayScore int) *regexp.Regexp {
-	scoreStr := fmt.Sprintf("%d-%d", homeScore, awayScore)
-	// Match score in various formats: "1-0", "[1-0]", "(1-0)", "1-0", etc.
-	patternStr := `[\[\(\s]*` + regexp.QuoteMeta(scoreStr) + `[\]\)\s]*`
-	compiled, err := regexp.Compile(patternStr)
-	if err != nil {
-		// Fallback to exact match
-		return regexp.MustCompile(regexp.QuoteMeta(scoreStr))
-	}
-	return compiled
-}
-
 // MatchConfidence represents how confident we are in a match.
 type MatchConfidence int
 
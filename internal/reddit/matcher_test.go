@@ -0,0 +1,122 @@
+package reddit_test
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/0xjuanma/golazo/internal/reddit"
+)
+
+// corpus pairs real goals with titles in r/soccer's format: clips of the goal, which must be
+// accepted, and posts about the same match that are not, which must be rejected.
+// Tune DefaultWeights against it; every case should keep passing.
+var corpus = []struct {
+	goal     reddit.GoalInfo
+	accepted []string
+	rejected []string
+}{
+	{
+		goal: reddit.GoalInfo{
+			HomeTeam: "Manchester City", AwayTeam: "Queens Park Rangers", HomeTeamShort: "Man City", AwayTeamShort: "QPR",
+			ScorerName: "Sergio Agüero", Minute: 90, DisplayMinute: "90+4'", HomeScore: 3, AwayScore: 2, IsHomeTeam: true,
+		},
+		accepted: []string{
+			"Manchester City [3] - 2 QPR - Sergio Agüero 90+4'",
+			"Man City [3]-2 Queens Park Rangers - Aguero 94'",
+		},
+		rejected: []string{
+			"Manchester City [2] - 2 QPR - Edin Dzeko 90+2'",
+			"Post Match Thread: Manchester City 3-2 QPR",
+		},
+	},
+	{
+		goal: reddit.GoalInfo{
+			HomeTeam: "Liverpool", AwayTeam: "Barcelona",
+			ScorerName: "Divock Origi", Minute: 79, DisplayMinute: "79'", HomeScore: 4, AwayScore: 0, IsHomeTeam: true,
+		},
+		accepted: []string{
+			"Liverpool [4] - 0 Barcelona - Divock Origi 79'",
+			"Liverpool [4]-0 Barcelona [4-3 on agg.] - Origi 79'",
+		},
+		rejected: []string{
+			"Liverpool [3] - 0 Barcelona - Georginio Wijnaldum 56'",
+			"Match Thread: Liverpool vs Barcelona [UEFA Champions League]",
+		},
+	},
+	{
+		goal: reddit.GoalInfo{
+			HomeTeam: "Ajax", AwayTeam: "Tottenham Hotspur", AwayTeamShort: "Tottenham",
+			ScorerName: "Lucas Moura", Minute: 90, DisplayMinute: "90+6'", HomeScore: 2, AwayScore: 3, IsHomeTeam: false,
+		},
+		accepted: []string{
+			"Ajax 2 - [3] Tottenham - Lucas Moura 90+6'",
+			"Ajax 2-[3] Tottenham Hotspur - Lucas Moura hat-trick 96'",
+		},
+		rejected: []string{
+			"Ajax 2 - [2] Tottenham - Lucas Moura 59'",
+		},
+	},
+	{
+		goal: reddit.GoalInfo{
+			HomeTeam: "Barcelona", AwayTeam: "Paris Saint-Germain", AwayTeamShort: "PSG",
+			ScorerName: "Sergi Roberto", Minute: 90, DisplayMinute: "90+5'", HomeScore: 6, AwayScore: 1, IsHomeTeam: true,
+		},
+		accepted: []string{
+			"Barcelona [6] - 1 PSG - Sergi Roberto 90+5'",
+		},
+		rejected: []string{
+			"Barcelona [5] - 1 PSG - Neymar 90+1'",
+			"Barcelona 0 - 1 PSG - Edinson Cavani 62'",
+		},
+	},
+}
+
+func TestMatcherCorpus(t *testing.T) {
+	matcher := reddit.NewMatcher(reddit.DefaultWeights)
+	for _, c := range corpus {
+		var results []reddit.SearchResult
+		for _, title := range append(append([]string{}, c.accepted...), c.rejected...) {
+			results = append(results, reddit.SearchResult{Title: title, URL: "https://v.redd.it/" + title})
+		}
+
+		scored := matcher.Score(results, c.goal)
+		for i, result := range scored {
+			wantAccepted := i < len(c.accepted)
+			if accepted := result.Rejected == ""; accepted != wantAccepted {
+				t.Errorf("%q: accepted = %v (score %d, %s); want %v", result.Title, accepted, result.MatchScore, result.Rejected, wantAccepted)
+			}
+		}
+
+		best := reddit.FindBestMatch(matcher, results, c.goal)
+		if best == nil || !slices.Contains(c.accepted, best.Title) {
+			t.Errorf("best match for %s %s = %v; want one of %q", c.goal.ScorerName, c.goal.DisplayMinute, best, c.accepted)
+		}
+	}
+}
+
+func TestMatcherWeights(t *testing.T) {
+	goal := reddit.GoalInfo{HomeTeam: "Liverpool", AwayTeam: "Barcelona", ScorerName: "Divock Origi", Minute: 79, HomeScore: 4, IsHomeTeam: true}
+	results := []reddit.SearchResult{
+		{Title: "Liverpool [4] - 0 Barcelona - Divock Origi 79'", URL: "https://v.redd.it/a", UpvoteRatio: 0.9},
+		{Title: "Liverpool [4] - 0 Barcelona - Origi 79'", URL: "https://example.com/b", UpvoteRatio: 0.9},
+	}
+
+	if ranked := reddit.RankMatches(reddit.NewMatcher(reddit.DefaultWeights), results, goal); len(ranked) != 2 || ranked[0].URL != "https://v.redd.it/a" {
+		t.Errorf("default weights ranked %+v; want both, trusted host first", ranked)
+	}
+
+	strict := reddit.DefaultWeights
+	strict.MinScore = 200
+	if ranked := reddit.RankMatches(reddit.NewMatcher(strict), results, goal); len(ranked) != 0 {
+		t.Errorf("MinScore 200 accepted %d results; want none", len(ranked))
+	}
+
+	noScorer := reddit.DefaultWeights
+	noScorer.Scorer = 0
+	noScorer.TrustedHost = 0
+	noScorer.MaxSimilarity = 0
+	ranked := reddit.RankMatches(reddit.NewMatcher(noScorer), results, goal)
+	if len(ranked) != 2 || ranked[0].MatchScore != ranked[1].MatchScore {
+		t.Errorf("without scorer, host and similarity weights ranked %+v; want a tie", ranked)
+	}
+}
@@ -0,0 +1,133 @@
+package reddit
+
+import (
+	"strings"
+	"time"
+)
+
+// Ranking windows (see recencyBonus).
+const (
+	recencyWindow = 20 * time.Minute
+	halfTimeBreak = 15 * time.Minute
+)
+
+// trustedMirrorHosts are clip hosts that reliably stay up and play in browsers and mpv.
+var trustedMirrorHosts = map[string]bool{
+	"v.redd.it":      true,
+	"streamin.one":   true,
+	"streamin.me":    true,
+	"streamable.com": true,
+	"streamff.com":   true,
+	"streamja.com":   true,
+	"dubz.link":      true,
+	"dubz.co":        true,
+}
+
+// rankingBonus scores how good a matching result is as the goal's clip, combining
+// upvote ratio, post time relative to the goal, title similarity and mirror host. It
+// orders the results that already passed the acceptance score and never decides whether
+// a post is a clip of the goal, only which mirror wins.
+func rankingBonus(result SearchResult, goal GoalInfo, w Weights) int {
+	return upvoteRatioBonus(result.UpvoteRatio, w) +
+		recencyBonus(result.CreatedAt, goal, w) +
+		int(titleSimilarity(result.Title, goal)*float64(w.MaxSimilarity)) +
+		hostBonus(result.URL, w)
+}
+
+// upvoteRatioBonus rewards posts without controversy; ratios at or below 0.5 earn nothing.
+func upvoteRatioBonus(ratio float64, w Weights) int {
+	if ratio <= 0.5 {
+		return 0
+	}
+	return int((ratio - 0.5) * 2 * float64(w.MaxUpvoteRatio))
+}
+
+// recencyBonus rewards posts made soon after the goal. The goal's wall-clock time is
+// estimated from kickoff, its minute and the half-time break.
+func recencyBonus(posted time.Time, goal GoalInfo, w Weights) int {
+	if goal.MatchTime.IsZero() || posted.IsZero() {
+		return 0
+	}
+	goalTime := goal.MatchTime.Add(time.Duration(goal.Minute) * time.Minute)
+	if goal.Minute > 45 {
+		goalTime = goalTime.Add(halfTimeBreak)
+	}
+
+	delay := posted.Sub(goalTime)
+	switch {
+	case delay < -5*time.Minute:
+		return -w.EarlyPost
+	case delay <= recencyWindow:
+		return w.MaxRecency
+	case delay <= 3*recencyWindow:
+		return w.MaxRecency / 2
+	default:
+		return 0
+	}
+}
+
+// hostBonus rewards clips on known-good mirror hosts.
+func hostBonus(link string, w Weights) int {
+	if trustedMirrorHosts[mirrorHost(link)] {
+		return w.TrustedHost
+	}
+	return 0
+}
+
+// titleSimilarity returns the fraction (0-1) of the goal's team and scorer tokens found
+// in the title. Tokens of 5+ letters also match at edit distance 1 to absorb typos and
+// accents dropped by normalization ("Mbappé" becomes "mbapp", one edit from "mbappe").
+func titleSimilarity(title string, goal GoalInfo) float64 {
+	want := tokens(goal.HomeTeam + " " + goal.AwayTeam + " " + goal.ScorerName)
+	if len(want) == 0 {
+		return 0
+	}
+	have := tokens(title)
+
+	found := 0
+	for _, w := range want {
+		for _, h := range have {
+			if w == h || (len(w) >= 5 && levenshtein(w, h) <= 1) {
+				found++
+				break
+			}
+		}
+	}
+	return float64(found) / float64(len(want))
+}
+
+// tokens splits s into normalized words of 3+ letters, dropping duplicates.
+func tokens(s string) []string {
+	var result []string
+	seen := make(map[string]bool)
+	for _, word := range strings.Fields(normalizeName(s)) {
+		if len(word) < 3 || seen[word] {
+			continue
+		}
+		seen[word] = true
+		result = append(result, word)
+	}
+	return result
+}
+
+// levenshtein returns the edit distance between a and b.
+func levenshtein(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+	prev := make([]int, len(rb)+1)
+	curr := make([]int, len(rb)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(ra); i++ {
+		curr[0] = i
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			curr[j] = min(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+		}
+		prev, curr = curr, prev
+	}
+	return prev[len(rb)]
+}
@@ -0,0 +1,57 @@
+package reddit
+
+import (
+	"regexp"
+	"strconv"
+)
+
+// titleScorePattern finds the score line of a goal post title, e.g. "Wolves [3] - 0 West Ham",
+// "Barcelona 0 - [1] Real Madrid" or "Arsenal [2-1] Chelsea". Scores run into no other
+// digits, so seasons ("2024-25") and dates don't match.
+var titleScorePattern = regexp.MustCompile(`(?:^|[^\d\[])(\[)?(\d{1,2})(\])?\s*[-–]\s*(\[)?(\d{1,2})(\])?(?:$|[^\d])`)
+
+// titleScore is the score line of a post title. r/soccer titles bracket the score of
+// the team that just scored.
+type titleScore struct {
+	home, away int
+	homeMarked bool // "[3] - 0": the home team scored
+	awayMarked bool // "0 - [1]": the away team scored
+}
+
+// parseTitleScore returns the first score line of a post title.
+func parseTitleScore(title string) (titleScore, bool) {
+	match := titleScorePattern.FindStringSubmatch(title)
+	if match == nil {
+		return titleScore{}, false
+	}
+	home, _ := strconv.Atoi(match[2])
+	away, _ := strconv.Atoi(match[5])
+	return titleScore{
+		home:       home,
+		away:       away,
+		homeMarked: match[1] != "" && match[3] != "",
+		awayMarked: match[4] != "" && match[6] != "",
+	}, true
+}
+
+// scoreSignal scores how well a title's score line fits the running score at the goal:
+// the right score is the strongest sign the clip shows this goal and not another of the
+// match, and a bracket on the scoring team confirms it.
+func scoreSignal(title string, goal GoalInfo, w Weights) int {
+	ts, ok := parseTitleScore(title)
+	if !ok {
+		return -w.NoScore
+	}
+	if ts.home != goal.HomeScore || ts.away != goal.AwayScore {
+		return -w.ScoreMismatch
+	}
+	signal := w.ScoreMatch
+	if ts.homeMarked != ts.awayMarked {
+		if ts.homeMarked == goal.IsHomeTeam {
+			signal += w.ScoringSide
+		} else {
+			signal -= w.WrongSide
+		}
+	}
+	return signal
+}
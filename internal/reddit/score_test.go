@@ -0,0 +1,84 @@
+package reddit
+
+import (
+	"testing"
+	"time"
+
+	"github.com/0xjuanma/golazo/internal/api"
+)
+
+func TestParseTitleScore(t *testing.T) {
+	tests := []struct {
+		title string
+		want  titleScore
+		ok    bool
+	}{
+		{"Wolves [3] - 0 West Ham - Mateus Mane 41'", titleScore{home: 3, away: 0, homeMarked: true}, true},
+		{"Barcelona 0 - [1] Real Madrid - Vinicius Jr 89'", titleScore{home: 0, away: 1, awayMarked: true}, true},
+		{"Manchester United [2]-1 Liverpool - Marcus Rashford 67'", titleScore{home: 2, away: 1, homeMarked: true}, true},
+		{"Arsenal [2-1] Chelsea - Saka 34'", titleScore{home: 2, away: 1}, true},
+		{"Arsenal 2 – [2] Chelsea - Palmer 90+3'", titleScore{home: 2, away: 2, awayMarked: true}, true},
+		{"[1] - 0 Inter vs Milan", titleScore{home: 1, away: 0, homeMarked: true}, true},
+		{"Great goal by Saka in the 2024-25 season 34'", titleScore{}, false},
+		{"Saka stunner vs Chelsea 34'", titleScore{}, false},
+	}
+
+	for _, tt := range tests {
+		got, ok := parseTitleScore(tt.title)
+		if ok != tt.ok || got != tt.want {
+			t.Errorf("parseTitleScore(%q) = %+v, %v; want %+v, %v", tt.title, got, ok, tt.want, tt.ok)
+		}
+	}
+}
+
+func TestScoreSignal(t *testing.T) {
+	goal := GoalInfo{HomeScore: 1, AwayScore: 1, IsHomeTeam: false}
+	w := DefaultWeights
+	tests := []struct {
+		title string
+		want  int
+		desc  string
+	}{
+		{"Arsenal 1 - [1] Chelsea - Palmer 58'", w.ScoreMatch + w.ScoringSide, "score and scoring team"},
+		{"Arsenal [1-1] Chelsea - Palmer 58'", w.ScoreMatch, "score without scoring team"},
+		{"Arsenal [1] - 1 Chelsea - Palmer 58'", w.ScoreMatch - w.WrongSide, "other team bracketed"},
+		{"Arsenal [1] - 0 Chelsea - Saka 12'", -w.ScoreMismatch, "earlier goal"},
+		{"Palmer equaliser vs Arsenal 58'", -w.NoScore, "no score"},
+	}
+
+	for _, tt := range tests {
+		if got := scoreSignal(tt.title, goal, w); got != tt.want {
+			t.Errorf("scoreSignal(%q) = %d; want %d - %s", tt.title, got, tt.want, tt.desc)
+		}
+	}
+}
+
+func TestGoalInfosRunningScore(t *testing.T) {
+	home, away := api.Team{ID: 1, Name: "Arsenal"}, api.Team{ID: 2, Name: "Chelsea"}
+	kickoff := time.Date(2025, 3, 1, 15, 0, 0, 0, time.UTC)
+	goal := func(minute int, team api.Team) api.MatchEvent {
+		return api.MatchEvent{Type: "goal", Minute: minute, Team: team, Timestamp: kickoff.Add(time.Duration(minute) * time.Minute)}
+	}
+	homeScore, awayScore := 2, 1
+	details := &api.MatchDetails{
+		Match: api.Match{ID: 7, HomeTeam: home, AwayTeam: away, HomeScore: &homeScore, AwayScore: &awayScore, MatchTime: &kickoff},
+		Events: []api.MatchEvent{
+			goal(80, home),
+			goal(12, home),
+			{Type: "card", Minute: 30, Team: away},
+			goal(58, away),
+		},
+	}
+
+	want := []struct{ minute, home, away int }{{12, 1, 0}, {58, 1, 1}, {80, 2, 1}}
+	goals := GoalInfos(details)
+	if len(goals) != len(want) {
+		t.Fatalf("GoalInfos returned %d goals; want %d", len(goals), len(want))
+	}
+	for i, w := range want {
+		g := goals[i]
+		if g.Minute != w.minute || g.HomeScore != w.home || g.AwayScore != w.away {
+			t.Errorf("goal %d = %d' %d-%d; want %d' %d-%d", i, g.Minute, g.HomeScore, g.AwayScore, w.minute, w.home, w.away)
+		}
+	}
+}
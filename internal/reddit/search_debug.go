@@ -0,0 +1,104 @@
+package reddit
+
+import (
+	"slices"
+	"sync"
+	"time"
+)
+
+// Search debug settings.
+const (
+	maxSearchTraces     = 50 // Goal searches kept for the debug panel
+	maxTraceCandidates  = 3  // Top candidates kept per query
+	strategyTeams       = "teams + minute"
+	strategyScorer      = "scorer + minute"
+	strategyScoringTeam = "scoring team + minute"
+	strategyShortNames  = "short names, top"
+)
+
+// SearchTrace is a structured record of one goal link search, kept for the
+// Reddit search debug panel: every query sent and which strategy won.
+type SearchTrace struct {
+	Goal     GoalInfo
+	At       time.Time
+	Attempts []SearchAttempt
+	Winner   string // Strategy whose results produced the link; empty when nothing matched
+	URL      string
+	matcher  Matcher // Scores the candidates
+}
+
+// SearchAttempt is one query sent to Reddit (or the archive).
+type SearchAttempt struct {
+	Strategy   string
+	Query      string
+	Sort       string
+	Results    int
+	Err        string
+	Candidates []SearchCandidate // Best scoring results, best first
+}
+
+// SearchCandidate is a search result with its match score.
+type SearchCandidate struct {
+	Title    string
+	Score    int    // Match score, plus the ranking bonus when accepted
+	Rejected string // Why it is not a clip of the goal; empty when accepted
+}
+
+// searchTraces keeps the most recent goal searches.
+type searchTraces struct {
+	mu     sync.Mutex
+	traces []SearchTrace
+}
+
+// add records a finished search, dropping the oldest beyond maxSearchTraces.
+func (t *searchTraces) add(trace SearchTrace) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.traces = append(t.traces, trace)
+	if excess := len(t.traces) - maxSearchTraces; excess > 0 {
+		t.traces = slices.Delete(t.traces, 0, excess)
+	}
+}
+
+// SearchTraces returns the recent goal link searches for a match, newest first.
+func (c *Client) SearchTraces(matchID int) []SearchTrace {
+	c.traces.mu.Lock()
+	defer c.traces.mu.Unlock()
+
+	var traces []SearchTrace
+	for i := len(c.traces.traces) - 1; i >= 0; i-- {
+		if c.traces.traces[i].Goal.MatchID == matchID {
+			traces = append(traces, c.traces.traces[i])
+		}
+	}
+	return traces
+}
+
+// attempt records a query and its best candidates.
+func (t *SearchTrace) attempt(strategy, query, sort string, results []SearchResult, err error) {
+	a := SearchAttempt{Strategy: strategy, Query: query, Sort: sort, Results: len(results)}
+	if err != nil {
+		a.Err = err.Error()
+	} else {
+		a.Candidates = scoreCandidates(t.matcher, results, t.Goal)
+	}
+	t.Attempts = append(t.Attempts, a)
+}
+
+// won records the strategy that produced the link.
+func (t *SearchTrace) won(strategy string, ranked []ScoredResult) {
+	t.Winner = strategy
+	t.URL = ranked[0].URL
+}
+
+// scoreCandidates scores every result against the goal and returns the best few.
+func scoreCandidates(m Matcher, results []SearchResult, goal GoalInfo) []SearchCandidate {
+	candidates := make([]SearchCandidate, 0, len(results))
+	for _, result := range m.Score(results, goal) {
+		candidates = append(candidates, SearchCandidate{Title: result.Title, Score: result.MatchScore, Rejected: result.Rejected})
+	}
+	slices.SortStableFunc(candidates, func(a, b SearchCandidate) int {
+		return b.Score - a.Score
+	})
+	return candidates[:min(len(candidates), maxTraceCandidates)]
+}
@@ -0,0 +1,166 @@
+package reddit
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// MatchThread is the official r/soccer Match Thread for a fixture.
+type MatchThread struct {
+	ID        string // Reddit post ID (e.g., "1abc2d")
+	Title     string
+	URL       string // Full permalink
+	CreatedAt time.Time
+}
+
+// Comment is a top-level comment in a thread.
+type Comment struct {
+	ID        string
+	Author    string
+	Body      string
+	Score     int
+	CreatedAt time.Time
+}
+
+// ThreadFetcher is implemented by fetchers that can look up match threads and their comments.
+type ThreadFetcher interface {
+	FindMatchThread(ctx context.Context, homeTeam, awayTeam string, matchTime time.Time) (*MatchThread, error)
+	Comments(ctx context.Context, threadID string, limit int) ([]Comment, error)
+}
+
+// threadSearchResponse is the search listing for match thread lookups.
+type threadSearchResponse struct {
+	Data struct {
+		Children []struct {
+			Data struct {
+				ID         string  `json:"id"`
+				Title      string  `json:"title"`
+				Permalink  string  `json:"permalink"`
+				CreatedUTC float64 `json:"created_utc"`
+			} `json:"data"`
+		} `json:"children"`
+	} `json:"data"`
+}
+
+// commentListing is one listing from a post's comments endpoint.
+// The endpoint returns [post listing, comments listing].
+type commentListing struct {
+	Data struct {
+		Children []struct {
+			Kind string `json:"kind"` // "t1" for comments, "more" for collapsed
+			Data struct {
+				ID         string  `json:"id"`
+				Author     string  `json:"author"`
+				Body       string  `json:"body"`
+				Score      int     `json:"score"`
+				CreatedUTC float64 `json:"created_utc"`
+				Stickied   bool    `json:"stickied"`
+			} `json:"data"`
+		} `json:"children"`
+	} `json:"data"`
+}
+
+// FindMatchThread searches r/soccer for the Match Thread of homeTeam vs awayTeam
+// posted within 12 hours of matchTime. Pre- and post-match threads are ignored.
+// Returns nil without error when no thread is found.
+func (f *PublicJSONFetcher) FindMatchThread(ctx context.Context, homeTeam, awayTeam string, matchTime time.Time) (*MatchThread, error) {
+	query := fmt.Sprintf(`title:"Match Thread" %s %s`, homeTeam, awayTeam)
+	searchURL := fmt.Sprintf(
+		"https://www.reddit.com/r/soccer/search.json?q=%s&restrict_sr=on&sort=new&t=week&limit=25",
+		url.QueryEscape(query),
+	)
+
+	var resp threadSearchResponse
+	if err := breaker.Do(func() error { return f.getJSON(ctx, searchURL, &resp) }); err != nil {
+		return nil, err
+	}
+
+	homeNorm := normalizeTeamName(homeTeam)
+	awayNorm := normalizeTeamName(awayTeam)
+	for _, child := range resp.Data.Children {
+		post := child.Data
+		titleLower := strings.ToLower(post.Title)
+		if !strings.HasPrefix(titleLower, "match thread") {
+			continue // Skips "Pre-Match Thread" and "Post Match Thread"
+		}
+		created := time.Unix(int64(post.CreatedUTC), 0)
+		if created.Sub(matchTime).Abs() > 12*time.Hour {
+			continue
+		}
+		if !containsTeamName(post.Title, homeNorm) || !containsTeamName(post.Title, awayNorm) {
+			continue
+		}
+		return &MatchThread{
+			ID:        post.ID,
+			Title:     post.Title,
+			URL:       "https://www.reddit.com" + post.Permalink,
+			CreatedAt: created,
+		}, nil
+	}
+	return nil, nil
+}
+
+// Comments returns up to limit of the newest top-level comments in a thread, newest first.
+// Stickied moderator comments, deleted comments and collapsed "more" stubs are skipped.
+func (f *PublicJSONFetcher) Comments(ctx context.Context, threadID string, limit int) ([]Comment, error) {
+	commentsURL := fmt.Sprintf(
+		"https://www.reddit.com/r/soccer/comments/%s.json?sort=new&depth=1&limit=%d",
+		url.PathEscape(threadID), limit,
+	)
+
+	var listings []json.RawMessage
+	if err := breaker.Do(func() error { return f.getJSON(ctx, commentsURL, &listings) }); err != nil {
+		return nil, err
+	}
+	if len(listings) < 2 {
+		return nil, nil
+	}
+
+	var listing commentListing
+	if err := json.Unmarshal(listings[1], &listing); err != nil {
+		return nil, fmt.Errorf("parse comments: %w", err)
+	}
+
+	comments := make([]Comment, 0, len(listing.Data.Children))
+	for _, child := range listing.Data.Children {
+		c := child.Data
+		if child.Kind != "t1" || c.Stickied || c.Body == "[deleted]" || c.Body == "[removed]" {
+			continue
+		}
+		comments = append(comments, Comment{
+			ID:        c.ID,
+			Author:    c.Author,
+			Body:      c.Body,
+			Score:     c.Score,
+			CreatedAt: time.Unix(int64(c.CreatedUTC), 0),
+		})
+	}
+	return comments, nil
+}
+
+// FindMatchThread looks up the r/soccer Match Thread for a fixture.
+// Returns nil when none is found or the fetcher doesn't support threads.
+func (c *Client) FindMatchThread(ctx context.Context, homeTeam, awayTeam string, matchTime time.Time) (*MatchThread, error) {
+	tf, ok := c.fetcher.(ThreadFetcher)
+	if !ok {
+		return nil, nil
+	}
+	thread, err := tf.FindMatchThread(ctx, homeTeam, awayTeam, matchTime)
+	if err != nil {
+		c.debugLog(fmt.Sprintf("Match thread search failed for %s vs %s: %v", homeTeam, awayTeam, err))
+	}
+	return thread, err
+}
+
+// ThreadComments returns the newest top-level comments of a match thread.
+func (c *Client) ThreadComments(ctx context.Context, threadID string, limit int) ([]Comment, error) {
+	tf, ok := c.fetcher.(ThreadFetcher)
+	if !ok {
+		return nil, nil
+	}
+	return tf.Comments(ctx, threadID, limit)
+}
@@ -1,7 +1,11 @@
 // Package reddit provides functionality to fetch goal replay links from r/soccer.
 package reddit
 
-import "time"
+import (
+	"net/url"
+	"strings"
+	"time"
+)
 
 // GoalLink represents a cached goal replay link from Reddit.
 type GoalLink struct {
@@ -10,7 +14,83 @@ type GoalLink struct {
 	URL       string    `json:"url"`
 	Title     string    `json:"title"`
 	PostURL   string    `json:"post_url"`
+	VideoURL  string    `json:"video_url,omitempty"` // Direct MP4 for v.redd.it clips (playable in mpv etc.)
+	DashURL   string    `json:"dash_url,omitempty"`  // DASH manifest for v.redd.it clips (video + audio)
 	FetchedAt time.Time `json:"fetched_at"`
+	ExpiresAt time.Time `json:"expires_at,omitzero"` // Only set for "not found" markers
+
+	// LastUsedAt is when the link was last read or stored; least recently used links
+	// are evicted first when the cache is full.
+	LastUsedAt time.Time `json:"last_used_at,omitzero"`
+
+	// Score is the selected mirror's ranking score, kept for debugging match quality.
+	Score int `json:"score,omitempty"`
+
+	// Mirrors are all matching clips for the goal, best first. URL, PostURL, VideoURL
+	// and DashURL above always describe the selected mirror (the best one by default).
+	Mirrors []Mirror `json:"mirrors,omitempty"`
+}
+
+// Mirror is one candidate clip for a goal, usually from a separate r/soccer post.
+type Mirror struct {
+	URL      string `json:"url"`
+	Host     string `json:"host"`  // e.g., "streamin.one", "v.redd.it"
+	Score    int    `json:"score"` // Ranking score (acceptance score + ranking bonuses); higher is better
+	PostURL  string `json:"post_url"`
+	VideoURL string `json:"video_url,omitempty"`
+	DashURL  string `json:"dash_url,omitempty"`
+}
+
+// mirrorIndex returns the index of the selected mirror, or -1 if the link has none.
+func (l *GoalLink) mirrorIndex() int {
+	for i, m := range l.Mirrors {
+		if m.URL == l.URL {
+			return i
+		}
+	}
+	return -1
+}
+
+// selectMirror makes mirror i the link's selected URL.
+func (l *GoalLink) selectMirror(i int) {
+	m := l.Mirrors[i]
+	l.URL = m.URL
+	l.PostURL = m.PostURL
+	l.VideoURL = m.VideoURL
+	l.DashURL = m.DashURL
+	l.Score = m.Score
+}
+
+// NextMirror selects the next mirror, wrapping around to the best one, and returns it
+// with its 1-based position. Returns ok=false when the link has fewer than two mirrors.
+func (l *GoalLink) NextMirror() (mirror Mirror, position int, ok bool) {
+	if len(l.Mirrors) < 2 {
+		return Mirror{}, 0, false
+	}
+	next := (l.mirrorIndex() + 1) % len(l.Mirrors)
+	l.selectMirror(next)
+	return l.Mirrors[next], next + 1, true
+}
+
+// PlayableURL returns the best URL to hand to a media player.
+// Prefers the DASH manifest (includes audio), then the direct MP4, then the link itself.
+func (l GoalLink) PlayableURL() string {
+	if l.DashURL != "" {
+		return l.DashURL
+	}
+	if l.VideoURL != "" {
+		return l.VideoURL
+	}
+	return l.URL
+}
+
+// mirrorHost returns the host of a clip URL without the "www." prefix.
+func mirrorHost(link string) string {
+	u, err := url.Parse(link)
+	if err != nil {
+		return ""
+	}
+	return strings.TrimPrefix(u.Hostname(), "www.")
 }
 
 // GoalLinkKey creates a unique key for a goal (matchID + minute).
@@ -21,12 +101,15 @@ type GoalLinkKey struct {
 
 // SearchResult represents a Reddit search result from r/soccer.
 type SearchResult struct {
-	Title     string
-	URL       string // The media URL (video/gif link)
-	PostURL   string // The Reddit post URL
-	Flair     string // e.g., "Media"
-	CreatedAt time.Time
-	Score     int
+	Title       string
+	URL         string // The media URL (video/gif link)
+	PostURL     string // The Reddit post URL
+	VideoURL    string // Direct MP4 (v.redd.it fallback) when available
+	DashURL     string // DASH manifest for v.redd.it clips when available
+	Flair       string // e.g., "Media"
+	CreatedAt   time.Time
+	Score       int
+	UpvoteRatio float64 // Share of upvotes (0-1)
 }
 
 // redditSearchResponse represents the JSON structure from Reddit's search API.
@@ -46,42 +129,95 @@ type redditPost struct {
 	LinkFlairText string  `json:"link_flair_text"`
 	CreatedUTC    float64 `json:"created_utc"`
 	Score         int     `json:"score"`
+	UpvoteRatio   float64 `json:"upvote_ratio"`
 	Domain        string  `json:"domain"`
 	IsSelf        bool    `json:"is_self"`
 	// Media fields for various embed types
-	SecureMedia *struct {
-		RedditVideo *struct {
-			FallbackURL string `json:"fallback_url"`
-		} `json:"reddit_video"`
-	} `json:"secure_media"`
-	Preview *struct {
+	SecureMedia *redditMedia `json:"secure_media"`
+	Media       *redditMedia `json:"media"`
+	Preview     *struct {
 		Images []struct {
 			Source struct {
 				URL string `json:"url"`
 			} `json:"source"`
 		} `json:"images"`
+		RedditVideoPreview *redditVideo `json:"reddit_video_preview"`
 	} `json:"preview"`
+	// Crossposts carry the original post's media
+	CrosspostParentList []redditPost `json:"crosspost_parent_list"`
+}
+
+// redditMedia is the media payload of a post.
+type redditMedia struct {
+	RedditVideo *redditVideo `json:"reddit_video"`
+}
+
+// redditVideo describes a clip hosted on v.redd.it.
+type redditVideo struct {
+	FallbackURL string `json:"fallback_url"` // Video-only MP4
+	DashURL     string `json:"dash_url"`     // DASH manifest (video + audio)
+}
+
+// redditVideo returns the v.redd.it video for the post, checking secure_media, media,
+// crosspost parents and the preview (in that order). Returns nil if none is present.
+func (p *redditPost) redditVideo() *redditVideo {
+	for _, media := range []*redditMedia{p.SecureMedia, p.Media} {
+		if media != nil && media.RedditVideo != nil && media.RedditVideo.FallbackURL != "" {
+			return media.RedditVideo
+		}
+	}
+	for i := range p.CrosspostParentList {
+		if video := p.CrosspostParentList[i].redditVideo(); video != nil {
+			return video
+		}
+	}
+	if p.Preview != nil && p.Preview.RedditVideoPreview != nil && p.Preview.RedditVideoPreview.FallbackURL != "" {
+		return p.Preview.RedditVideoPreview
+	}
+	return nil
+}
+
+// vReddItDashURL derives the DASH manifest URL from a v.redd.it link
+// (e.g., https://v.redd.it/abc123 -> https://v.redd.it/abc123/DASHPlaylist.mpd).
+// Returns "" for non-v.redd.it URLs.
+func vReddItDashURL(link string) string {
+	id, ok := strings.CutPrefix(link, "https://v.redd.it/")
+	if !ok {
+		return ""
+	}
+	id, _, _ = strings.Cut(id, "/")
+	if id == "" {
+		return ""
+	}
+	return "https://v.redd.it/" + id + "/DASHPlaylist.mpd"
 }
 
 // toSearchResult converts a redditPost to SearchResult.
 func (p *redditPost) toSearchResult() SearchResult {
 	// Extract the best available media URL
 	mediaURL := p.URL
+	var videoURL, dashURL string
 
-	// Try to get Reddit video fallback URL if available
-	if p.SecureMedia != nil && p.SecureMedia.RedditVideo != nil {
-		if p.SecureMedia.RedditVideo.FallbackURL != "" {
-			mediaURL = p.SecureMedia.RedditVideo.FallbackURL
-		}
+	// Prefer the direct v.redd.it video when the post hosts one
+	if video := p.redditVideo(); video != nil {
+		videoURL = video.FallbackURL
+		dashURL = video.DashURL
+		mediaURL = videoURL
+	}
+	if dashURL == "" {
+		dashURL = vReddItDashURL(p.URL)
 	}
 
 	return SearchResult{
-		Title:     p.Title,
-		URL:       mediaURL,
-		PostURL:   "https://www.reddit.com" + p.Permalink,
-		Flair:     p.LinkFlairText,
-		CreatedAt: time.Unix(int64(p.CreatedUTC), 0),
-		Score:     p.Score,
+		Title:       p.Title,
+		URL:         mediaURL,
+		PostURL:     "https://www.reddit.com" + p.Permalink,
+		VideoURL:    videoURL,
+		DashURL:     dashURL,
+		Flair:       p.LinkFlairText,
+		CreatedAt:   time.Unix(int64(p.CreatedUTC), 0),
+		Score:       p.Score,
+		UpvoteRatio: p.UpvoteRatio,
 	}
 }
 
@@ -92,11 +228,14 @@ type GoalInfo struct {
 	AwayTeam      string
 	HomeTeamShort string // Short/alternative name (e.g., "Wolves", "Man Utd")
 	AwayTeamShort string // Short/alternative name (e.g., "Wolves", "Man Utd")
-	ScorerName    string
+	ScorerName    string // Goal scorer (used for matching and the scorer search strategy)
 	Minute        int
 	DisplayMinute string // e.g., "45+2'" for stoppage time display
 	HomeScore     int
 	AwayScore     int
 	IsHomeTeam    bool
+	IsFinished    bool // Whether the match is over (controls how long "not found" is cached)
 	MatchTime     time.Time
+	LeagueID      int          // Competition, for its search window
+	Window        SearchWindow // Set by the client from LeagueID; zero means DefaultSearchWindow
 }
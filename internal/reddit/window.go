@@ -0,0 +1,71 @@
+package reddit
+
+import (
+	"time"
+
+	"github.com/0xjuanma/golazo/internal/data"
+)
+
+// SearchWindow is the span around kick-off in which goal clips are searched for.
+type SearchWindow struct {
+	Before time.Duration // How long before kick-off posts are searched
+	After  time.Duration // How long after kick-off
+}
+
+// DefaultSearchWindow searches the match day: clips are usually posted within minutes
+// of a goal.
+var DefaultSearchWindow = SearchWindow{Before: 12 * time.Hour, After: 12 * time.Hour}
+
+// Posts are accepted at least this long before and after kick-off, whatever the
+// search window: reposts and time zone mix-ups are common.
+const (
+	minAcceptBefore = 24 * time.Hour
+	minAcceptAfter  = 48 * time.Hour
+)
+
+// Widened returns the window of the second pass, made when the first found nothing for
+// a finished match: twice as early and four times as late, as clips of tournaments in
+// distant time zones or posted late fall outside the match day.
+func (w SearchWindow) Widened() SearchWindow {
+	return SearchWindow{Before: 2 * w.Before, After: 4 * w.After}
+}
+
+// accepts reports whether a post made at posted may be a clip of a match kicking off at
+// matchTime.
+func (w SearchWindow) accepts(posted, matchTime time.Time) bool {
+	return !posted.Before(matchTime.Add(-max(w.Before, minAcceptBefore))) &&
+		!posted.After(matchTime.Add(max(w.After, minAcceptAfter)))
+}
+
+// SetSearchWindows sets the search windows of competitions by league ID; the others
+// use DefaultSearchWindow. Safe to call while searches run.
+func (c *Client) SetSearchWindows(windows map[int]SearchWindow) {
+	c.windows.Store(&windows)
+}
+
+// searchWindow returns the search window of a competition.
+func (c *Client) searchWindow(leagueID int) SearchWindow {
+	if windows := c.windows.Load(); windows != nil {
+		if w, ok := (*windows)[leagueID]; ok {
+			return w
+		}
+	}
+	return DefaultSearchWindow
+}
+
+// SearchWindowsFromSettings returns the search windows configured by goal_search_windows,
+// with sides left out taken from DefaultSearchWindow. Invalid durations are ignored.
+func SearchWindowsFromSettings(settings *data.Settings) map[int]SearchWindow {
+	windows := make(map[int]SearchWindow, len(settings.GoalSearchWindows))
+	for leagueID, configured := range settings.GoalSearchWindows {
+		window := DefaultSearchWindow
+		if d, err := time.ParseDuration(configured.Before); err == nil && d > 0 {
+			window.Before = d
+		}
+		if d, err := time.ParseDuration(configured.After); err == nil && d > 0 {
+			window.After = d
+		}
+		windows[leagueID] = window
+	}
+	return windows
+}
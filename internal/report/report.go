@@ -0,0 +1,215 @@
+// Package report builds the diagnostics bundle users attach to GitHub issues
+// ("golazo report-bug"), with secrets and home directory paths redacted. The encrypted
+// credentials store is never included, and its unlocked values are redacted from text.
+package report
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/url"
+	"os"
+	"path/filepath"
+	"runtime"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/0xjuanma/golazo/internal/data"
+	"github.com/0xjuanma/golazo/internal/fotmob"
+	"gopkg.in/yaml.v3"
+)
+
+// maxLogBytes is how much of the end of the debug log goes into a bundle.
+const maxLogBytes = 512 * 1024
+
+// Options describes what goes into a bundle.
+type Options struct {
+	Version     string
+	MatchID     int    // Match the report is about (0 = none)
+	RawResponse []byte // Raw provider response for MatchID, if available
+}
+
+// bundleFile is a file of the bundle.
+type bundleFile struct {
+	name    string
+	content []byte
+}
+
+// Write writes the bundle as a zip archive: version and platform, redacted settings,
+// the end of the debug log, diagnostics of the local files and, for a match, its raw
+// provider response and whether it still decodes.
+func Write(w io.Writer, opts Options) error {
+	zw := zip.NewWriter(w)
+
+	files := []bundleFile{
+		{"version.txt", versionInfo(opts.Version)},
+		{"settings.yaml", settings()},
+		{"debug.log", debugLog()},
+		{"diagnostics.txt", diagnostics(opts)},
+	}
+	if len(opts.RawResponse) > 0 {
+		files = append(files, bundleFile{fmt.Sprintf("match-%d.json", opts.MatchID), opts.RawResponse})
+	}
+
+	for _, f := range files {
+		fw, err := zw.Create(f.name)
+		if err != nil {
+			return fmt.Errorf("add %s: %w", f.name, err)
+		}
+		if _, err := fw.Write(f.content); err != nil {
+			return fmt.Errorf("write %s: %w", f.name, err)
+		}
+	}
+	return zw.Close()
+}
+
+// versionInfo describes the build and platform.
+func versionInfo(version string) []byte {
+	return fmt.Appendf(nil, "golazo %s\n%s %s/%s\ncreated %s\n",
+		version, runtime.Version(), runtime.GOOS, runtime.GOARCH, time.Now().UTC().Format(time.RFC3339))
+}
+
+// settings returns the settings with proxy credentials and home directory paths redacted.
+func settings() []byte {
+	s, err := data.LoadSettings()
+	if err != nil {
+		return fmt.Appendf(nil, "# settings could not be loaded: %s\n", redactHome(err.Error()))
+	}
+	if s.Proxy != "" {
+		s.Proxy = redactProxy(s.Proxy)
+	}
+	s.DailyNotePath = redactHome(s.DailyNotePath)
+	s.PlayerCommand = redactHome(s.PlayerCommand)
+
+	out, err := yaml.Marshal(s)
+	if err != nil {
+		return fmt.Appendf(nil, "# settings could not be encoded: %v\n", err)
+	}
+	return []byte(redactSecrets(string(out)))
+}
+
+// debugLog returns the end of the debug log, starting at a line boundary.
+func debugLog() []byte {
+	dir, err := data.ConfigDir()
+	if err != nil {
+		return nil
+	}
+	log, err := os.ReadFile(filepath.Join(dir, "golazo_debug.log"))
+	if err != nil {
+		return []byte("no debug log (run golazo with --debug to record one)\n")
+	}
+	if len(log) > maxLogBytes {
+		log = log[len(log)-maxLogBytes:]
+		if i := bytes.IndexByte(log, '\n'); i >= 0 {
+			log = log[i+1:]
+		}
+	}
+	return []byte(redactSecrets(redactHome(string(log))))
+}
+
+// diagnostics lists the local state files and checks that they, and the raw match
+// response, still decode.
+func diagnostics(opts Options) []byte {
+	var b strings.Builder
+
+	for _, d := range []struct {
+		label string
+		dir   func() (string, error)
+	}{
+		{"config", data.ConfigDir},
+		{"cache", data.CacheDir},
+	} {
+		dir, err := d.dir()
+		if err != nil {
+			fmt.Fprintf(&b, "%s dir: %v\n\n", d.label, err)
+			continue
+		}
+		fmt.Fprintf(&b, "%s dir: %s\n", d.label, redactHome(dir))
+		for _, line := range listFiles(dir) {
+			fmt.Fprintf(&b, "  %s\n", line)
+		}
+		b.WriteString("\n")
+	}
+
+	if _, err := data.LoadSettings(); err != nil {
+		fmt.Fprintf(&b, "settings.yaml: %s\n", redactHome(err.Error()))
+	} else {
+		b.WriteString("settings.yaml: ok\n")
+	}
+
+	if opts.MatchID != 0 {
+		b.WriteString(matchDiagnostics(opts))
+	}
+	return []byte(b.String())
+}
+
+// matchDiagnostics reports whether the raw match response decodes and what it contains.
+func matchDiagnostics(opts Options) string {
+	if len(opts.RawResponse) == 0 {
+		return fmt.Sprintf("match %d: no raw response\n", opts.MatchID)
+	}
+	if !json.Valid(opts.RawResponse) {
+		return fmt.Sprintf("match %d: raw response is not valid JSON\n", opts.MatchID)
+	}
+	details, err := fotmob.ParseMatchDetails(opts.RawResponse)
+	if err != nil {
+		return fmt.Sprintf("match %d: decode failed: %v\n", opts.MatchID, err)
+	}
+	return fmt.Sprintf("match %d: decoded %s vs %s, status %q, %d events, %d statistics, %d shots, lineups %d/%d\n",
+		opts.MatchID, details.HomeTeam.Name, details.AwayTeam.Name, details.Status,
+		len(details.Events), len(details.Statistics), len(details.Shots),
+		len(details.HomeStarting), len(details.AwayStarting))
+}
+
+// listFiles lists the files under dir with their sizes and modification times.
+func listFiles(dir string) []string {
+	var lines []string
+	_ = filepath.WalkDir(dir, func(path string, d os.DirEntry, err error) error {
+		if err != nil || d.IsDir() {
+			return nil
+		}
+		info, err := d.Info()
+		if err != nil {
+			return nil
+		}
+		rel, _ := filepath.Rel(dir, path)
+		lines = append(lines, fmt.Sprintf("%-40s %10d  %s", rel, info.Size(), info.ModTime().UTC().Format(time.RFC3339)))
+		return nil
+	})
+	sort.Strings(lines)
+	return lines
+}
+
+// redactProxy hides the credentials of a proxy URL.
+func redactProxy(proxy string) string {
+	u, err := url.Parse(proxy)
+	if err != nil {
+		return "REDACTED"
+	}
+	if u.User != nil {
+		u.User = url.User("REDACTED")
+	}
+	return u.String()
+}
+
+// redactSecrets replaces the values of the unlocked credentials store.
+func redactSecrets(s string) string {
+	for _, secret := range data.CredentialValues() {
+		if len(secret) >= 4 { // Shorter values would redact unrelated text
+			s = strings.ReplaceAll(s, secret, "REDACTED")
+		}
+	}
+	return s
+}
+
+// redactHome replaces the home directory (which usually holds the user name) with "~".
+func redactHome(s string) string {
+	home, err := os.UserHomeDir()
+	if err != nil || home == "" || home == "/" {
+		return s
+	}
+	return strings.ReplaceAll(s, home, "~")
+}
@@ -0,0 +1,72 @@
+package rpc
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"io"
+)
+
+// maxLineSize is the longest JSON request line accepted.
+const maxLineSize = 1 << 20
+
+// codec reads requests and writes responses and events in one wire format.
+type codec interface {
+	// read returns the next request, an *invalidRequestError for a malformed one the
+	// stream can recover from, or io.EOF.
+	read() (Request, error)
+	writeResponse(resp Response) error
+	writeEvent(event Event) error
+}
+
+// invalidRequestError is a malformed request; reading continues with the next one.
+type invalidRequestError struct {
+	id  json.RawMessage // Answered with the error when known
+	err error
+}
+
+func (e *invalidRequestError) Error() string {
+	return "invalid request: " + e.err.Error()
+}
+
+func (e *invalidRequestError) Unwrap() error {
+	return e.err
+}
+
+// jsonCodec speaks JSON Lines: one message per line.
+type jsonCodec struct {
+	scanner *bufio.Scanner
+	enc     *json.Encoder
+}
+
+func newJSONCodec(in io.Reader, out io.Writer) *jsonCodec {
+	scanner := bufio.NewScanner(in)
+	scanner.Buffer(make([]byte, 64*1024), maxLineSize)
+	return &jsonCodec{scanner: scanner, enc: json.NewEncoder(out)}
+}
+
+func (c *jsonCodec) read() (Request, error) {
+	for c.scanner.Scan() {
+		line := bytes.TrimSpace(c.scanner.Bytes())
+		if len(line) == 0 {
+			continue
+		}
+		var req Request
+		if err := json.Unmarshal(line, &req); err != nil {
+			return Request{}, &invalidRequestError{err: err}
+		}
+		return req, nil
+	}
+	if err := c.scanner.Err(); err != nil {
+		return Request{}, err
+	}
+	return Request{}, io.EOF
+}
+
+func (c *jsonCodec) writeResponse(resp Response) error {
+	return c.enc.Encode(resp)
+}
+
+func (c *jsonCodec) writeEvent(event Event) error {
+	return c.enc.Encode(event)
+}
@@ -0,0 +1,452 @@
+package rpc
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"slices"
+	"strconv"
+)
+
+// msgpack-rpc message types.
+const (
+	msgpackRequest      = 0
+	msgpackResponse     = 1
+	msgpackNotification = 2
+)
+
+const (
+	// maxMsgpackSize is the longest string, binary or collection accepted.
+	maxMsgpackSize = 1 << 20
+
+	// maxMsgpackDepth is how deeply arrays and maps may nest.
+	maxMsgpackDepth = 32
+)
+
+// neovimEventCode is the Lua Neovim runs for every event: it fires the GolazoEvent User
+// autocommand with the event as its data.
+const neovimEventCode = `vim.api.nvim_exec_autocmds("User", {pattern = "GolazoEvent", modeline = false, data = ...})`
+
+// msgpackCodec speaks msgpack-rpc as Neovim job control does (jobstart with rpc = true):
+// requests are [0, msgid, method, params] with the params object as the first
+// parameter, and events are nvim_exec_lua notifications.
+type msgpackCodec struct {
+	r *bufio.Reader
+	w *bufio.Writer
+}
+
+func newMsgpackCodec(in io.Reader, out io.Writer) *msgpackCodec {
+	return &msgpackCodec{r: bufio.NewReader(in), w: bufio.NewWriter(out)}
+}
+
+func (c *msgpackCodec) read() (Request, error) {
+	for {
+		value, err := decodeMsgpack(c.r, 0)
+		if err != nil {
+			// Past a malformed value the stream can't be resynchronized
+			return Request{}, err
+		}
+		msg, ok := value.([]any)
+		if !ok || len(msg) == 0 {
+			return Request{}, &invalidRequestError{err: errors.New("expected a msgpack-rpc message array")}
+		}
+		kind, _ := msg[0].(int64)
+		switch {
+		case kind == msgpackRequest && len(msg) == 4:
+			id, ok := msg[1].(int64)
+			if !ok {
+				return Request{}, &invalidRequestError{err: errors.New("msgid must be an integer")}
+			}
+			return msgpackCall(json.RawMessage(strconv.FormatInt(id, 10)), msg[2], msg[3])
+		case kind == msgpackNotification && len(msg) == 3:
+			// Run without a response
+			return msgpackCall(nil, msg[1], msg[2])
+		case kind == msgpackResponse && len(msg) == 4:
+			// golazo sends no requests, so there is nothing to answer
+			continue
+		default:
+			return Request{}, &invalidRequestError{err: fmt.Errorf("unknown msgpack-rpc message %v", msg[0])}
+		}
+	}
+}
+
+// msgpackCall builds a request from a msgpack-rpc method and parameters array.
+func msgpackCall(id json.RawMessage, method, params any) (Request, error) {
+	req := Request{ID: id}
+	name, ok := method.(string)
+	if !ok {
+		return Request{}, &invalidRequestError{id: id, err: errors.New("method must be a string")}
+	}
+	req.Method = name
+	args, ok := params.([]any)
+	if !ok {
+		return Request{}, &invalidRequestError{id: id, err: errors.New("params must be an array")}
+	}
+	if len(args) > 0 {
+		raw, err := json.Marshal(args[0])
+		if err != nil {
+			return Request{}, &invalidRequestError{id: id, err: err}
+		}
+		req.Params = raw
+	}
+	return req, nil
+}
+
+func (c *msgpackCodec) writeResponse(resp Response) error {
+	if len(resp.ID) == 0 {
+		// Notifications and messages without a msgid get no response
+		return nil
+	}
+	id, err := strconv.ParseInt(string(resp.ID), 10, 64)
+	if err != nil {
+		return err
+	}
+	var errValue, result any
+	if resp.Error != nil {
+		// Neovim's error shape: [type, message], 0 being an exception
+		errValue = []any{int64(0), resp.Error.Message}
+	} else if result, err = msgpackValue(resp.Result); err != nil {
+		errValue = []any{int64(0), err.Error()}
+	}
+	return c.write([]any{int64(msgpackResponse), id, errValue, result})
+}
+
+func (c *msgpackCodec) writeEvent(event Event) error {
+	value, err := msgpackValue(event)
+	if err != nil {
+		return err
+	}
+	return c.write([]any{int64(msgpackNotification), "nvim_exec_lua", []any{neovimEventCode, []any{value}}})
+}
+
+// write encodes and flushes a message.
+func (c *msgpackCodec) write(msg any) error {
+	buf, err := appendMsgpack(nil, msg)
+	if err != nil {
+		return err
+	}
+	if _, err := c.w.Write(buf); err != nil {
+		return err
+	}
+	return c.w.Flush()
+}
+
+// msgpackValue converts v to the generic values appendMsgpack encodes, going through
+// JSON so field names match the JSON flavor.
+func msgpackValue(v any) (any, error) {
+	raw, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	dec := json.NewDecoder(bytes.NewReader(raw))
+	dec.UseNumber()
+	var value any
+	if err := dec.Decode(&value); err != nil {
+		return nil, err
+	}
+	return fromJSONNumbers(value), nil
+}
+
+// fromJSONNumbers replaces the json.Numbers in value by int64 or float64.
+func fromJSONNumbers(value any) any {
+	switch v := value.(type) {
+	case json.Number:
+		if n, err := v.Int64(); err == nil {
+			return n
+		}
+		f, _ := v.Float64()
+		return f
+	case []any:
+		for i := range v {
+			v[i] = fromJSONNumbers(v[i])
+		}
+	case map[string]any:
+		for key := range v {
+			v[key] = fromJSONNumbers(v[key])
+		}
+	}
+	return value
+}
+
+// appendMsgpack appends the msgpack encoding of v, which holds nil, bools, integers,
+// float64s, strings, byte slices, []any and map[string]any.
+func appendMsgpack(b []byte, v any) ([]byte, error) {
+	switch v := v.(type) {
+	case nil:
+		return append(b, 0xc0), nil
+	case bool:
+		if v {
+			return append(b, 0xc3), nil
+		}
+		return append(b, 0xc2), nil
+	case int:
+		return appendMsgpackInt(b, int64(v)), nil
+	case int64:
+		return appendMsgpackInt(b, v), nil
+	case uint64:
+		return binary.BigEndian.AppendUint64(append(b, 0xcf), v), nil
+	case float64:
+		return binary.BigEndian.AppendUint64(append(b, 0xcb), math.Float64bits(v)), nil
+	case string:
+		b = appendMsgpackHeader(b, len(v), 0xa0, 31, 0xd9, 0xda, 0xdb)
+		return append(b, v...), nil
+	case []byte:
+		b = appendMsgpackHeader(b, len(v), 0, -1, 0xc4, 0xc5, 0xc6)
+		return append(b, v...), nil
+	case []any:
+		b = appendMsgpackHeader(b, len(v), 0x90, 15, 0, 0xdc, 0xdd)
+		var err error
+		for _, item := range v {
+			if b, err = appendMsgpack(b, item); err != nil {
+				return nil, err
+			}
+		}
+		return b, nil
+	case map[string]any:
+		b = appendMsgpackHeader(b, len(v), 0x80, 15, 0, 0xde, 0xdf)
+		keys := make([]string, 0, len(v))
+		for key := range v {
+			keys = append(keys, key)
+		}
+		slices.Sort(keys)
+		var err error
+		for _, key := range keys {
+			b, _ = appendMsgpack(b, key)
+			if b, err = appendMsgpack(b, v[key]); err != nil {
+				return nil, err
+			}
+		}
+		return b, nil
+	default:
+		return nil, fmt.Errorf("msgpack: unsupported type %T", v)
+	}
+}
+
+// appendMsgpackInt appends an integer in its shortest encoding.
+func appendMsgpackInt(b []byte, n int64) []byte {
+	switch {
+	case n >= 0 && n <= math.MaxInt8:
+		return append(b, byte(n))
+	case n >= -32 && n < 0:
+		return append(b, byte(n))
+	case n >= 0 && n <= math.MaxUint8:
+		return append(b, 0xcc, byte(n))
+	case n >= 0 && n <= math.MaxUint16:
+		return binary.BigEndian.AppendUint16(append(b, 0xcd), uint16(n))
+	case n >= 0 && n <= math.MaxUint32:
+		return binary.BigEndian.AppendUint32(append(b, 0xce), uint32(n))
+	case n >= 0:
+		return binary.BigEndian.AppendUint64(append(b, 0xcf), uint64(n))
+	case n >= math.MinInt8:
+		return append(b, 0xd0, byte(n))
+	case n >= math.MinInt16:
+		return binary.BigEndian.AppendUint16(append(b, 0xd1), uint16(n))
+	case n >= math.MinInt32:
+		return binary.BigEndian.AppendUint32(append(b, 0xd2), uint32(n))
+	default:
+		return binary.BigEndian.AppendUint64(append(b, 0xd3), uint64(n))
+	}
+}
+
+// appendMsgpackHeader appends the header of a string, binary, array or map of n items:
+// the fix format (fix|n) when n <= fixMax, else the 8 (when non-zero), 16 or 32-bit one.
+func appendMsgpackHeader(b []byte, n int, fix byte, fixMax int, code8, code16, code32 byte) []byte {
+	switch {
+	case n <= fixMax:
+		return append(b, fix|byte(n))
+	case code8 != 0 && n <= math.MaxUint8:
+		return append(b, code8, byte(n))
+	case n <= math.MaxUint16:
+		return binary.BigEndian.AppendUint16(append(b, code16), uint16(n))
+	default:
+		return binary.BigEndian.AppendUint32(append(b, code32), uint32(n))
+	}
+}
+
+// decodeMsgpack reads one msgpack value: integers become int64 (uint64 past its range),
+// floats float64, strings string, binaries []byte, arrays []any and maps map[string]any.
+// Extension values, such as Neovim's buffer handles, become nil.
+func decodeMsgpack(r *bufio.Reader, depth int) (any, error) {
+	if depth > maxMsgpackDepth {
+		return nil, errors.New("msgpack: nested too deeply")
+	}
+	code, err := r.ReadByte()
+	if err != nil {
+		// io.EOF between values is the end of the stream
+		return nil, err
+	}
+	switch {
+	case code <= 0x7f:
+		return int64(code), nil
+	case code >= 0xe0:
+		return int64(int8(code)), nil
+	case code >= 0xa0 && code <= 0xbf:
+		return readMsgpackString(r, int(code&0x1f))
+	case code >= 0x90 && code <= 0x9f:
+		return readMsgpackArray(r, int(code&0x0f), depth)
+	case code >= 0x80 && code <= 0x8f:
+		return readMsgpackMap(r, int(code&0x0f), depth)
+	}
+
+	switch code {
+	case 0xc0:
+		return nil, nil
+	case 0xc2:
+		return false, nil
+	case 0xc3:
+		return true, nil
+	case 0xc4, 0xc5, 0xc6:
+		n, err := readMsgpackLength(r, code-0xc4)
+		if err != nil {
+			return nil, err
+		}
+		return readMsgpackBytes(r, n)
+	case 0xc7, 0xc8, 0xc9:
+		n, err := readMsgpackLength(r, code-0xc7)
+		if err != nil {
+			return nil, err
+		}
+		return nil, skipMsgpackExt(r, n)
+	case 0xd4, 0xd5, 0xd6, 0xd7, 0xd8:
+		return nil, skipMsgpackExt(r, 1<<(code-0xd4))
+	case 0xca:
+		bits, err := readMsgpackUint(r, 4)
+		return float64(math.Float32frombits(uint32(bits))), err
+	case 0xcb:
+		bits, err := readMsgpackUint(r, 8)
+		return math.Float64frombits(bits), err
+	case 0xcc, 0xcd, 0xce, 0xcf:
+		n, err := readMsgpackUint(r, 1<<(code-0xcc))
+		if n > math.MaxInt64 {
+			return n, err
+		}
+		return int64(n), err
+	case 0xd0:
+		n, err := readMsgpackUint(r, 1)
+		return int64(int8(n)), err
+	case 0xd1:
+		n, err := readMsgpackUint(r, 2)
+		return int64(int16(n)), err
+	case 0xd2:
+		n, err := readMsgpackUint(r, 4)
+		return int64(int32(n)), err
+	case 0xd3:
+		n, err := readMsgpackUint(r, 8)
+		return int64(n), err
+	case 0xd9, 0xda, 0xdb:
+		n, err := readMsgpackLength(r, code-0xd9)
+		if err != nil {
+			return nil, err
+		}
+		return readMsgpackString(r, n)
+	case 0xdc, 0xdd:
+		n, err := readMsgpackLength(r, code-0xdc+1)
+		if err != nil {
+			return nil, err
+		}
+		return readMsgpackArray(r, n, depth)
+	case 0xde, 0xdf:
+		n, err := readMsgpackLength(r, code-0xde+1)
+		if err != nil {
+			return nil, err
+		}
+		return readMsgpackMap(r, n, depth)
+	default:
+		return nil, fmt.Errorf("msgpack: invalid type code %#x", code)
+	}
+}
+
+// readMsgpackUint reads a big-endian unsigned integer of size bytes.
+func readMsgpackUint(r *bufio.Reader, size int) (uint64, error) {
+	var buf [8]byte
+	if _, err := io.ReadFull(r, buf[8-size:]); err != nil {
+		return 0, unexpectedEOF(err)
+	}
+	return binary.BigEndian.Uint64(buf[:]), nil
+}
+
+// readMsgpackLength reads a length of 1, 2 or 4 bytes (sizeLog2 0, 1 or 2).
+func readMsgpackLength(r *bufio.Reader, sizeLog2 byte) (int, error) {
+	n, err := readMsgpackUint(r, 1<<sizeLog2)
+	if err != nil {
+		return 0, err
+	}
+	if n > maxMsgpackSize {
+		return 0, fmt.Errorf("msgpack: length %d too large", n)
+	}
+	return int(n), nil
+}
+
+func readMsgpackBytes(r *bufio.Reader, n int) ([]byte, error) {
+	buf := make([]byte, n)
+	if _, err := io.ReadFull(r, buf); err != nil {
+		return nil, unexpectedEOF(err)
+	}
+	return buf, nil
+}
+
+func readMsgpackString(r *bufio.Reader, n int) (any, error) {
+	buf, err := readMsgpackBytes(r, n)
+	if err != nil {
+		return nil, err
+	}
+	return string(buf), nil
+}
+
+func readMsgpackArray(r *bufio.Reader, n, depth int) (any, error) {
+	items := make([]any, 0, min(n, 16))
+	for range n {
+		item, err := decodeMsgpack(r, depth+1)
+		if err != nil {
+			return nil, unexpectedEOF(err)
+		}
+		items = append(items, item)
+	}
+	return items, nil
+}
+
+// readMsgpackMap reads a map; non-string keys are formatted as strings.
+func readMsgpackMap(r *bufio.Reader, n, depth int) (any, error) {
+	entries := make(map[string]any, min(n, 16))
+	for range n {
+		key, err := decodeMsgpack(r, depth+1)
+		if err != nil {
+			return nil, unexpectedEOF(err)
+		}
+		value, err := decodeMsgpack(r, depth+1)
+		if err != nil {
+			return nil, unexpectedEOF(err)
+		}
+		switch k := key.(type) {
+		case string:
+			entries[k] = value
+		case []byte:
+			entries[string(k)] = value
+		default:
+			entries[fmt.Sprint(k)] = value
+		}
+	}
+	return entries, nil
+}
+
+// skipMsgpackExt skips an extension value's type byte and n bytes of data.
+func skipMsgpackExt(r *bufio.Reader, n int) error {
+	if _, err := r.Discard(n + 1); err != nil {
+		return unexpectedEOF(err)
+	}
+	return nil
+}
+
+// unexpectedEOF turns io.EOF inside a value into io.ErrUnexpectedEOF, so only EOF
+// between values ends the stream cleanly.
+func unexpectedEOF(err error) error {
+	if errors.Is(err, io.EOF) {
+		return io.ErrUnexpectedEOF
+	}
+	return err
+}
@@ -0,0 +1,196 @@
+package rpc
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"math"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/0xjuanma/golazo/internal/api"
+)
+
+// decodeBytes decodes a single msgpack value.
+func decodeBytes(b []byte) (any, error) {
+	return decodeMsgpack(bufio.NewReader(bytes.NewReader(b)), 0)
+}
+
+func TestMsgpackRoundTrip(t *testing.T) {
+	items := func(n int) []any {
+		s := make([]any, n)
+		for i := range s {
+			s[i] = int64(i)
+		}
+		return s
+	}
+	entries := func(n int) map[string]any {
+		m := make(map[string]any, n)
+		for i := range n {
+			m[strings.Repeat("k", i+1)] = int64(i)
+		}
+		return m
+	}
+
+	tests := []struct {
+		name   string
+		value  any
+		header []byte // Expected leading bytes of the encoding
+	}{
+		{"nil", nil, []byte{0xc0}},
+		{"true", true, []byte{0xc3}},
+		{"false", false, []byte{0xc2}},
+		{"positive fixint max", int64(127), []byte{0x7f}},
+		{"uint8 min", int64(128), []byte{0xcc, 0x80}},
+		{"uint8 max", int64(255), []byte{0xcc, 0xff}},
+		{"uint16 min", int64(256), []byte{0xcd, 0x01, 0x00}},
+		{"uint32 min", int64(math.MaxUint16 + 1), []byte{0xce}},
+		{"uint64 min", int64(math.MaxUint32 + 1), []byte{0xcf}},
+		{"int64 max", int64(math.MaxInt64), []byte{0xcf}},
+		{"negative fixint -1", int64(-1), []byte{0xff}},
+		{"negative fixint min", int64(-32), []byte{0xe0}},
+		{"int8 below fixint", int64(-33), []byte{0xd0, 0xdf}},
+		{"int8 min", int64(math.MinInt8), []byte{0xd0, 0x80}},
+		{"int16 min", int64(math.MinInt16), []byte{0xd1}},
+		{"int32 min", int64(math.MinInt32), []byte{0xd2}},
+		{"int64 min", int64(math.MinInt64), []byte{0xd3}},
+		{"uint64 above MaxInt64", uint64(math.MaxInt64) + 1, []byte{0xcf, 0x80}},
+		{"float64", 1.5, []byte{0xcb}},
+		{"empty string", "", []byte{0xa0}},
+		{"fixstr max", strings.Repeat("a", 31), []byte{0xbf}},
+		{"str8 min", strings.Repeat("a", 32), []byte{0xd9, 32}},
+		{"str16", strings.Repeat("a", 256), []byte{0xda, 0x01, 0x00}},
+		{"bin8", []byte{1, 2, 3}, []byte{0xc4, 3}},
+		{"fixarray max", items(15), []byte{0x9f}},
+		{"array16 min", items(16), []byte{0xdc, 0x00, 16}},
+		{"fixmap max", entries(15), []byte{0x8f}},
+		{"map16 min", entries(16), []byte{0xde, 0x00, 16}},
+		{"nested", map[string]any{"a": []any{int64(1), "x", nil}}, []byte{0x81}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			encoded, err := appendMsgpack(nil, tt.value)
+			if err != nil {
+				t.Fatalf("appendMsgpack: %v", err)
+			}
+			if !bytes.HasPrefix(encoded, tt.header) {
+				t.Errorf("encoding starts % x; want % x", encoded[:min(len(encoded), len(tt.header))], tt.header)
+			}
+			decoded, err := decodeBytes(encoded)
+			if err != nil {
+				t.Fatalf("decodeMsgpack: %v", err)
+			}
+			if !reflect.DeepEqual(decoded, tt.value) {
+				t.Errorf("round trip = %#v; want %#v", decoded, tt.value)
+			}
+		})
+	}
+}
+
+func TestMsgpackDecodeErrors(t *testing.T) {
+	tests := []struct {
+		name  string
+		input []byte
+		want  error // nil: any error
+	}{
+		{"truncated uint16", []byte{0xcd, 0x01}, io.ErrUnexpectedEOF},
+		{"truncated float64", []byte{0xcb, 0, 0, 0}, io.ErrUnexpectedEOF},
+		{"truncated fixstr", []byte{0xa3, 'a'}, io.ErrUnexpectedEOF},
+		{"truncated str8 length", []byte{0xd9}, io.ErrUnexpectedEOF},
+		{"truncated array", []byte{0x92, 0x01}, io.ErrUnexpectedEOF},
+		{"truncated map value", []byte{0x81, 0xa1, 'k'}, io.ErrUnexpectedEOF},
+		{"truncated ext", []byte{0xd6, 0x01, 0x00}, io.ErrUnexpectedEOF},
+		{"invalid code", []byte{0xc1}, nil},
+		{"length over limit", []byte{0xc6, 0x7f, 0xff, 0xff, 0xff}, nil},
+		{"nested too deeply", bytes.Repeat([]byte{0x91}, maxMsgpackDepth+2), nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := decodeBytes(tt.input)
+			if err == nil {
+				t.Fatal("decoded without error")
+			}
+			if tt.want != nil && !errors.Is(err, tt.want) {
+				t.Errorf("error = %v; want %v", err, tt.want)
+			}
+		})
+	}
+
+	if _, err := decodeBytes(nil); err != io.EOF {
+		t.Errorf("empty stream error = %v; want io.EOF", err)
+	}
+}
+
+func TestMsgpackSkipsExtensions(t *testing.T) {
+	// [ext fixext1 (a Neovim buffer handle), 7]
+	value, err := decodeBytes([]byte{0x92, 0xd4, 0x00, 0x01, 0x07})
+	if err != nil {
+		t.Fatalf("decodeMsgpack: %v", err)
+	}
+	if want := []any{nil, int64(7)}; !reflect.DeepEqual(value, want) {
+		t.Errorf("decoded %#v; want %#v", value, want)
+	}
+}
+
+// stubSource serves one live match.
+type stubSource struct{}
+
+func (stubSource) LiveMatches(context.Context) ([]api.Match, error) {
+	return []api.Match{{ID: 7, Status: api.MatchStatusLive}}, nil
+}
+
+func (stubSource) MatchesByDate(context.Context, time.Time) ([]api.Match, error) { return nil, nil }
+
+func (stubSource) MatchDetails(context.Context, int) (*api.MatchDetails, error) { return nil, nil }
+
+func TestServeMsgpack(t *testing.T) {
+	inR, inW := io.Pipe()
+	outR, outW := io.Pipe()
+	served := make(chan error, 1)
+	go func() {
+		served <- New(stubSource{}).ServeMsgpack(t.Context(), inR, outW)
+		outW.Close()
+	}()
+
+	write := func(msg any) {
+		t.Helper()
+		encoded, err := appendMsgpack(nil, msg)
+		if err != nil {
+			t.Fatalf("encode: %v", err)
+		}
+		if _, err := inW.Write(encoded); err != nil {
+			t.Fatalf("write: %v", err)
+		}
+	}
+	// A notification is run without a response; the request is answered
+	write([]any{int64(msgpackNotification), "live", []any{}})
+	write([]any{int64(msgpackRequest), int64(42), "live", []any{map[string]any{}}})
+
+	out := bufio.NewReader(outR)
+	resp, err := decodeMsgpack(out, 0)
+	if err != nil {
+		t.Fatalf("read response: %v", err)
+	}
+	msg, ok := resp.([]any)
+	if !ok || len(msg) != 4 || msg[0] != int64(msgpackResponse) || msg[1] != int64(42) || msg[2] != nil {
+		t.Fatalf("response = %#v; want [1, 42, nil, result]", resp)
+	}
+	matches, ok := msg[3].([]any)
+	if !ok || len(matches) != 1 || matches[0].(map[string]any)["id"] != int64(7) {
+		t.Errorf("result = %#v; want the live match", msg[3])
+	}
+
+	inW.Close()
+	if extra, err := decodeMsgpack(out, 0); err != io.EOF {
+		t.Errorf("after the response got %#v, %v; want the end of the stream", extra, err)
+	}
+	if err := <-served; err != nil {
+		t.Errorf("ServeMsgpack: %v", err)
+	}
+}
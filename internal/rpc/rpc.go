@@ -0,0 +1,409 @@
+// Package rpc drives golazo headless (golazo rpc): JSON requests are read from stdin one
+// per line, and responses and subscription events are written to stdout one per line,
+// so editor plugins and custom GUIs can use golazo as a subprocess. The msgpack-rpc
+// flavor (golazo rpc --msgpack) speaks the same methods to Neovim job control.
+package rpc
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"sync"
+	"time"
+
+	"github.com/0xjuanma/golazo/internal/api"
+	"github.com/0xjuanma/golazo/internal/fotmob"
+)
+
+const (
+	// PollInterval is how often subscribed matches are fetched, as in the TUI's live view.
+	PollInterval = 20 * time.Second
+
+	// requestTimeout bounds a single fetch.
+	requestTimeout = 15 * time.Second
+)
+
+// Event names written for subscribed matches.
+const (
+	EventScore        = "score"         // Score, minute or status changed; also sent on subscribe
+	EventAdded        = "event_added"   // New match event (goal, card, substitution...)
+	EventChanged      = "event_changed" // Event details changed (e.g., corrected scorer)
+	EventRemoved      = "event_removed" // Event no longer reported (e.g., goal disallowed by VAR)
+	EventFinished     = "finished"      // Match ended; the subscription is closed
+	EventUnsubscribed = "unsubscribed"  // Subscription closed by the client
+)
+
+// Source is where match data comes from: the FotMob client, or mock data.
+type Source interface {
+	LiveMatches(ctx context.Context) ([]api.Match, error)
+	MatchesByDate(ctx context.Context, date time.Time) ([]api.Match, error)
+	MatchDetails(ctx context.Context, matchID int) (*api.MatchDetails, error)
+}
+
+// Request is a command read from stdin.
+type Request struct {
+	ID     json.RawMessage `json:"id,omitempty"` // Echoed in the response; any JSON value
+	Method string          `json:"method"`
+	Params json.RawMessage `json:"params,omitempty"`
+}
+
+// Response answers a request.
+type Response struct {
+	ID     json.RawMessage `json:"id,omitempty"`
+	Result any             `json:"result,omitempty"`
+	Error  *Error          `json:"error,omitempty"`
+}
+
+// Error is why a request failed.
+type Error struct {
+	Message string `json:"message"`
+}
+
+// Event is a change in a subscribed match. Events have no ID.
+type Event struct {
+	Event   string `json:"event"`
+	MatchID int    `json:"match_id"`
+	Data    any    `json:"data,omitempty"` // Score or api.MatchEvent
+}
+
+// Score is the state of a subscribed match.
+type Score struct {
+	Status    api.MatchStatus `json:"status"`
+	HomeScore *int            `json:"home_score,omitempty"`
+	AwayScore *int            `json:"away_score,omitempty"`
+	LiveTime  *string         `json:"live_time,omitempty"`
+	Clock     *api.Clock      `json:"clock,omitempty"`
+	Shootout  *api.Shootout   `json:"shootout,omitempty"`
+}
+
+// Server answers requests from one client.
+type Server struct {
+	source Source
+	poll   time.Duration
+
+	writeMu sync.Mutex // Serializes messages written to the codec
+	codec   codec
+
+	subsMu sync.Mutex
+	subs   map[int]*subscription
+	subsWG sync.WaitGroup
+}
+
+// subscription is a match being followed.
+type subscription struct {
+	cancel context.CancelFunc
+}
+
+// New creates a server reading match data from source.
+func New(source Source) *Server {
+	return &Server{source: source, poll: PollInterval, subs: make(map[int]*subscription)}
+}
+
+// Serve answers the JSON requests read from in, one per line, until it is closed or ctx
+// is done. Requests are handled concurrently, so responses may come out of order; match
+// them by ID.
+func (s *Server) Serve(ctx context.Context, in io.Reader, out io.Writer) error {
+	return s.serve(ctx, newJSONCodec(in, out))
+}
+
+// ServeMsgpack answers the msgpack-rpc requests read from in until it is closed or ctx
+// is done. Events are sent as Neovim API notifications firing the GolazoEvent User
+// autocommand.
+func (s *Server) ServeMsgpack(ctx context.Context, in io.Reader, out io.Writer) error {
+	return s.serve(ctx, newMsgpackCodec(in, out))
+}
+
+// serve answers the requests read by c.
+func (s *Server) serve(ctx context.Context, c codec) error {
+	s.codec = c
+	ctx, cancel := context.WithCancel(ctx)
+
+	var requests sync.WaitGroup
+	var readErr error
+	for {
+		req, err := c.read()
+		if err != nil {
+			var invalid *invalidRequestError
+			if errors.As(err, &invalid) {
+				s.respond(Response{ID: invalid.id, Error: &Error{Message: err.Error()}})
+				continue
+			}
+			if !errors.Is(err, io.EOF) {
+				readErr = err
+			}
+			break
+		}
+		requests.Add(1)
+		go func() {
+			defer requests.Done()
+			s.handle(ctx, req)
+		}()
+	}
+
+	// Answer what was asked before stdin closed, then stop following matches
+	requests.Wait()
+	cancel()
+	s.subsWG.Wait()
+	return readErr
+}
+
+// handle answers a request.
+func (s *Server) handle(ctx context.Context, req Request) {
+	result, err := s.call(ctx, req)
+	if err != nil {
+		s.respond(Response{ID: req.ID, Error: &Error{Message: err.Error()}})
+		return
+	}
+	s.respond(Response{ID: req.ID, Result: result})
+}
+
+// call runs a request's method.
+func (s *Server) call(ctx context.Context, req Request) (any, error) {
+	switch req.Method {
+	case "live":
+		return s.liveMatches(ctx)
+	case "matches":
+		var params struct {
+			Date string `json:"date"` // YYYY-MM-DD, today when empty
+		}
+		if err := decodeParams(req.Params, &params); err != nil {
+			return nil, err
+		}
+		date := time.Now()
+		if params.Date != "" {
+			parsed, err := time.ParseInLocation("2006-01-02", params.Date, time.Local)
+			if err != nil {
+				return nil, fmt.Errorf("invalid date %q, expected YYYY-MM-DD", params.Date)
+			}
+			date = parsed
+		}
+		return s.matchesByDate(ctx, date)
+	case "details", "subscribe", "unsubscribe":
+		var params struct {
+			MatchID int `json:"match_id"`
+		}
+		if err := decodeParams(req.Params, &params); err != nil {
+			return nil, err
+		}
+		if params.MatchID <= 0 {
+			return nil, errors.New("match_id is required")
+		}
+		switch req.Method {
+		case "details":
+			return s.details(ctx, params.MatchID)
+		case "subscribe":
+			return s.subscribe(ctx, params.MatchID)
+		default:
+			return s.unsubscribe(params.MatchID)
+		}
+	case "":
+		return nil, errors.New("method is required")
+	default:
+		return nil, fmt.Errorf("unknown method %q", req.Method)
+	}
+}
+
+// decodeParams decodes a request's params into v; missing params leave v unchanged.
+func decodeParams(raw json.RawMessage, v any) error {
+	if len(raw) == 0 || string(raw) == "null" {
+		return nil
+	}
+	if err := json.Unmarshal(raw, v); err != nil {
+		return fmt.Errorf("invalid params: %w", err)
+	}
+	return nil
+}
+
+// liveMatches returns the matches in play.
+func (s *Server) liveMatches(ctx context.Context) ([]api.Match, error) {
+	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	defer cancel()
+	matches, err := s.source.LiveMatches(ctx)
+	if err != nil {
+		return nil, err
+	}
+	live := []api.Match{}
+	for _, match := range matches {
+		if match.Status == api.MatchStatusLive {
+			live = append(live, match)
+		}
+	}
+	return live, nil
+}
+
+// matchesByDate returns the matches played or scheduled on date.
+func (s *Server) matchesByDate(ctx context.Context, date time.Time) ([]api.Match, error) {
+	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	defer cancel()
+	matches, err := s.source.MatchesByDate(ctx, date)
+	if err != nil {
+		return nil, err
+	}
+	if matches == nil {
+		matches = []api.Match{}
+	}
+	return matches, nil
+}
+
+// details returns a match's details.
+func (s *Server) details(ctx context.Context, matchID int) (*api.MatchDetails, error) {
+	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	defer cancel()
+	details, err := s.source.MatchDetails(ctx, matchID)
+	if err != nil {
+		return nil, err
+	}
+	if details == nil {
+		return nil, fmt.Errorf("match %d not found", matchID)
+	}
+	return details, nil
+}
+
+// subscribe starts following a match. Its events are written until it finishes, the
+// client unsubscribes or stdin closes.
+func (s *Server) subscribe(ctx context.Context, matchID int) (any, error) {
+	s.subsMu.Lock()
+	defer s.subsMu.Unlock()
+	if _, ok := s.subs[matchID]; ok {
+		return nil, fmt.Errorf("already subscribed to match %d", matchID)
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	sub := &subscription{cancel: cancel}
+	s.subs[matchID] = sub
+	s.subsWG.Add(1)
+	go s.follow(ctx, matchID, sub)
+	return map[string]int{"match_id": matchID}, nil
+}
+
+// unsubscribe stops following a match.
+func (s *Server) unsubscribe(matchID int) (any, error) {
+	s.subsMu.Lock()
+	sub, ok := s.subs[matchID]
+	delete(s.subs, matchID)
+	s.subsMu.Unlock()
+	if !ok {
+		return nil, fmt.Errorf("not subscribed to match %d", matchID)
+	}
+	sub.cancel()
+	return map[string]int{"match_id": matchID}, nil
+}
+
+// follow polls a subscribed match and writes what changed. The first poll only sends
+// the score: clients get the events so far from details.
+func (s *Server) follow(ctx context.Context, matchID int, sub *subscription) {
+	defer s.subsWG.Done()
+	defer s.forget(matchID, sub)
+
+	ticker := time.NewTicker(s.poll)
+	defer ticker.Stop()
+	var last *api.MatchDetails
+	for {
+		details, err := s.details(ctx, matchID)
+		if err == nil && ctx.Err() == nil {
+			s.publishChanges(matchID, last, details)
+			last = details
+			if details.Status == api.MatchStatusFinished {
+				s.publish(Event{Event: EventFinished, MatchID: matchID, Data: scoreOf(details.Match)})
+				return
+			}
+		}
+		select {
+		case <-ctx.Done():
+			if s.isForgotten(matchID, sub) {
+				s.publish(Event{Event: EventUnsubscribed, MatchID: matchID})
+			}
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// publishChanges writes the events for what changed in a match since the last poll.
+func (s *Server) publishChanges(matchID int, last, details *api.MatchDetails) {
+	score := scoreOf(details.Match)
+	if last == nil {
+		s.publish(Event{Event: EventScore, MatchID: matchID, Data: score})
+		return
+	}
+	if !sameScore(score, scoreOf(last.Match)) {
+		s.publish(Event{Event: EventScore, MatchID: matchID, Data: score})
+	}
+	diff := fotmob.DiffEvents(last.Events, details.Events)
+	for _, event := range diff.Added {
+		s.publish(Event{Event: EventAdded, MatchID: matchID, Data: event})
+	}
+	for _, event := range diff.Changed {
+		s.publish(Event{Event: EventChanged, MatchID: matchID, Data: event})
+	}
+	for _, event := range diff.Removed {
+		s.publish(Event{Event: EventRemoved, MatchID: matchID, Data: event})
+	}
+}
+
+// forget drops a subscription that ended on its own. A newer subscription to the same
+// match is kept.
+func (s *Server) forget(matchID int, sub *subscription) {
+	s.subsMu.Lock()
+	defer s.subsMu.Unlock()
+	if s.subs[matchID] == sub {
+		delete(s.subs, matchID)
+	}
+	sub.cancel()
+}
+
+// isForgotten reports whether the client unsubscribed sub, as opposed to stdin closing.
+func (s *Server) isForgotten(matchID int, sub *subscription) bool {
+	s.subsMu.Lock()
+	defer s.subsMu.Unlock()
+	return s.subs[matchID] != sub
+}
+
+// scoreOf returns the score of a match.
+func scoreOf(match api.Match) Score {
+	return Score{
+		Status:    match.Status,
+		HomeScore: match.HomeScore,
+		AwayScore: match.AwayScore,
+		LiveTime:  match.LiveTime,
+		Clock:     match.Clock,
+		Shootout:  match.Shootout,
+	}
+}
+
+// sameScore reports whether two scores show the same thing.
+func sameScore(a, b Score) bool {
+	return a.Status == b.Status && equalPtr(a.HomeScore, b.HomeScore) && equalPtr(a.AwayScore, b.AwayScore) &&
+		equalPtr(a.LiveTime, b.LiveTime) && equalPtr(a.Clock, b.Clock) && shootoutScore(a.Shootout) == shootoutScore(b.Shootout)
+}
+
+// equalPtr reports whether two pointers are both nil or point to equal values.
+func equalPtr[T comparable](a, b *T) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
+
+// shootoutScore returns a shootout's score and number of kicks, or zeros.
+func shootoutScore(shootout *api.Shootout) [3]int {
+	if shootout == nil {
+		return [3]int{}
+	}
+	return [3]int{shootout.HomeScore, shootout.AwayScore, len(shootout.Kicks)}
+}
+
+// respond writes a response.
+func (s *Server) respond(resp Response) {
+	s.writeMu.Lock()
+	defer s.writeMu.Unlock()
+	_ = s.codec.writeResponse(resp)
+}
+
+// publish writes an event.
+func (s *Server) publish(event Event) {
+	s.writeMu.Lock()
+	defer s.writeMu.Unlock()
+	_ = s.codec.writeEvent(event)
+}
@@ -0,0 +1,330 @@
+// Package rules holds competition-specific league table rules: points per result
+// and the tiebreakers that order teams level on points.
+package rules
+
+import (
+	"sort"
+
+	"github.com/0xjuanma/golazo/internal/api"
+)
+
+// Tiebreaker is a criterion ordering teams level on points (and on every earlier tiebreaker).
+type Tiebreaker int
+
+const (
+	GoalDifference Tiebreaker = iota
+	GoalsScored
+	Wins
+	AwayGoals // Needs results
+	AwayWins  // Needs results
+
+	// Head-to-head criteria only count matches between the teams still tied, and need results.
+	HeadToHeadPoints
+	HeadToHeadGoalDifference
+	HeadToHeadGoals
+	HeadToHeadAwayGoals
+)
+
+// Rules are the points and tiebreakers of a competition's league table.
+type Rules struct {
+	Win         int
+	Draw        int
+	Games       int // Matches per team; 0 for a double round robin
+	Tiebreakers []Tiebreaker
+}
+
+// Default applies to competitions without specific rules: 3 points per win,
+// then goal difference and goals scored.
+var Default = Rules{Win: 3, Draw: 1, Tiebreakers: []Tiebreaker{GoalDifference, GoalsScored}}
+
+// uefaLeaguePhase is the league phase of the UEFA club competitions (from 2024/25).
+var uefaLeaguePhase = Rules{Win: 3, Draw: 1, Games: 8, Tiebreakers: []Tiebreaker{GoalDifference, GoalsScored, AwayGoals, Wins, AwayWins}}
+
+// byLeague maps FotMob league IDs to their rules.
+var byLeague = map[int]Rules{
+	// Premier League
+	47: {Win: 3, Draw: 1, Tiebreakers: []Tiebreaker{GoalDifference, GoalsScored, HeadToHeadPoints, HeadToHeadAwayGoals}},
+	// La Liga
+	87: {Win: 3, Draw: 1, Tiebreakers: []Tiebreaker{HeadToHeadPoints, HeadToHeadGoalDifference, GoalDifference, GoalsScored}},
+	// Serie A
+	55: {Win: 3, Draw: 1, Tiebreakers: []Tiebreaker{HeadToHeadPoints, HeadToHeadGoalDifference, GoalDifference, GoalsScored}},
+	// Bundesliga
+	54: {Win: 3, Draw: 1, Tiebreakers: []Tiebreaker{GoalDifference, GoalsScored, HeadToHeadPoints, HeadToHeadAwayGoals, AwayGoals}},
+	// Ligue 1
+	53: {Win: 3, Draw: 1, Tiebreakers: []Tiebreaker{GoalDifference, HeadToHeadPoints, HeadToHeadGoalDifference, HeadToHeadGoals, GoalsScored}},
+	// Primeira Liga
+	61: {Win: 3, Draw: 1, Tiebreakers: []Tiebreaker{HeadToHeadPoints, HeadToHeadGoalDifference, HeadToHeadGoals, GoalDifference, Wins, GoalsScored}},
+	// Eredivisie
+	57: {Win: 3, Draw: 1, Tiebreakers: []Tiebreaker{GoalDifference, GoalsScored, HeadToHeadPoints, HeadToHeadGoalDifference, HeadToHeadAwayGoals}},
+	// UEFA Champions League
+	42: uefaLeaguePhase,
+	// UEFA Europa League
+	73: uefaLeaguePhase,
+	// UEFA Conference League
+	10216: uefaLeaguePhase,
+	// FIFA World Cup (group stage)
+	77: {Win: 3, Draw: 1, Games: 3, Tiebreakers: []Tiebreaker{GoalDifference, GoalsScored, HeadToHeadPoints, HeadToHeadGoalDifference, HeadToHeadGoals}},
+	// UEFA Euro (group stage)
+	50: {Win: 3, Draw: 1, Games: 3, Tiebreakers: []Tiebreaker{HeadToHeadPoints, HeadToHeadGoalDifference, HeadToHeadGoals, GoalDifference, GoalsScored, Wins}},
+	// Copa America (group stage)
+	44: {Win: 3, Draw: 1, Games: 3, Tiebreakers: []Tiebreaker{GoalDifference, GoalsScored, HeadToHeadPoints}},
+}
+
+// For returns the rules of a competition by FotMob league ID, or Default.
+func For(leagueID int) Rules {
+	if r, ok := byLeague[leagueID]; ok {
+		return r
+	}
+	return Default
+}
+
+// Result is a played (or in progress) match between two teams of a table.
+type Result struct {
+	HomeTeamID int
+	AwayTeamID int
+	HomeGoals  int
+	AwayGoals  int
+}
+
+// Points returns the points earned by a team that scored and conceded the given goals.
+func (r Rules) Points(scored, conceded int) int {
+	switch {
+	case scored > conceded:
+		return r.Win
+	case scored == conceded:
+		return r.Draw
+	default:
+		return 0
+	}
+}
+
+// Apply adds a result to a team's table entry.
+func (r Rules) Apply(entry *api.LeagueTableEntry, scored, conceded int) {
+	entry.Played++
+	entry.GoalsFor += scored
+	entry.GoalsAgainst += conceded
+	entry.GoalDifference += scored - conceded
+	entry.Points += r.Points(scored, conceded)
+	switch {
+	case scored > conceded:
+		entry.Won++
+	case scored == conceded:
+		entry.Drawn++
+	default:
+		entry.Lost++
+	}
+}
+
+// Sort orders a table by points, then by the tiebreakers, and renumbers positions.
+// Head-to-head criteria are evaluated among the teams still tied at that step.
+// Criteria needing results cannot separate teams when results is empty; teams still
+// tied after every criterion keep their previous order. Zones belong to positions, so
+// they stay in place while teams move. The input is not modified.
+func (r Rules) Sort(table []api.LeagueTableEntry, results []Result) []api.LeagueTableEntry {
+	sorted := make([]api.LeagueTableEntry, len(table))
+	copy(sorted, table)
+	sort.SliceStable(sorted, func(i, j int) bool { return sorted[i].Position < sorted[j].Position })
+	zones := make([][2]string, len(sorted))
+	for i, e := range sorted {
+		zones[i] = [2]string{e.Zone, e.ZoneColor}
+	}
+
+	sortByKey(sorted, func(e api.LeagueTableEntry) int { return e.Points })
+	start := 0
+	for i := 1; i <= len(sorted); i++ {
+		if i == len(sorted) || sorted[i].Points != sorted[start].Points {
+			r.breakTies(sorted[start:i], r.Tiebreakers, results)
+			start = i
+		}
+	}
+
+	for i := range sorted {
+		sorted[i].Position = i + 1
+		sorted[i].Zone, sorted[i].ZoneColor = zones[i][0], zones[i][1]
+	}
+	return sorted
+}
+
+// breakTies orders a group of teams tied so far with the first tiebreaker,
+// then each sub-group still tied with the remaining ones.
+func (r Rules) breakTies(group []api.LeagueTableEntry, tiebreakers []Tiebreaker, results []Result) {
+	if len(group) < 2 || len(tiebreakers) == 0 {
+		return
+	}
+
+	keys := r.keys(tiebreakers[0], group, results)
+	key := func(e api.LeagueTableEntry) int { return keys[e.Team.ID] }
+	sortByKey(group, key)
+
+	start := 0
+	for i := 1; i <= len(group); i++ {
+		if i == len(group) || key(group[i]) != key(group[start]) {
+			r.breakTies(group[start:i], tiebreakers[1:], results)
+			start = i
+		}
+	}
+}
+
+// keys computes a tiebreaker's value (higher ranks first) for each team of a group.
+func (r Rules) keys(t Tiebreaker, group []api.LeagueTableEntry, results []Result) map[int]int {
+	keys := make(map[int]int, len(group))
+	inGroup := make(map[int]bool, len(group))
+	for _, e := range group {
+		inGroup[e.Team.ID] = true
+		switch t {
+		case GoalDifference:
+			keys[e.Team.ID] = e.GoalDifference
+		case GoalsScored:
+			keys[e.Team.ID] = e.GoalsFor
+		case Wins:
+			keys[e.Team.ID] = e.Won
+		}
+	}
+
+	for _, res := range results {
+		headToHead := inGroup[res.HomeTeamID] && inGroup[res.AwayTeamID]
+		switch t {
+		case AwayGoals:
+			keys[res.AwayTeamID] += res.AwayGoals
+		case AwayWins:
+			if res.AwayGoals > res.HomeGoals {
+				keys[res.AwayTeamID]++
+			}
+		case HeadToHeadPoints:
+			if headToHead {
+				keys[res.HomeTeamID] += r.Points(res.HomeGoals, res.AwayGoals)
+				keys[res.AwayTeamID] += r.Points(res.AwayGoals, res.HomeGoals)
+			}
+		case HeadToHeadGoalDifference:
+			if headToHead {
+				keys[res.HomeTeamID] += res.HomeGoals - res.AwayGoals
+				keys[res.AwayTeamID] += res.AwayGoals - res.HomeGoals
+			}
+		case HeadToHeadGoals:
+			if headToHead {
+				keys[res.HomeTeamID] += res.HomeGoals
+				keys[res.AwayTeamID] += res.AwayGoals
+			}
+		case HeadToHeadAwayGoals:
+			if headToHead {
+				keys[res.AwayTeamID] += res.AwayGoals
+			}
+		}
+	}
+	return keys
+}
+
+// sortByKey stably sorts entries by descending key.
+func sortByKey(entries []api.LeagueTableEntry, key func(api.LeagueTableEntry) int) {
+	sort.SliceStable(entries, func(i, j int) bool { return key(entries[i]) > key(entries[j]) })
+}
+
+// Scenarios returns what each result of an upcoming or live match settles for its two teams:
+// a qualification zone secured, or every qualification zone out of reach. Qualification
+// zones are the zones starting in the top half of the table. The table must not include
+// the match. Other remaining results are unknown, so a team only secures a zone it holds
+// whatever they are, with ties on points counted against it. What is already settled
+// before the match is left out.
+func (r Rules) Scenarios(table []api.LeagueTableEntry, homeTeamID, awayTeamID int) []api.QualificationScenario {
+	entries := make([]api.LeagueTableEntry, len(table))
+	copy(entries, table)
+	sort.SliceStable(entries, func(i, j int) bool { return entries[i].Position < entries[j].Position })
+
+	zones := make([]string, len(entries))
+	qualifying := 0 // Lowest position within a qualification zone
+	start := 0      // First position of the current zone
+	for i, e := range entries {
+		zones[i] = e.Zone
+		if i > 0 && e.Zone != entries[i-1].Zone {
+			start = i
+		}
+		if e.Zone != "" && start < len(entries)/2 {
+			qualifying = i + 1
+		}
+	}
+
+	home, away := -1, -1
+	for i, e := range entries {
+		switch e.Team.ID {
+		case homeTeamID:
+			home = i
+		case awayTeamID:
+			away = i
+		}
+	}
+	if qualifying == 0 || home < 0 || away < 0 {
+		return nil
+	}
+
+	games := r.Games
+	if games == 0 {
+		games = 2 * (len(entries) - 1)
+	}
+	before := map[int]outlook{
+		home: r.outlook(entries, home, games, zones, qualifying),
+		away: r.outlook(entries, away, games, zones, qualifying),
+	}
+
+	var scenarios []api.QualificationScenario
+	for _, outcome := range []api.MatchOutcome{api.OutcomeHomeWin, api.OutcomeDraw, api.OutcomeAwayWin} {
+		homeGoals, awayGoals := 0, 0
+		switch outcome {
+		case api.OutcomeHomeWin:
+			homeGoals = 1
+		case api.OutcomeAwayWin:
+			awayGoals = 1
+		}
+		after := make([]api.LeagueTableEntry, len(entries))
+		copy(after, entries)
+		r.Apply(&after[home], homeGoals, awayGoals)
+		r.Apply(&after[away], awayGoals, homeGoals)
+
+		for _, team := range []int{home, away} {
+			id := entries[team].Team.ID
+			now := r.outlook(after, team, games, zones, qualifying)
+			if now.zone != "" && now.zone != before[team].zone {
+				scenarios = append(scenarios, api.QualificationScenario{Outcome: outcome, TeamID: id, Zone: now.zone})
+			}
+			if now.out && !before[team].out {
+				scenarios = append(scenarios, api.QualificationScenario{Outcome: outcome, TeamID: id, Out: true})
+			}
+		}
+	}
+	return scenarios
+}
+
+// outlook is what is settled for a team whatever the remaining results.
+type outlook struct {
+	zone string // Qualification zone secured
+	out  bool   // Cannot finish within the qualification zones
+}
+
+// outlook bounds the final position of entries[team] from the points each team can still
+// earn in its remaining games. zones holds the zone of each position, and qualifying is the
+// lowest position within a qualification zone.
+func (r Rules) outlook(entries []api.LeagueTableEntry, team, games int, zones []string, qualifying int) outlook {
+	maxPoints := func(e api.LeagueTableEntry) int {
+		return e.Points + r.Win*max(games-e.Played, 0)
+	}
+
+	self := entries[team]
+	worst, best := 1, 1
+	for i, e := range entries {
+		if i == team {
+			continue
+		}
+		if maxPoints(e) >= self.Points {
+			worst++
+		}
+		if e.Points > maxPoints(self) {
+			best++
+		}
+	}
+
+	var o outlook
+	if worst <= qualifying {
+		o.zone = zones[worst-1]
+	}
+	o.out = best > qualifying
+	return o
+}
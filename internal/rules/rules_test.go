@@ -0,0 +1,180 @@
+package rules
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/0xjuanma/golazo/internal/api"
+)
+
+// entry builds a table entry; position is the order before sorting.
+func entry(id, position, points, goalsFor, goalsAgainst int) api.LeagueTableEntry {
+	return api.LeagueTableEntry{
+		Position:       position,
+		Team:           api.Team{ID: id},
+		Points:         points,
+		GoalsFor:       goalsFor,
+		GoalsAgainst:   goalsAgainst,
+		GoalDifference: goalsFor - goalsAgainst,
+	}
+}
+
+func TestSort(t *testing.T) {
+	tests := []struct {
+		desc     string
+		leagueID int
+		table    []api.LeagueTableEntry
+		results  []Result
+		want     []int // Team IDs in order
+	}{
+		{
+			desc:     "points first",
+			leagueID: 0,
+			table:    []api.LeagueTableEntry{entry(1, 1, 10, 5, 5), entry(2, 2, 12, 1, 9)},
+			want:     []int{2, 1},
+		},
+		{
+			desc:     "default: goal difference, then goals scored",
+			leagueID: 0,
+			table:    []api.LeagueTableEntry{entry(1, 1, 10, 8, 5), entry(2, 2, 10, 9, 5), entry(3, 3, 10, 10, 6)},
+			want:     []int{3, 2, 1},
+		},
+		{
+			desc:     "premier league: goal difference before head-to-head",
+			leagueID: 47,
+			table:    []api.LeagueTableEntry{entry(1, 1, 10, 5, 5), entry(2, 2, 10, 8, 5)},
+			results:  []Result{{HomeTeamID: 1, AwayTeamID: 2, HomeGoals: 2, AwayGoals: 0}},
+			want:     []int{2, 1},
+		},
+		{
+			desc:     "la liga: head-to-head before goal difference",
+			leagueID: 87,
+			table:    []api.LeagueTableEntry{entry(1, 1, 10, 8, 5), entry(2, 2, 10, 5, 5)},
+			results:  []Result{{HomeTeamID: 1, AwayTeamID: 2, HomeGoals: 0, AwayGoals: 1}},
+			want:     []int{2, 1},
+		},
+		{
+			desc:     "la liga: falls back to goal difference without results",
+			leagueID: 87,
+			table:    []api.LeagueTableEntry{entry(1, 1, 10, 5, 5), entry(2, 2, 10, 8, 5)},
+			want:     []int{2, 1},
+		},
+		{
+			desc:     "serie a: three-way tie on head-to-head points split by head-to-head goal difference",
+			leagueID: 55,
+			table:    []api.LeagueTableEntry{entry(1, 1, 10, 9, 5), entry(2, 2, 10, 5, 5), entry(3, 3, 10, 5, 9)},
+			results: []Result{
+				{HomeTeamID: 1, AwayTeamID: 2, HomeGoals: 1, AwayGoals: 0},
+				{HomeTeamID: 2, AwayTeamID: 3, HomeGoals: 3, AwayGoals: 0},
+				{HomeTeamID: 3, AwayTeamID: 1, HomeGoals: 1, AwayGoals: 0},
+			},
+			want: []int{2, 1, 3},
+		},
+		{
+			desc:     "head-to-head only counts matches between tied teams",
+			leagueID: 87,
+			table:    []api.LeagueTableEntry{entry(1, 1, 10, 5, 5), entry(2, 2, 10, 5, 5), entry(3, 3, 20, 5, 5)},
+			results: []Result{
+				{HomeTeamID: 1, AwayTeamID: 3, HomeGoals: 5, AwayGoals: 0},
+				{HomeTeamID: 2, AwayTeamID: 1, HomeGoals: 1, AwayGoals: 0},
+			},
+			want: []int{3, 2, 1},
+		},
+		{
+			desc:     "bundesliga: head-to-head away goals after goals scored",
+			leagueID: 54,
+			table:    []api.LeagueTableEntry{entry(1, 1, 10, 6, 4), entry(2, 2, 10, 6, 4)},
+			results: []Result{
+				{HomeTeamID: 1, AwayTeamID: 2, HomeGoals: 1, AwayGoals: 2},
+				{HomeTeamID: 2, AwayTeamID: 1, HomeGoals: 0, AwayGoals: 1},
+			},
+			want: []int{2, 1},
+		},
+		{
+			desc:     "champions league: away goals after goals scored",
+			leagueID: 42,
+			table:    []api.LeagueTableEntry{entry(1, 1, 9, 7, 3), entry(2, 2, 9, 7, 3)},
+			results: []Result{
+				{HomeTeamID: 5, AwayTeamID: 2, HomeGoals: 0, AwayGoals: 3},
+				{HomeTeamID: 6, AwayTeamID: 1, HomeGoals: 0, AwayGoals: 1},
+			},
+			want: []int{2, 1},
+		},
+		{
+			desc:     "still tied keeps previous order",
+			leagueID: 47,
+			table:    []api.LeagueTableEntry{entry(2, 1, 10, 5, 5), entry(1, 2, 10, 5, 5)},
+			want:     []int{2, 1},
+		},
+	}
+
+	for _, tt := range tests {
+		sorted := For(tt.leagueID).Sort(tt.table, tt.results)
+		var got []int
+		for i, e := range sorted {
+			got = append(got, e.Team.ID)
+			if e.Position != i+1 {
+				t.Errorf("%s: position of team %d = %d; want %d", tt.desc, e.Team.ID, e.Position, i+1)
+			}
+		}
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("%s: order = %v; want %v", tt.desc, got, tt.want)
+		}
+	}
+}
+
+func TestSortKeepsZonesInPlace(t *testing.T) {
+	top := entry(1, 1, 10, 5, 5)
+	top.Zone, top.ZoneColor = "Promotion", "#2AD572"
+	sorted := Default.Sort([]api.LeagueTableEntry{top, entry(2, 2, 12, 5, 5)}, nil)
+	if sorted[0].Team.ID != 2 || sorted[0].Zone != "Promotion" || sorted[1].Zone != "" {
+		t.Errorf("zones after sort = %q, %q; want the zone to stay at position 1", sorted[0].Zone, sorted[1].Zone)
+	}
+}
+
+func TestApply(t *testing.T) {
+	tests := []struct {
+		scored, conceded int
+		want             api.LeagueTableEntry
+		desc             string
+	}{
+		{2, 1, api.LeagueTableEntry{Played: 1, Won: 1, GoalsFor: 2, GoalsAgainst: 1, GoalDifference: 1, Points: 3}, "win"},
+		{1, 1, api.LeagueTableEntry{Played: 1, Drawn: 1, GoalsFor: 1, GoalsAgainst: 1, Points: 1}, "draw"},
+		{0, 2, api.LeagueTableEntry{Played: 1, Lost: 1, GoalsAgainst: 2, GoalDifference: -2}, "loss"},
+	}
+
+	for _, tt := range tests {
+		var got api.LeagueTableEntry
+		Default.Apply(&got, tt.scored, tt.conceded)
+		if got != tt.want {
+			t.Errorf("Apply(%d, %d) = %+v; want %+v - %s", tt.scored, tt.conceded, got, tt.want, tt.desc)
+		}
+	}
+}
+
+func TestScenarios(t *testing.T) {
+	// Last matchday of a World Cup group: 2 (home) vs 3 (away), 1 vs 4 still to play
+	group := []api.LeagueTableEntry{
+		{Position: 1, Team: api.Team{ID: 1}, Played: 2, Points: 6, Zone: "Round of 32"},
+		{Position: 2, Team: api.Team{ID: 2}, Played: 2, Points: 3, Zone: "Round of 32"},
+		{Position: 3, Team: api.Team{ID: 3}, Played: 2, Points: 3},
+		{Position: 4, Team: api.Team{ID: 4}, Played: 2, Points: 0},
+	}
+	want := []api.QualificationScenario{
+		{Outcome: api.OutcomeHomeWin, TeamID: 2, Zone: "Round of 32"},
+		{Outcome: api.OutcomeHomeWin, TeamID: 3, Out: true},
+		{Outcome: api.OutcomeAwayWin, TeamID: 2, Out: true},
+		{Outcome: api.OutcomeAwayWin, TeamID: 3, Zone: "Round of 32"},
+	}
+	if got := For(77).Scenarios(group, 2, 3); !reflect.DeepEqual(got, want) {
+		t.Errorf("Scenarios() = %+v; want %+v", got, want)
+	}
+
+	// Too early for anything to be settled
+	for i := range group {
+		group[i].Played--
+	}
+	if got := For(77).Scenarios(group, 2, 3); len(got) != 0 {
+		t.Errorf("Scenarios() on matchday 2 = %+v; want none", got)
+	}
+}
@@ -0,0 +1,49 @@
+package trace
+
+import (
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// Call is the most recent provider call, kept for diagnostics (e.g., the developer overlay).
+type Call struct {
+	Provider string // First word of the span name (e.g., "fotmob", "reddit")
+	Name     string
+	Duration time.Duration
+	Err      error
+	At       time.Time
+}
+
+var (
+	callsMu   sync.Mutex
+	lastCalls = make(map[string]Call)
+	inFlight  atomic.Int64 // Spans started but not done
+)
+
+// InFlight returns the number of provider calls in progress.
+func InFlight() int {
+	return int(inFlight.Load())
+}
+
+// record keeps s as the last call of its provider.
+func record(s span) {
+	provider, _, _ := strings.Cut(s.name, " ")
+	callsMu.Lock()
+	defer callsMu.Unlock()
+	lastCalls[provider] = Call{Provider: provider, Name: s.name, Duration: s.end.Sub(s.start), Err: s.err, At: s.end}
+}
+
+// LastCalls returns the most recent call of each provider, sorted by provider.
+func LastCalls() []Call {
+	callsMu.Lock()
+	defer callsMu.Unlock()
+	calls := make([]Call, 0, len(lastCalls))
+	for _, c := range lastCalls {
+		calls = append(calls, c)
+	}
+	sort.Slice(calls, func(i, j int) bool { return calls[i].Provider < calls[j].Provider })
+	return calls
+}
@@ -0,0 +1,125 @@
+package trace
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// OTLP export settings.
+const (
+	otlpBatchInterval = 5 * time.Second
+	otlpQueueSize     = 256 // Spans beyond this are dropped while the collector is slow
+)
+
+// span is a finished provider call.
+type span struct {
+	traceID string
+	action  string
+	name    string
+	start   time.Time
+	end     time.Time
+	err     error
+}
+
+var (
+	exporterMu sync.Mutex
+	queue      chan span // nil when OTLP export is disabled
+)
+
+// EnableOTLP exports spans to an OTLP/HTTP collector (JSON encoding), e.g.
+// "http://localhost:4318". Spans are batched and sent in the background;
+// failures are ignored so tracing never affects the app.
+func EnableOTLP(endpoint string) {
+	exporterMu.Lock()
+	defer exporterMu.Unlock()
+	if queue != nil || endpoint == "" {
+		return
+	}
+	queue = make(chan span, otlpQueueSize)
+	go runExporter(strings.TrimSuffix(endpoint, "/")+"/v1/traces", queue)
+}
+
+// export queues a span for OTLP export, dropping it if export is off or the queue is full.
+func export(s span) {
+	exporterMu.Lock()
+	q := queue
+	exporterMu.Unlock()
+	if q == nil {
+		return
+	}
+	select {
+	case q <- s:
+	default:
+	}
+}
+
+// runExporter sends queued spans every otlpBatchInterval.
+func runExporter(url string, q <-chan span) {
+	client := &http.Client{Timeout: 5 * time.Second}
+	ticker := time.NewTicker(otlpBatchInterval)
+	defer ticker.Stop()
+
+	var batch []span
+	for {
+		select {
+		case s := <-q:
+			batch = append(batch, s)
+		case <-ticker.C:
+			if len(batch) == 0 {
+				continue
+			}
+			body, err := json.Marshal(otlpRequest(batch))
+			batch = nil
+			if err != nil {
+				continue
+			}
+			resp, err := client.Post(url, "application/json", bytes.NewReader(body))
+			if err == nil {
+				_ = resp.Body.Close()
+			}
+		}
+	}
+}
+
+// otlpRequest builds an OTLP/JSON ExportTraceServiceRequest for spans.
+func otlpRequest(spans []span) map[string]any {
+	otlpSpans := make([]map[string]any, len(spans))
+	for i, s := range spans {
+		status := map[string]any{"code": 1} // STATUS_CODE_OK
+		if s.err != nil {
+			status = map[string]any{"code": 2, "message": s.err.Error()} // STATUS_CODE_ERROR
+		}
+		otlpSpans[i] = map[string]any{
+			"traceId":           s.traceID,
+			"spanId":            randomHex(8),
+			"name":              s.name,
+			"kind":              3, // SPAN_KIND_CLIENT
+			"startTimeUnixNano": strconv.FormatInt(s.start.UnixNano(), 10),
+			"endTimeUnixNano":   strconv.FormatInt(s.end.UnixNano(), 10),
+			"attributes":        []map[string]any{stringAttr("golazo.action", s.action)},
+			"status":            status,
+		}
+	}
+
+	return map[string]any{
+		"resourceSpans": []map[string]any{{
+			"resource": map[string]any{
+				"attributes": []map[string]any{stringAttr("service.name", "golazo")},
+			},
+			"scopeSpans": []map[string]any{{
+				"scope": map[string]any{"name": "github.com/0xjuanma/golazo/internal/trace"},
+				"spans": otlpSpans,
+			}},
+		}},
+	}
+}
+
+// stringAttr builds an OTLP string attribute.
+func stringAttr(key, value string) map[string]any {
+	return map[string]any{"key": key, "value": map[string]any{"stringValue": value}}
+}
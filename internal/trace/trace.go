@@ -0,0 +1,96 @@
+// Package trace tags user actions (refresh, select match, fetch goal links) with a
+// correlation ID that follows their provider calls through context.Context, so slow
+// or failing requests in the debug log can be tied to the action that caused them.
+package trace
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// action is the user action a context belongs to.
+type action struct {
+	id   string // 32 hex chars, doubles as the OTLP trace ID
+	name string
+}
+
+type ctxKey struct{}
+
+var (
+	loggerMu sync.RWMutex
+	logger   func(string)
+)
+
+// SetLogger sets where trace lines are written (e.g., the debug log). nil disables logging.
+func SetLogger(fn func(string)) {
+	loggerMu.Lock()
+	defer loggerMu.Unlock()
+	logger = fn
+}
+
+// logf writes a trace line when a logger is set.
+func logf(format string, args ...any) {
+	loggerMu.RLock()
+	fn := logger
+	loggerMu.RUnlock()
+	if fn != nil {
+		fn(fmt.Sprintf(format, args...))
+	}
+}
+
+// Start returns a context for a new user action with a fresh correlation ID.
+func Start(ctx context.Context, name string) context.Context {
+	a := &action{id: randomHex(16), name: name}
+	logf("[%s] %s", a.id[:8], name)
+	return context.WithValue(ctx, ctxKey{}, a)
+}
+
+// ID returns the short correlation ID of the action in ctx ("-" outside an action).
+func ID(ctx context.Context) string {
+	if a, ok := ctx.Value(ctxKey{}).(*action); ok {
+		return a.id[:8]
+	}
+	return "-"
+}
+
+// Span times a provider call made on behalf of the action in ctx. Call the returned
+// function with the call's error when it completes:
+//
+//	done := trace.Span(ctx, "fotmob GET /api/matchDetails")
+//	body, err := fetch()
+//	done(err)
+func Span(ctx context.Context, name string) func(error) {
+	a, _ := ctx.Value(ctxKey{}).(*action)
+	start := time.Now()
+	inFlight.Add(1)
+	return func(err error) {
+		inFlight.Add(-1)
+		elapsed := time.Since(start)
+		id, actionName := "-", ""
+		if a != nil {
+			id, actionName = a.id[:8], a.name
+		}
+		if err != nil {
+			logf("[%s] %s %s error: %v", id, name, elapsed.Round(time.Millisecond), err)
+		} else {
+			logf("[%s] %s %s", id, name, elapsed.Round(time.Millisecond))
+		}
+		s := span{action: actionName, name: name, start: start, end: start.Add(elapsed), err: err}
+		record(s)
+		if a != nil {
+			s.traceID = a.id
+			export(s)
+		}
+	}
+}
+
+// randomHex returns n random bytes as hex.
+func randomHex(n int) string {
+	b := make([]byte, n)
+	_, _ = rand.Read(b)
+	return hex.EncodeToString(b)
+}
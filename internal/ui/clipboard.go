@@ -0,0 +1,69 @@
+package ui
+
+import (
+	"encoding/base64"
+	"fmt"
+	"os"
+	"os/exec"
+	"runtime"
+	"strings"
+)
+
+// CopyToClipboard copies text to the system clipboard.
+// Uses the platform clipboard tool when available, falling back to the OSC52
+// terminal escape sequence (works over SSH in most modern terminals).
+// Over SSH, OSC52 is always used since local tools would copy on the remote host.
+func CopyToClipboard(text string) error {
+	if !isSSHSession() {
+		if err := copyWithTool(text); err == nil {
+			return nil
+		}
+	}
+	return copyWithOSC52(text)
+}
+
+// isSSHSession reports whether golazo is running in an SSH session.
+func isSSHSession() bool {
+	return os.Getenv("SSH_TTY") != "" || os.Getenv("SSH_CONNECTION") != ""
+}
+
+// copyWithTool pipes text into the first available platform clipboard tool.
+func copyWithTool(text string) error {
+	var candidates [][]string
+	switch runtime.GOOS {
+	case "darwin":
+		candidates = [][]string{{"pbcopy"}}
+	case "windows":
+		candidates = [][]string{{"clip"}}
+	default:
+		candidates = [][]string{
+			{"wl-copy"},
+			{"xclip", "-selection", "clipboard"},
+			{"xsel", "--clipboard", "--input"},
+		}
+	}
+
+	for _, candidate := range candidates {
+		if _, err := exec.LookPath(candidate[0]); err != nil {
+			continue
+		}
+		cmd := exec.Command(candidate[0], candidate[1:]...)
+		cmd.Stdin = strings.NewReader(text)
+		if err := cmd.Run(); err == nil {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("no clipboard tool available")
+}
+
+// copyWithOSC52 asks the terminal to set the clipboard via the OSC52 escape sequence.
+// Wrapped in a DCS passthrough when running inside tmux.
+func copyWithOSC52(text string) error {
+	seq := "\x1b]52;c;" + base64.StdEncoding.EncodeToString([]byte(text)) + "\a"
+	if os.Getenv("TMUX") != "" {
+		seq = "\x1bPtmux;\x1b" + seq + "\x1b\\"
+	}
+	_, err := os.Stdout.WriteString(seq)
+	return err
+}
@@ -0,0 +1,17 @@
+package ui
+
+import (
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+// OverlayCommandLine draws the ":" command prompt over the last line of a rendered
+// view, where the help text is.
+func OverlayCommandLine(view string, width int, prompt string) string {
+	line := lipgloss.NewStyle().Width(width).MaxWidth(width).Render(prompt)
+	if i := strings.LastIndex(view, "\n"); i >= 0 {
+		return view[:i+1] + line
+	}
+	return line
+}
@@ -0,0 +1,66 @@
+package ui
+
+import (
+	"fmt"
+
+	"github.com/0xjuanma/golazo/internal/api"
+	"github.com/0xjuanma/golazo/internal/constants"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// commentaryMinuteWidth is the width of the minute column, e.g. "90+4' ".
+const commentaryMinuteWidth = 7
+
+// Commentary is the text commentary tab of the match details panel.
+type Commentary struct {
+	Entries []api.CommentaryEntry // Newest first
+	Offset  int                   // Index of the entry shown at the top while scrolled back
+	Loading bool
+}
+
+// renderCommentarySection renders the commentary from the scroll offset, newest first,
+// wrapping each line next to its minute.
+func renderCommentarySection(cfg MatchDetailsConfig, contentWidth int) string {
+	c := cfg.Commentary
+
+	titleText := constants.PanelCommentary
+	if c.Offset > 0 && len(c.Entries) > 0 {
+		titleText += "  " + neonValueStyle.Render(fmt.Sprintf("↓ %d/%d", c.Offset+1, len(c.Entries)))
+	}
+	title := lipgloss.NewStyle().
+		Foreground(neonCyan).
+		Bold(true).
+		BorderBottom(true).
+		BorderStyle(lipgloss.NormalBorder()).
+		BorderForeground(neonDarkDim).
+		Width(cfg.Width - 6).
+		Render(titleText)
+	lines := []string{title}
+
+	switch {
+	case len(c.Entries) > 0:
+		textStyle := lipgloss.NewStyle().Width(max(contentWidth-commentaryMinuteWidth, 10))
+		minuteStyle := neonDimStyle.Width(commentaryMinuteWidth)
+		for _, entry := range c.Entries[min(c.Offset, len(c.Entries)-1):] {
+			style := textStyle.Foreground(neonWhite)
+			switch entry.Type {
+			case "goal":
+				style = style.Foreground(neonCyan).Bold(true)
+			case "card":
+				style = style.Foreground(neonYellow)
+			case "substitution":
+				style = style.Foreground(neonDim)
+			}
+			lines = append(lines, lipgloss.JoinHorizontal(lipgloss.Top,
+				minuteStyle.Render(entry.Minute),
+				style.Render(entry.Text),
+			))
+		}
+	case c.Loading:
+		lines = append(lines, neonDimStyle.Render("Loading commentary..."))
+	default:
+		lines = append(lines, neonDimStyle.Render(constants.EmptyNoCommentary))
+	}
+
+	return lipgloss.JoinVertical(lipgloss.Left, lines...)
+}
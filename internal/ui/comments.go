@@ -0,0 +1,53 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/0xjuanma/golazo/internal/constants"
+	"github.com/0xjuanma/golazo/internal/ui/design"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// minCommentsPanelWidth is the terminal width needed to show the Match Thread panel
+// alongside the list and details panels.
+const minCommentsPanelWidth = 150
+
+// LiveListPanelWidth returns the live view's list panel width for a terminal width.
+// The list narrows when the Match Thread panel is shown.
+func LiveListPanelWidth(width int, withComments bool) int {
+	if withComments && width >= minCommentsPanelWidth {
+		return width * 28 / 100
+	}
+	return max(width*35/100, 25)
+}
+
+// ThreadComment is a Match Thread comment prepared for display.
+type ThreadComment struct {
+	Author string
+	Body   string
+	Score  int
+	Age    string // Relative age, e.g. "3m"
+}
+
+// renderCommentsPanel renders the newest Match Thread comments, newest first,
+// wrapping each body to the panel width and cutting off at the panel height.
+func renderCommentsPanel(width, height int, comments []ThreadComment) string {
+	contentWidth := width - 4
+	lines := []string{design.RenderHeader(constants.PanelMatchThread, contentWidth)}
+
+	authorStyle := neonDimStyle
+	bodyStyle := lipgloss.NewStyle().Foreground(neonWhite).Width(contentWidth)
+
+	for _, c := range comments {
+		meta := authorStyle.Render(fmt.Sprintf("▲%d  u/%s  %s", c.Score, c.Author, c.Age))
+		body := bodyStyle.Render(strings.Join(strings.Fields(c.Body), " "))
+		lines = append(lines, meta, body, "")
+	}
+
+	return neonPanelCyanStyle.
+		Width(width).
+		Height(height).
+		MaxHeight(height).
+		Render(truncateToHeight(strings.Join(lines, "\n"), height))
+}
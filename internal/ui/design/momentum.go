@@ -0,0 +1,76 @@
+package design
+
+import (
+	"math"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+// MomentumChart configures a momentum area chart: home pressure rises above a
+// timeline axis and away pressure hangs below it.
+type MomentumChart struct {
+	Values    []float64      // One per column, from -1 (away dominating) to 1 (home dominating)
+	Height    int            // Rows on each side of the axis
+	Markers   map[int]string // Styled single-cell markers drawn on the axis, by column
+	HomeColor lipgloss.TerminalColor
+	AwayColor lipgloss.TerminalColor
+	AxisColor lipgloss.TerminalColor
+}
+
+// RenderMomentum renders the chart with half-block characters, giving two levels per row.
+func RenderMomentum(c MomentumChart) string {
+	if len(c.Values) == 0 || c.Height < 1 {
+		return ""
+	}
+	homeStyle := lipgloss.NewStyle().Foreground(c.HomeColor)
+	awayStyle := lipgloss.NewStyle().Foreground(c.AwayColor)
+	axisStyle := lipgloss.NewStyle().Foreground(c.AxisColor)
+
+	// Bar length of each column in half rows
+	levels := make([]int, len(c.Values))
+	for i, v := range c.Values {
+		levels[i] = int(math.Round(max(min(v, 1), -1) * float64(c.Height*2)))
+	}
+
+	rows := make([]string, 0, c.Height*2+1)
+	for r := c.Height - 1; r >= 0; r-- {
+		var row strings.Builder
+		for _, level := range levels {
+			row.WriteString(halfBlock(level-r*2, "▄"))
+		}
+		rows = append(rows, homeStyle.Render(row.String()))
+	}
+
+	var axis strings.Builder
+	for i := range c.Values {
+		if marker, ok := c.Markers[i]; ok {
+			axis.WriteString(marker)
+			continue
+		}
+		axis.WriteString(axisStyle.Render("─"))
+	}
+	rows = append(rows, axis.String())
+
+	for r := 0; r < c.Height; r++ {
+		var row strings.Builder
+		for _, level := range levels {
+			row.WriteString(halfBlock(-level-r*2, "▀"))
+		}
+		rows = append(rows, awayStyle.Render(row.String()))
+	}
+	return strings.Join(rows, "\n")
+}
+
+// halfBlock returns the cell for the part of a bar that reaches into a row:
+// a full block, the given half block, or a space.
+func halfBlock(level int, half string) string {
+	switch {
+	case level >= 2:
+		return "█"
+	case level == 1:
+		return half
+	default:
+		return " "
+	}
+}
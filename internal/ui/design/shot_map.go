@@ -0,0 +1,126 @@
+package design
+
+import (
+	"math"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+// Pitch dimensions in metres, matching FotMob shot coordinates.
+const (
+	pitchLength = 105.0
+	pitchWidth  = 68.0
+)
+
+// ShotMapPoint is a shot to draw: its position in pitch metres (X along the pitch
+// towards the goal attacked, Y across it) and a single-cell styled marker.
+type ShotMapPoint struct {
+	X, Y   float64
+	Marker string
+}
+
+// RenderShotMap renders the attacking half of a pitch with braille lines, goal at the
+// top, and each shot's marker at its position. Later points are drawn over earlier ones.
+func RenderShotMap(points []ShotMapPoint, width, height int, lineColor lipgloss.TerminalColor) string {
+	if width < 10 || height < 5 {
+		return ""
+	}
+	c := newBrailleCanvas(width, height)
+
+	// Map pitch metres to dots: Y across the width, X from the goal line (top) to halfway (bottom)
+	dot := func(x, y float64) (int, int) {
+		px := y / pitchWidth * float64(c.dotsWide()-1)
+		py := (pitchLength - x) / (pitchLength / 2) * float64(c.dotsHigh()-1)
+		return int(math.Round(px)), int(math.Round(py))
+	}
+	line := func(x1, y1, x2, y2 float64) {
+		steps := 2 * max(c.dotsWide(), c.dotsHigh())
+		for i := 0; i <= steps; i++ {
+			t := float64(i) / float64(steps)
+			c.set(dot(x1+(x2-x1)*t, y1+(y2-y1)*t))
+		}
+	}
+	box := func(depth, boxWidth float64) {
+		left, right := (pitchWidth-boxWidth)/2, (pitchWidth+boxWidth)/2
+		line(pitchLength, left, pitchLength-depth, left)
+		line(pitchLength-depth, left, pitchLength-depth, right)
+		line(pitchLength-depth, right, pitchLength, right)
+	}
+	arc := func(cx, cy, radius, from, to float64) {
+		steps := 4 * max(c.dotsWide(), c.dotsHigh())
+		for i := 0; i <= steps; i++ {
+			a := from + (to-from)*float64(i)/float64(steps)
+			c.set(dot(cx+radius*math.Cos(a), cy+radius*math.Sin(a)))
+		}
+	}
+
+	// Outline, penalty area, six-yard box, goal, penalty arc and centre circle
+	line(pitchLength, 0, pitchLength, pitchWidth)
+	line(pitchLength, 0, pitchLength/2, 0)
+	line(pitchLength, pitchWidth, pitchLength/2, pitchWidth)
+	line(pitchLength/2, 0, pitchLength/2, pitchWidth)
+	box(16.5, 40.32)
+	box(5.5, 18.32)
+	line(pitchLength+0.8, (pitchWidth-7.32)/2, pitchLength+0.8, (pitchWidth+7.32)/2)
+	arcAngle := math.Acos(5.5 / 9.15) // Part of the circle around the spot outside the area
+	arc(pitchLength-11, pitchWidth/2, 9.15, math.Pi-arcAngle, math.Pi+arcAngle)
+	arc(pitchLength/2, pitchWidth/2, 9.15, -math.Pi/2, math.Pi/2)
+
+	lineStyle := lipgloss.NewStyle().Foreground(lineColor)
+	grid := c.cells(lineStyle)
+	for _, p := range points {
+		px, py := dot(min(max(p.X, pitchLength/2), pitchLength), min(max(p.Y, 0), pitchWidth))
+		grid[py/4][px/2] = p.Marker
+	}
+
+	rows := make([]string, len(grid))
+	for i, row := range grid {
+		rows[i] = strings.Join(row, "")
+	}
+	return strings.Join(rows, "\n")
+}
+
+// brailleCanvas is a grid of terminal cells of 2x4 braille dots each.
+type brailleCanvas struct {
+	width, height int
+	dots          [][]rune
+}
+
+func newBrailleCanvas(width, height int) *brailleCanvas {
+	dots := make([][]rune, height)
+	for i := range dots {
+		dots[i] = make([]rune, width)
+	}
+	return &brailleCanvas{width: width, height: height, dots: dots}
+}
+
+func (c *brailleCanvas) dotsWide() int { return c.width * 2 }
+func (c *brailleCanvas) dotsHigh() int { return c.height * 4 }
+
+// brailleBits maps a dot's position within its cell ([row][column]) to its braille bit.
+var brailleBits = [4][2]rune{{0x01, 0x08}, {0x02, 0x10}, {0x04, 0x20}, {0x40, 0x80}}
+
+// set turns on the dot at (x, y); dots outside the canvas are ignored.
+func (c *brailleCanvas) set(x, y int) {
+	if x < 0 || y < 0 || x >= c.dotsWide() || y >= c.dotsHigh() {
+		return
+	}
+	c.dots[y/4][x/2] |= brailleBits[y%4][x%2]
+}
+
+// cells returns each cell as a styled braille character (blank when no dot is set).
+func (c *brailleCanvas) cells(style lipgloss.Style) [][]string {
+	grid := make([][]string, c.height)
+	for y, row := range c.dots {
+		grid[y] = make([]string, c.width)
+		for x, bits := range row {
+			if bits == 0 {
+				grid[y][x] = " "
+				continue
+			}
+			grid[y][x] = style.Render(string(0x2800 + bits))
+		}
+	}
+	return grid
+}
@@ -0,0 +1,102 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/x/ansi"
+)
+
+// DevStats are the diagnostics shown in the developer overlay (Ctrl+D).
+type DevStats struct {
+	FrameLast  time.Duration // Render time of the previous frame
+	FrameAvg   time.Duration // Average over recent frames
+	FrameMax   time.Duration // Slowest recent frame
+	Frames     int           // Frames averaged
+	Fetches    []DevFetch
+	Goroutines int
+	Caches     []DevCount
+}
+
+// DevFetch is the last provider call shown in the developer overlay.
+type DevFetch struct {
+	Provider string
+	Duration time.Duration
+	Age      time.Duration
+	Err      string
+}
+
+// DevCount is a labelled size (e.g., a cache's entry count).
+type DevCount struct {
+	Label string
+	Value int
+}
+
+// devOverlayStyle frames the developer overlay.
+var devOverlayStyle = lipgloss.NewStyle().
+	Border(lipgloss.RoundedBorder()).
+	BorderForeground(neonCyan).
+	Foreground(neonWhite).
+	Padding(0, 1)
+
+// OverlayDevStats draws the developer overlay over the top-right corner of a rendered view,
+// below the first line (spinner padding / toast).
+func OverlayDevStats(view string, width int, stats DevStats) string {
+	box := strings.Split(devOverlayStyle.Render(renderDevStats(stats)), "\n")
+	boxWidth := lipgloss.Width(box[0])
+	left := width - boxWidth
+	if left < 0 {
+		return view
+	}
+
+	lines := strings.Split(view, "\n")
+	for i, boxLine := range box {
+		row := i + 1
+		for row >= len(lines) {
+			lines = append(lines, "")
+		}
+		line := ansi.Truncate(lines[row], left, "")
+		if pad := left - ansi.StringWidth(line); pad > 0 {
+			line += strings.Repeat(" ", pad)
+		}
+		lines[row] = line + boxLine
+	}
+	return strings.Join(lines, "\n")
+}
+
+// renderDevStats renders the overlay content.
+func renderDevStats(s DevStats) string {
+	dim := neonDimStyle
+	lines := []string{
+		lipgloss.NewStyle().Foreground(neonCyan).Bold(true).Render("Developer"),
+		fmt.Sprintf("frame  %s  avg %s  max %s", devDuration(s.FrameLast), devDuration(s.FrameAvg), devDuration(s.FrameMax)) +
+			dim.Render(fmt.Sprintf(" (%d)", s.Frames)),
+	}
+
+	if len(s.Fetches) == 0 {
+		lines = append(lines, dim.Render("no fetches yet"))
+	}
+	for _, f := range s.Fetches {
+		line := fmt.Sprintf("%-7s %s", f.Provider, devDuration(f.Duration)) + dim.Render(fmt.Sprintf("  %s ago", f.Age.Round(time.Second)))
+		if f.Err != "" {
+			line += " " + neonRedCardStyle.Render(ansi.Truncate(f.Err, 24, "…"))
+		}
+		lines = append(lines, line)
+	}
+
+	lines = append(lines, fmt.Sprintf("goroutines  %d", s.Goroutines))
+	for _, c := range s.Caches {
+		lines = append(lines, fmt.Sprintf("%-11s %d", c.Label, c.Value))
+	}
+	return strings.Join(lines, "\n")
+}
+
+// devDuration formats a duration with millisecond precision (microseconds below 1ms).
+func devDuration(d time.Duration) string {
+	if d < time.Millisecond {
+		return d.Round(time.Microsecond).String()
+	}
+	return d.Round(100 * time.Microsecond).String()
+}
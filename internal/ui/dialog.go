@@ -2,6 +2,7 @@
 package ui
 
 import (
+	"github.com/0xjuanma/golazo/internal/api"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
 )
@@ -18,6 +19,16 @@ type DialogAction any
 // DialogActionClose signals that the dialog should be closed.
 type DialogActionClose struct{}
 
+// DialogActionOpenTeam signals that the team page of the selected team should open.
+type DialogActionOpenTeam struct {
+	Team api.Team
+}
+
+// DialogActionOpenPlayer signals that the page of the selected player should open.
+type DialogActionOpenPlayer struct {
+	Player api.PlayerInfo
+}
+
 // Dialog is a component that can be displayed as an overlay on top of the UI.
 type Dialog interface {
 	// ID returns the unique identifier of the dialog.
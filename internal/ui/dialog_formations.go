@@ -12,6 +12,9 @@ import (
 
 const formationsDialogID = "formations"
 
+// PredictedBadge marks line-ups that are FotMob's prediction rather than the announced ones.
+const PredictedBadge = "predicted"
+
 // FormationsDialog displays the match formations for both teams.
 type FormationsDialog struct {
 	homeTeam      string
@@ -20,7 +23,11 @@ type FormationsDialog struct {
 	awayFormation string
 	homeStarting  []api.PlayerInfo
 	awayStarting  []api.PlayerInfo
-	focusedTeam   int // 0 = home, 1 = away
+	subbedOff     map[string]int // Player name -> minute substituted off
+	predicted     bool           // Line-ups are FotMob's prediction
+	focusedTeam   int            // 0 = home, 1 = away
+	cursor        int            // Selected player of the focused team
+	pitch         bool           // Show both XIs on a pitch instead of lists
 }
 
 // NewFormationsDialog creates a new formations dialog.
@@ -28,6 +35,8 @@ func NewFormationsDialog(
 	homeTeam, awayTeam string,
 	homeFormation, awayFormation string,
 	homeStarting, awayStarting []api.PlayerInfo,
+	subbedOff map[string]int,
+	predicted bool,
 ) *FormationsDialog {
 	return &FormationsDialog{
 		homeTeam:      homeTeam,
@@ -36,6 +45,8 @@ func NewFormationsDialog(
 		awayFormation: awayFormation,
 		homeStarting:  homeStarting,
 		awayStarting:  awayStarting,
+		subbedOff:     subbedOff,
+		predicted:     predicted,
 		focusedTeam:   0,
 	}
 }
@@ -55,19 +66,70 @@ func (d *FormationsDialog) Update(msg tea.Msg) (Dialog, DialogAction) {
 		case "tab", "h", "l", "left", "right":
 			// Toggle between home and away
 			d.focusedTeam = 1 - d.focusedTeam
+			d.cursor = min(d.cursor, max(len(d.focusedPlayers())-1, 0))
+		case "j", "down":
+			if d.cursor < len(d.focusedPlayers())-1 {
+				d.cursor++
+			}
+		case "k", "up":
+			if d.cursor > 0 {
+				d.cursor--
+			}
+		case "enter":
+			if players := d.focusedPlayers(); !d.pitch && d.cursor < len(players) && players[d.cursor].ID != 0 {
+				return d, DialogActionOpenPlayer{Player: players[d.cursor]}
+			}
+		case "p":
+			// Toggle between team lists and the pitch view
+			d.pitch = !d.pitch
 		}
 	}
 	return d, nil
 }
 
+// focusedPlayers returns the starting XI of the focused team.
+func (d *FormationsDialog) focusedPlayers() []api.PlayerInfo {
+	if d.focusedTeam == 1 {
+		return d.awayStarting
+	}
+	return d.homeStarting
+}
+
 // View renders the formations view.
 func (d *FormationsDialog) View(width, height int) string {
 	// Larger dimensions for better readability
 	dialogWidth, dialogHeight := DialogSize(width, height, 97, 36)
+	title := "Formations"
+	if d.predicted {
+		title += " · " + PredictedBadge
+	}
+
+	if d.pitch {
+		content := d.renderPitch(dialogWidth - 6)
+		return RenderDialogFrameWithHelp(title, content, constants.HelpFormationsPitch, dialogWidth, dialogHeight)
+	}
 
 	// Build the content
 	content := d.renderFormations(dialogWidth - 6)
-	return RenderDialogFrameWithHelp("Formations", content, constants.HelpFormationsDialog, dialogWidth, dialogHeight)
+	return RenderDialogFrameWithHelp(title, content, constants.HelpFormationsDialog, dialogWidth, dialogHeight)
+}
+
+// renderPitch renders both starting XIs on a pitch, home at the top and away at the bottom.
+func (d *FormationsDialog) renderPitch(width int) string {
+	label := func(team, formation string, style lipgloss.Style) string {
+		if formation != "" {
+			team += " · " + formation
+		}
+		return style.Width(width).Align(lipgloss.Center).Render(team)
+	}
+	home := PitchTeam{Name: d.homeTeam, Formation: d.homeFormation, Starting: d.homeStarting}
+	away := PitchTeam{Name: d.awayTeam, Formation: d.awayFormation, Starting: d.awayStarting}
+
+	return lipgloss.JoinVertical(lipgloss.Left,
+		label(d.homeTeam, d.homeFormation, dialogTeamStyle),
+		RenderPitch(home, away, d.subbedOff, width),
+		label(d.awayTeam, d.awayFormation, lipgloss.NewStyle().Foreground(neonRed).Bold(true)),
+	)
 }
 
 // renderFormations renders both team formations side by side.
@@ -121,8 +183,8 @@ func (d *FormationsDialog) renderTeamPanel(teamName, formation string, players [
 		noData := dialogDimStyle.Width(width).Align(lipgloss.Center).Render("Lineup not available")
 		lines = append(lines, noData)
 	} else {
-		for _, player := range players {
-			playerLine := d.renderPlayerLine(player, width, focused)
+		for i, player := range players {
+			playerLine := d.renderPlayerLine(player, width, focused, focused && i == d.cursor)
 			lines = append(lines, playerLine)
 		}
 	}
@@ -131,7 +193,8 @@ func (d *FormationsDialog) renderTeamPanel(teamName, formation string, players [
 }
 
 // renderPlayerLine renders a single player line with number, position, and rating.
-func (d *FormationsDialog) renderPlayerLine(player api.PlayerInfo, width int, focused bool) string {
+// The selected player's name is highlighted.
+func (d *FormationsDialog) renderPlayerLine(player api.PlayerInfo, width int, focused, selected bool) string {
 	// Number
 	numStr := ""
 	if player.Number > 0 {
@@ -150,6 +213,9 @@ func (d *FormationsDialog) renderPlayerLine(player api.PlayerInfo, width int, fo
 	// Player name (truncated if needed)
 	nameWidth := width - 14 // Account for number, position, rating badge, spacing
 	name := player.Name
+	if player.Captain {
+		name += " (C)"
+	}
 	if len(name) > nameWidth {
 		name = name[:nameWidth-1] + "…"
 	}
@@ -166,9 +232,12 @@ func (d *FormationsDialog) renderPlayerLine(player api.PlayerInfo, width int, fo
 		posStyle = dialogDimStyle
 		nameStyle = dialogDimStyle
 	}
+	if selected {
+		nameStyle = lipgloss.NewStyle().Background(neonDarkDim).Foreground(neonWhite).Bold(true)
+	}
 
 	// Render rating with badge for high ratings
-	ratingRendered := d.renderRating(player.Rating, focused)
+	ratingRendered := renderRating(player.Rating, focused)
 
 	return lipgloss.JoinHorizontal(lipgloss.Top,
 		numStyle.Render(numStr),
@@ -181,7 +250,7 @@ func (d *FormationsDialog) renderPlayerLine(player api.PlayerInfo, width int, fo
 }
 
 // renderRating renders the player rating with color styling.
-func (d *FormationsDialog) renderRating(rating string, focused bool) string {
+func renderRating(rating string, focused bool) string {
 	if rating == "" {
 		return "    "
 	}
@@ -0,0 +1,175 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/0xjuanma/golazo/internal/api"
+	"github.com/0xjuanma/golazo/internal/constants"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+const playerDialogID = "player"
+
+// PlayerDialog shows a player's page: profile, injury status, season stats and
+// recent match ratings.
+type PlayerDialog struct {
+	player      api.PlayerInfo
+	page        *api.PlayerPage // nil while loading or unavailable
+	unavailable bool
+}
+
+// NewPlayerDialog creates a player dialog that shows a loading state until SetPage.
+func NewPlayerDialog(player api.PlayerInfo) *PlayerDialog {
+	return &PlayerDialog{player: player}
+}
+
+// PlayerID returns the ID of the player shown.
+func (d *PlayerDialog) PlayerID() int {
+	return d.player.ID
+}
+
+// SetPage fills in the fetched page; nil marks it unavailable.
+func (d *PlayerDialog) SetPage(page *api.PlayerPage) {
+	d.page = page
+	d.unavailable = page == nil
+}
+
+// ID returns the dialog identifier.
+func (d *PlayerDialog) ID() string {
+	return playerDialogID
+}
+
+// Update handles input for the player dialog.
+func (d *PlayerDialog) Update(msg tea.Msg) (Dialog, DialogAction) {
+	if msg, ok := msg.(tea.KeyMsg); ok {
+		switch msg.String() {
+		case "esc", "enter", "q":
+			return d, DialogActionClose{}
+		}
+	}
+	return d, nil
+}
+
+// View renders the profile above the season stats and recent matches, side by side.
+func (d *PlayerDialog) View(width, height int) string {
+	dialogWidth, dialogHeight := DialogSize(width, height, 97, 36)
+
+	if d.page == nil {
+		message := constants.LoadingFetching
+		if d.unavailable {
+			message = constants.EmptyNoPlayerPage
+		}
+		content := dialogDimStyle.Width(dialogWidth - 6).Align(lipgloss.Center).Render(message)
+		return RenderDialogFrameWithHelp(d.player.Name, content, constants.HelpPlayerDialog, dialogWidth, dialogHeight)
+	}
+
+	contentWidth := dialogWidth - 6
+	statsWidth := min(contentWidth/3, 30)
+	recentWidth := contentWidth - statsWidth - 3 // Account for separator
+
+	content := lipgloss.JoinVertical(lipgloss.Left,
+		d.renderProfile(contentWidth),
+		dialogSeparatorStyle.Render(strings.Repeat("─", contentWidth)),
+		lipgloss.JoinHorizontal(lipgloss.Top,
+			d.renderStats(statsWidth),
+			dialogSeparatorStyle.Render(" │ "),
+			d.renderRecent(recentWidth),
+		),
+	)
+	title := d.page.Name
+	if title == "" {
+		title = d.player.Name
+	}
+	return RenderDialogFrameWithHelp(title, content, constants.HelpPlayerDialog, dialogWidth, dialogHeight)
+}
+
+// renderProfile renders the team, position, age and country, then the injury status.
+func (d *PlayerDialog) renderProfile(width int) string {
+	var facts []string
+	for _, fact := range []string{d.page.Team, d.page.Position, d.page.Country} {
+		if fact != "" {
+			facts = append(facts, fact)
+		}
+	}
+	if d.page.Age > 0 {
+		facts = append(facts, fmt.Sprintf("%d years", d.page.Age))
+	}
+	profile := dialogTeamStyle.Render(strings.Join(facts, " · "))
+
+	injury := dialogDimStyle.Render("No reported injury")
+	if d.page.Injury != "" {
+		text := "✚ " + d.page.Injury
+		if d.page.Return != "" {
+			text += " · expected back " + d.page.Return
+		}
+		injury = lipgloss.NewStyle().Foreground(neonRed).Bold(true).Render(text)
+	}
+
+	clip := lipgloss.NewStyle().MaxWidth(width)
+	return lipgloss.JoinVertical(lipgloss.Left, clip.Render(profile), clip.Render(injury))
+}
+
+// renderStats renders the season stats of the player's main league.
+func (d *PlayerDialog) renderStats(width int) string {
+	title := "Season"
+	if d.page.Season != "" {
+		title = d.page.Season
+	}
+	lines := []string{dialogHeaderStyle.MaxWidth(width).Render(title)}
+	if len(d.page.Stats) == 0 {
+		return lipgloss.JoinVertical(lipgloss.Left, append(lines, dialogDimStyle.Render("No season stats"))...)
+	}
+
+	valueWidth := 6
+	for _, stat := range d.page.Stats {
+		label := truncateString(stat.Label, width-valueWidth)
+		lines = append(lines, lipgloss.JoinHorizontal(lipgloss.Top,
+			dialogDimStyle.Width(width-valueWidth).Render(label),
+			dialogValueStyle.Width(valueWidth).Align(lipgloss.Right).Render(stat.Value),
+		))
+	}
+	return lipgloss.NewStyle().Width(width).Render(lipgloss.JoinVertical(lipgloss.Left, lines...))
+}
+
+// renderRecent renders the recent matches, newest first, with the player's rating.
+func (d *PlayerDialog) renderRecent(width int) string {
+	lines := []string{dialogHeaderStyle.Render("Recent matches")}
+	if len(d.page.Recent) == 0 {
+		return lipgloss.JoinVertical(lipgloss.Left, append(lines, dialogDimStyle.Render("No recent matches"))...)
+	}
+
+	clip := lipgloss.NewStyle().MaxWidth(width)
+	for _, match := range d.page.Recent {
+		date := "      "
+		if !match.Date.IsZero() {
+			date = match.Date.Local().Format("02 Jan")
+		}
+		venue := "vs"
+		if !match.Home {
+			venue = " @"
+		}
+		var involvement []string
+		if match.Goals > 0 {
+			involvement = append(involvement, fmt.Sprintf("%dG", match.Goals))
+		}
+		if match.Assists > 0 {
+			involvement = append(involvement, fmt.Sprintf("%dA", match.Assists))
+		}
+		if match.Minutes > 0 {
+			involvement = append(involvement, fmt.Sprintf("%d'", match.Minutes))
+		}
+
+		line := renderRating(match.Rating, true) + "  " +
+			dialogDimStyle.Render(date) + " " +
+			dialogValueStyle.Render(fmt.Sprintf("%-5s", match.Score)) + " " +
+			dialogDimStyle.Render(venue) + " " +
+			dialogContentStyle.Render(match.Opponent)
+		if len(involvement) > 0 {
+			line += "  " + dialogDimStyle.Render(strings.Join(involvement, " "))
+		}
+		lines = append(lines, clip.Render(line))
+	}
+	return lipgloss.JoinVertical(lipgloss.Left, lines...)
+}
@@ -0,0 +1,173 @@
+package ui
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/0xjuanma/golazo/internal/api"
+	"github.com/0xjuanma/golazo/internal/constants"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+const ratingsDialogID = "ratings"
+
+// ratedPlayer is a player who played and was rated, with whether they came off the bench.
+type ratedPlayer struct {
+	api.PlayerInfo
+	rating float64
+	sub    bool
+}
+
+// RatingsDialog lists both squads' player ratings, best first, highlighting the
+// player of the match.
+type RatingsDialog struct {
+	homeTeam      string
+	awayTeam      string
+	home          []ratedPlayer
+	away          []ratedPlayer
+	playerOfMatch int // Player ID, 0 when not awarded
+	focusedTeam   int // 0 = home, 1 = away
+}
+
+// NewRatingsDialog creates a new ratings dialog from both teams' starters and substitutes.
+func NewRatingsDialog(
+	homeTeam, awayTeam string,
+	homeStarting, homeSubs, awayStarting, awaySubs []api.PlayerInfo,
+	playerOfMatch int,
+) *RatingsDialog {
+	return &RatingsDialog{
+		homeTeam:      homeTeam,
+		awayTeam:      awayTeam,
+		home:          ratedSquad(homeStarting, homeSubs),
+		away:          ratedSquad(awayStarting, awaySubs),
+		playerOfMatch: playerOfMatch,
+	}
+}
+
+// HasRatings reports whether any player has a rating.
+func (d *RatingsDialog) HasRatings() bool {
+	return len(d.home) > 0 || len(d.away) > 0
+}
+
+// ratedSquad returns the rated players of a squad sorted by rating, best first.
+// Unused substitutes have no rating and are left out.
+func ratedSquad(starting, subs []api.PlayerInfo) []ratedPlayer {
+	var squad []ratedPlayer
+	add := func(players []api.PlayerInfo, sub bool) {
+		for _, p := range players {
+			rating, err := strconv.ParseFloat(p.Rating, 64)
+			if err != nil || rating <= 0 {
+				continue
+			}
+			squad = append(squad, ratedPlayer{PlayerInfo: p, rating: rating, sub: sub})
+		}
+	}
+	add(starting, false)
+	add(subs, true)
+	sort.SliceStable(squad, func(i, j int) bool { return squad[i].rating > squad[j].rating })
+	return squad
+}
+
+// ID returns the dialog identifier.
+func (d *RatingsDialog) ID() string {
+	return ratingsDialogID
+}
+
+// Update handles input for the ratings dialog.
+func (d *RatingsDialog) Update(msg tea.Msg) (Dialog, DialogAction) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		switch msg.String() {
+		case "esc", "t", "q":
+			return d, DialogActionClose{}
+		case "tab", "h", "l", "left", "right":
+			// Toggle between home and away
+			d.focusedTeam = 1 - d.focusedTeam
+		}
+	}
+	return d, nil
+}
+
+// View renders both squads side by side.
+func (d *RatingsDialog) View(width, height int) string {
+	dialogWidth, dialogHeight := DialogSize(width, height, 97, 36)
+
+	contentWidth := dialogWidth - 6
+	halfWidth := (contentWidth - 3) / 2 // Account for separator
+	content := lipgloss.JoinHorizontal(lipgloss.Top,
+		d.renderSquad(d.homeTeam, d.home, halfWidth, d.focusedTeam == 0),
+		dialogSeparatorStyle.Render(" │ "),
+		d.renderSquad(d.awayTeam, d.away, halfWidth, d.focusedTeam == 1),
+	)
+	return RenderDialogFrameWithHelp("Player Ratings", content, constants.HelpRatingsDialog, dialogWidth, dialogHeight)
+}
+
+// renderSquad renders a team's rated players, best first.
+func (d *RatingsDialog) renderSquad(teamName string, squad []ratedPlayer, width int, focused bool) string {
+	headerStyle := dialogDimStyle
+	if focused {
+		headerStyle = dialogTeamStyle
+	}
+	if len(teamName) > width-2 {
+		teamName = teamName[:width-3] + "…"
+	}
+	lines := []string{
+		headerStyle.Width(width).Align(lipgloss.Center).Render(teamName),
+		dialogSeparatorStyle.Render(strings.Repeat("─", width)),
+	}
+
+	if len(squad) == 0 {
+		lines = append(lines, dialogDimStyle.Width(width).Align(lipgloss.Center).Render(constants.EmptyNoRatings))
+		return lipgloss.JoinVertical(lipgloss.Left, lines...)
+	}
+
+	for _, p := range squad {
+		lines = append(lines, d.renderPlayer(p, width, focused))
+	}
+	return lipgloss.JoinVertical(lipgloss.Left, lines...)
+}
+
+// renderPlayer renders a player line: rating, position, name, and markers for the
+// player of the match (★) and substitutes (↑).
+func (d *RatingsDialog) renderPlayer(p ratedPlayer, width int, focused bool) string {
+	posStr := p.Position
+	if len(posStr) > 3 {
+		posStr = posStr[:3]
+	}
+	posStr = fmt.Sprintf("%-3s", posStr)
+
+	marker := "  "
+	motm := p.ID != 0 && p.ID == d.playerOfMatch
+	switch {
+	case motm:
+		marker = lipgloss.NewStyle().Foreground(neonYellow).Bold(true).Render("★ ")
+	case p.sub:
+		marker = dialogDimStyle.Render("↑ ")
+	}
+
+	nameWidth := width - 11 // Rating, position, marker and spacing
+	name := p.Name
+	if len(name) > nameWidth {
+		name = name[:nameWidth-1] + "…"
+	}
+
+	nameStyle := dialogDimStyle
+	switch {
+	case motm && focused:
+		nameStyle = lipgloss.NewStyle().Foreground(neonYellow).Bold(true)
+	case focused:
+		nameStyle = dialogContentStyle
+	}
+
+	return lipgloss.JoinHorizontal(lipgloss.Top,
+		renderRating(p.Rating, focused),
+		" ",
+		dialogDimStyle.Render(posStr),
+		" ",
+		marker,
+		nameStyle.Render(name),
+	)
+}
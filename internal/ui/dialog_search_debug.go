@@ -0,0 +1,127 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/0xjuanma/golazo/internal/constants"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/x/ansi"
+)
+
+const searchDebugDialogID = "search-debug"
+
+// SearchDebugGoal is one goal link search shown in the Reddit search debug dialog.
+type SearchDebugGoal struct {
+	Goal    string // e.g., "23' Saka (Arsenal 1-0 Chelsea)"
+	Age     string // Relative time of the search, e.g. "2m ago"
+	Winner  string // Strategy that produced the link; empty when none matched
+	URL     string
+	Queries []SearchDebugQuery
+}
+
+// SearchDebugQuery is one query sent during a goal link search.
+type SearchDebugQuery struct {
+	Strategy   string
+	Query      string
+	Sort       string
+	Results    int
+	Err        string
+	Candidates []SearchDebugCandidate
+}
+
+// SearchDebugCandidate is a search result with its match score.
+type SearchDebugCandidate struct {
+	Title    string
+	Score    int
+	Rejected string // Empty when accepted
+}
+
+// SearchDebugDialog lists the Reddit queries sent for a match's goals, their result
+// counts, top candidate titles with scores, and which strategy won.
+type SearchDebugDialog struct {
+	match  string
+	goals  []SearchDebugGoal
+	scroll int
+}
+
+// NewSearchDebugDialog creates a search debug dialog for a match.
+func NewSearchDebugDialog(match string, goals []SearchDebugGoal) *SearchDebugDialog {
+	return &SearchDebugDialog{match: match, goals: goals}
+}
+
+// ID returns the dialog identifier.
+func (d *SearchDebugDialog) ID() string {
+	return searchDebugDialogID
+}
+
+// Update handles input for the search debug dialog.
+func (d *SearchDebugDialog) Update(msg tea.Msg) (Dialog, DialogAction) {
+	if msg, ok := msg.(tea.KeyMsg); ok {
+		switch msg.String() {
+		case "esc", "q", "ctrl+g":
+			return d, DialogActionClose{}
+		case "j", "down":
+			d.scroll++ // Clamped when rendering
+		case "k", "up":
+			d.scroll = max(d.scroll-1, 0)
+		}
+	}
+	return d, nil
+}
+
+// View renders the searches, scrolled to the current position.
+func (d *SearchDebugDialog) View(width, height int) string {
+	dialogWidth, dialogHeight := DialogSize(width, height, DefaultDialogMaxWidth, DefaultDialogMaxHeight)
+	contentWidth := dialogWidth - 6
+	visible := max(dialogHeight-9, 1) // Padding, title bar and help
+
+	lines := d.renderLines(contentWidth)
+	d.scroll = min(d.scroll, max(len(lines)-visible, 0))
+	end := min(d.scroll+visible, len(lines))
+
+	return RenderDialogFrameWithHelp(constants.PanelRedditSearch, strings.Join(lines[d.scroll:end], "\n"), constants.HelpSearchDebugDialog, dialogWidth, dialogHeight)
+}
+
+// renderLines renders every search as lines truncated to width.
+func (d *SearchDebugDialog) renderLines(width int) []string {
+	lines := []string{dialogTeamStyle.Render(d.match), ""}
+	if len(d.goals) == 0 {
+		return append(lines, dialogDimStyle.Render("No goal link searches yet (cached links are not searched again)"))
+	}
+
+	fit := func(s string) string { return ansi.Truncate(s, width, "…") }
+	for _, g := range d.goals {
+		result := neonRedCardStyle.Render("no match")
+		if g.Winner != "" {
+			result = dialogHeaderStyle.Render("won: " + g.Winner)
+		}
+		lines = append(lines, fit(dialogValueStyle.Bold(true).Render(g.Goal)+dialogDimStyle.Render(" · "+g.Age+" · ")+result))
+		if g.URL != "" {
+			lines = append(lines, fit(dialogDimStyle.Render("  "+g.URL)))
+		}
+
+		for _, q := range g.Queries {
+			status := fmt.Sprintf("%d results", q.Results)
+			if q.Err != "" {
+				status = neonRedCardStyle.Render("error: " + q.Err)
+			}
+			lines = append(lines, fit(fmt.Sprintf("  %s %s  %s",
+				dialogLabelStyle.Width(22).Render(q.Strategy),
+				dialogContentStyle.Render(fmt.Sprintf("%q (%s)", q.Query, q.Sort)),
+				dialogDimStyle.Render(status))))
+			for _, c := range q.Candidates {
+				score := lipgloss.NewStyle().Foreground(neonCyan).Render(fmt.Sprintf("%4d", c.Score))
+				note := ""
+				if c.Rejected != "" {
+					score = dialogDimStyle.Render(fmt.Sprintf("%4d", c.Score))
+					note = dialogDimStyle.Render("  ✗ " + c.Rejected)
+				}
+				lines = append(lines, fit("    "+score+"  "+c.Title+note))
+			}
+		}
+		lines = append(lines, dialogSeparatorStyle.Render(strings.Repeat("─", width)))
+	}
+	return lines
+}
@@ -0,0 +1,156 @@
+package ui
+
+import (
+	"fmt"
+
+	"github.com/0xjuanma/golazo/internal/api"
+	"github.com/0xjuanma/golazo/internal/constants"
+	"github.com/0xjuanma/golazo/internal/ui/design"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+const shotMapDialogID = "shotmap"
+
+// bigChanceXG is the xG from which a shot is drawn as a big chance (bold marker).
+const bigChanceXG = 0.3
+
+// ShotMapDialog displays each team's shots on the half pitch they attacked.
+type ShotMapDialog struct {
+	homeTeam    string
+	awayTeam    string
+	homeTeamID  int
+	awayTeamID  int
+	shots       []api.Shot
+	focusedTeam int // 0 = home, 1 = away
+}
+
+// NewShotMapDialog creates a new shot map dialog.
+func NewShotMapDialog(homeTeam, awayTeam string, homeTeamID, awayTeamID int, shots []api.Shot) *ShotMapDialog {
+	return &ShotMapDialog{
+		homeTeam:   homeTeam,
+		awayTeam:   awayTeam,
+		homeTeamID: homeTeamID,
+		awayTeamID: awayTeamID,
+		shots:      shots,
+	}
+}
+
+// ID returns the dialog identifier.
+func (d *ShotMapDialog) ID() string {
+	return shotMapDialogID
+}
+
+// Update handles input for the shot map dialog.
+func (d *ShotMapDialog) Update(msg tea.Msg) (Dialog, DialogAction) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		switch msg.String() {
+		case "esc", "a", "q":
+			return d, DialogActionClose{}
+		case "tab", "h", "l", "left", "right":
+			// Toggle between home and away
+			d.focusedTeam = 1 - d.focusedTeam
+		}
+	}
+	return d, nil
+}
+
+// View renders the shot map of the focused team.
+func (d *ShotMapDialog) View(width, height int) string {
+	dialogWidth, dialogHeight := DialogSize(width, height, 97, 36)
+	content := d.renderShotMap(dialogWidth-6, dialogHeight-8)
+	return RenderDialogFrameWithHelp("Shot Map", content, constants.HelpShotMapDialog, dialogWidth, dialogHeight)
+}
+
+// renderShotMap renders the team tabs, the pitch with the focused team's shots,
+// a legend and the team's shot totals.
+func (d *ShotMapDialog) renderShotMap(width, height int) string {
+	teamID := d.homeTeamID
+	if d.focusedTeam == 1 {
+		teamID = d.awayTeamID
+	}
+
+	// Goals are drawn last so they stay visible when shots share a cell
+	var points, goalPoints []design.ShotMapPoint
+	var total, onTarget int
+	for _, shot := range d.shots {
+		if shot.TeamID != teamID {
+			continue
+		}
+		total++
+		point := design.ShotMapPoint{X: shot.X, Y: shot.Y, Marker: shotMarker(shot)}
+		switch shot.Outcome {
+		case "goal":
+			onTarget++
+			goalPoints = append(goalPoints, point)
+			continue
+		case "saved":
+			onTarget++
+		}
+		points = append(points, point)
+	}
+	goals := len(goalPoints)
+	points = append(points, goalPoints...)
+
+	tab := func(name string, focused bool) string {
+		if focused {
+			return dialogTeamStyle.Render("[" + name + "]")
+		}
+		return dialogDimStyle.Render(" " + name + " ")
+	}
+	tabs := lipgloss.NewStyle().Width(width).Align(lipgloss.Center).Render(
+		tab(d.homeTeam, d.focusedTeam == 0) + "   " + tab(d.awayTeam, d.focusedTeam == 1),
+	)
+
+	var body string
+	if total == 0 {
+		body = lipgloss.NewStyle().Width(width).Align(lipgloss.Center).Render(dialogDimStyle.Render(constants.EmptyNoShots))
+	} else {
+		// Keep the pitch roughly to scale: a terminal cell is about twice as tall as wide
+		mapHeight := max(height-4, 5)
+		mapWidth := min(width, mapHeight*2*68/52)
+		pitch := design.RenderShotMap(points, mapWidth, mapHeight, neonDarkDim)
+		body = lipgloss.NewStyle().Width(width).Align(lipgloss.Center).Render(pitch)
+	}
+
+	legend := fmt.Sprintf("%s goal  %s saved  %s missed  %s blocked  %s",
+		shotMarker(api.Shot{Outcome: "goal"}),
+		shotMarker(api.Shot{Outcome: "saved"}),
+		shotMarker(api.Shot{Outcome: "missed"}),
+		shotMarker(api.Shot{Outcome: "blocked"}),
+		dialogDimStyle.Render(fmt.Sprintf("bold: xG ≥ %.1f", bigChanceXG)),
+	)
+	summary := fmt.Sprintf("Shots %d  On target %d  Goals %d  xG %.2f",
+		total, onTarget, goals, api.ShotsXG(d.shots, teamID))
+
+	center := lipgloss.NewStyle().Width(width).Align(lipgloss.Center)
+	return lipgloss.JoinVertical(lipgloss.Left,
+		tabs,
+		"",
+		body,
+		"",
+		center.Render(legend),
+		center.Render(neonValueStyle.Render(summary)),
+	)
+}
+
+// shotMarker returns the marker of a shot by outcome, bold for big chances.
+func shotMarker(shot api.Shot) string {
+	style := lipgloss.NewStyle()
+	marker := "×"
+	switch shot.Outcome {
+	case "goal":
+		marker, style = "●", style.Foreground(neonCyan)
+	case "saved":
+		marker, style = "○", style.Foreground(neonWhite)
+	case "blocked":
+		marker, style = "▪", style.Foreground(neonGray)
+	default:
+		style = style.Foreground(neonRed)
+	}
+	if shot.XG >= bigChanceXG {
+		style = style.Bold(true)
+	}
+	return style.Render(marker)
+}
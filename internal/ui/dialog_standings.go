@@ -18,7 +18,7 @@ type StandingsDialog struct {
 	standings   []api.LeagueTableEntry
 	homeTeamID  int
 	awayTeamID  int
-	scrollIndex int
+	scrollIndex int // Selected row
 }
 
 // NewStandingsDialog creates a new standings dialog.
@@ -44,6 +44,10 @@ func (d *StandingsDialog) Update(msg tea.Msg) (Dialog, DialogAction) {
 		switch msg.String() {
 		case "esc", "s", "q":
 			return d, DialogActionClose{}
+		case "enter":
+			if d.scrollIndex < len(d.standings) {
+				return d, DialogActionOpenTeam{Team: d.standings[d.scrollIndex].Team}
+			}
 		case "j", "down":
 			if d.scrollIndex < len(d.standings)-1 {
 				d.scrollIndex++
@@ -85,14 +89,44 @@ func (d *StandingsDialog) renderTable(width int) string {
 	lines = append(lines, separator)
 
 	// Data rows
-	for _, entry := range d.standings {
-		row := d.renderTeamRow(entry, width)
+	for i, entry := range d.standings {
+		row := d.renderTeamRow(entry, width, i == d.scrollIndex)
 		lines = append(lines, row)
 	}
 
+	if legend := renderZoneLegend(d.standings, width); legend != "" {
+		lines = append(lines, "", legend)
+	}
+
 	return lipgloss.JoinVertical(lipgloss.Left, lines...)
 }
 
+// zoneMarker returns a bar in the color of the entry's qualification/relegation zone,
+// or a space outside zones.
+func zoneMarker(entry api.LeagueTableEntry) string {
+	if entry.ZoneColor == "" {
+		return " "
+	}
+	return lipgloss.NewStyle().Foreground(lipgloss.Color(entry.ZoneColor)).Render("▌")
+}
+
+// renderZoneLegend lists the table's zones in table order, e.g. "▌ Champions League  ▌ Relegation".
+func renderZoneLegend(standings []api.LeagueTableEntry, width int) string {
+	var items []string
+	seen := make(map[string]bool)
+	for _, entry := range standings {
+		if entry.Zone == "" || seen[entry.Zone] {
+			continue
+		}
+		seen[entry.Zone] = true
+		items = append(items, zoneMarker(entry)+" "+dialogDimStyle.Render(entry.Zone))
+	}
+	if len(items) == 0 {
+		return ""
+	}
+	return lipgloss.NewStyle().Width(width).Render(strings.Join(items, "  "))
+}
+
 // Column widths for consistent alignment
 const (
 	standingsColPos  = 4 // Position column
@@ -103,11 +137,11 @@ const (
 
 // renderHeaderRow renders the table header.
 func (d *StandingsDialog) renderHeaderRow(width int) string {
-	teamWidth := width - standingsColPos - (standingsColStat * 4) - standingsColGD - standingsColPts - 4
+	teamWidth := width - standingsColPos - (standingsColStat * 4) - standingsColGD - standingsColPts - 5
 
 	return lipgloss.JoinHorizontal(lipgloss.Top,
 		dialogHeaderStyle.Width(standingsColPos).Align(lipgloss.Right).Render("#"),
-		"  ",
+		"   ",
 		dialogHeaderStyle.Width(teamWidth).Align(lipgloss.Left).Render("Team"),
 		dialogHeaderStyle.Width(standingsColStat).Align(lipgloss.Right).Render("P"),
 		dialogHeaderStyle.Width(standingsColStat).Align(lipgloss.Right).Render("W"),
@@ -118,11 +152,11 @@ func (d *StandingsDialog) renderHeaderRow(width int) string {
 	)
 }
 
-// renderTeamRow renders a single team row.
-func (d *StandingsDialog) renderTeamRow(entry api.LeagueTableEntry, width int) string {
+// renderTeamRow renders a single team row; the selected row is marked for Enter.
+func (d *StandingsDialog) renderTeamRow(entry api.LeagueTableEntry, width int, selected bool) string {
 	isHighlighted := entry.Team.ID == d.homeTeamID || entry.Team.ID == d.awayTeamID
 
-	teamWidth := width - standingsColPos - (standingsColStat * 4) - standingsColGD - standingsColPts - 4
+	teamWidth := width - standingsColPos - (standingsColStat * 4) - standingsColGD - standingsColPts - 5
 
 	// Truncate team name if needed
 	teamName := entry.Team.ShortName
@@ -136,10 +170,9 @@ func (d *StandingsDialog) renderTeamRow(entry api.LeagueTableEntry, width int) s
 	// Format goal difference with sign
 	gdStr := formatGoalDifference(entry.GoalDifference)
 
-	// Build row content with fixed widths
+	// Build row content with fixed widths; the zone marker is rendered separately to keep its color
 	rowContent := lipgloss.JoinHorizontal(lipgloss.Top,
-		lipgloss.NewStyle().Width(standingsColPos).Align(lipgloss.Right).Render(fmt.Sprintf("%d", entry.Position)),
-		"  ",
+		" ",
 		lipgloss.NewStyle().Width(teamWidth).Align(lipgloss.Left).Render(teamName),
 		lipgloss.NewStyle().Width(standingsColStat).Align(lipgloss.Right).Render(fmt.Sprintf("%d", entry.Played)),
 		lipgloss.NewStyle().Width(standingsColStat).Align(lipgloss.Right).Render(fmt.Sprintf("%d", entry.Won)),
@@ -150,17 +183,27 @@ func (d *StandingsDialog) renderTeamRow(entry api.LeagueTableEntry, width int) s
 	)
 
 	// Apply row styling
+	rowStyle := dialogValueStyle
 	if isHighlighted {
 		// Background highlight for match teams
-		return lipgloss.NewStyle().
+		rowStyle = lipgloss.NewStyle().
 			Background(neonDark).
 			Foreground(neonCyan).
-			Bold(true).
-			Width(width).
-			Render(rowContent)
+			Bold(true)
+	}
+	if selected {
+		rowStyle = lipgloss.NewStyle().
+			Background(neonDarkDim).
+			Foreground(neonWhite).
+			Bold(true)
 	}
 
-	return dialogValueStyle.Render(rowContent)
+	return lipgloss.JoinHorizontal(lipgloss.Top,
+		rowStyle.Width(standingsColPos).Align(lipgloss.Right).Render(fmt.Sprintf("%d", entry.Position)),
+		rowStyle.Render(" "),
+		zoneMarker(entry),
+		rowStyle.Width(width-standingsColPos-2).Render(rowContent),
+	)
 }
 
 // formatGoalDifference formats goal difference with +/- sign.
@@ -0,0 +1,137 @@
+package ui
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/0xjuanma/golazo/internal/constants"
+	"github.com/0xjuanma/golazo/internal/ui/design"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// Replay link status of a goal in the goal feed.
+const (
+	ReplayPending  = iota // Not searched yet
+	ReplayFound           // A playable replay link is cached
+	ReplayNotFound        // Searched, nothing found yet
+)
+
+// FeedGoal is a goal of the goal feed, with the score it made.
+type FeedGoal struct {
+	MatchID   int
+	League    string
+	HomeTeam  string
+	AwayTeam  string
+	HomeScore int // Score after the goal
+	AwayScore int
+	ForHome   bool // Whether the home team is credited with the goal
+	Scorer    string
+	OwnGoal   bool
+	Minute    string    // Display minute, e.g. "45+2'"
+	At        time.Time // Approximate time of the goal, orders the feed
+	Replay    int       // ReplayPending, ReplayFound or ReplayNotFound
+	Unseen    bool      // Scored while the feed was closed
+}
+
+// GoalFeedView is the state of the goal feed.
+type GoalFeedView struct {
+	Goals   []FeedGoal // Newest first
+	Matches int        // Live matches the goals are gathered from
+	Loading bool
+	Scroll  int // First goal shown
+}
+
+// RenderGoalFeedView renders the goals of every live match as one stream, newest first
+// (scrolled by Scroll).
+func RenderGoalFeedView(width, height int, view GoalFeedView, bannerType constants.StatusBannerType) string {
+	if width <= 0 {
+		width = 80
+	}
+	if height <= 0 {
+		height = 24
+	}
+
+	lines := []string{}
+	if banner := renderStatusBanner(bannerType, width); banner != "" {
+		lines = append(lines, banner)
+	}
+	lines = append(lines, design.RenderHeader("All Goals", width))
+	help := neonDimStyle.Width(width).Align(lipgloss.Center).Render(constants.HelpGoalFeedView)
+	panelHeight := max(height-len(lines)-3, minPanelHeight)
+
+	if len(view.Goals) == 0 {
+		message := constants.EmptyNoGoals
+		if view.Loading {
+			message = constants.LoadingFetching
+		}
+		lines = append(lines, "", neonDimStyle.Width(width).Height(panelHeight).Align(lipgloss.Center).
+			PaddingTop(panelHeight/3).Render(message))
+		lines = append(lines, "", help)
+		return lipgloss.JoinVertical(lipgloss.Left, lines...)
+	}
+
+	summary := fmt.Sprintf("%d goals in %d live matches", len(view.Goals), view.Matches)
+	if slices.ContainsFunc(view.Goals, func(goal FeedGoal) bool { return goal.Unseen }) {
+		summary += " · " + neonYellowCardStyle.Render("●") + neonDimStyle.Render(" scored while you were away")
+	}
+	if view.Loading {
+		summary += " · " + constants.LoadingFetching
+	}
+	rows := []string{neonDimStyle.Render(summary), ""}
+	clip := lipgloss.NewStyle().MaxWidth(width - 2)
+	scroll := min(max(view.Scroll, 0), len(view.Goals)-1)
+	for _, goal := range view.Goals[scroll:] {
+		if len(rows) >= panelHeight {
+			break
+		}
+		rows = append(rows, clip.Render(renderFeedGoal(goal)))
+	}
+
+	lines = append(lines, "", neonPanelCyanStyle.Width(width).Height(panelHeight).MaxHeight(panelHeight).
+		Render(strings.Join(rows, "\n")))
+	lines = append(lines, "", help)
+	return lipgloss.JoinVertical(lipgloss.Left, lines...)
+}
+
+// renderFeedGoal renders one goal: minute, score with the scoring team highlighted,
+// scorer, replay status and league.
+func renderFeedGoal(goal FeedGoal) string {
+	home := neonValueStyle.Render(goal.HomeTeam)
+	away := neonValueStyle.Render(goal.AwayTeam)
+	if goal.ForHome {
+		home = neonTeamStyle.Render(goal.HomeTeam)
+	} else {
+		away = neonTeamStyle.Render(goal.AwayTeam)
+	}
+	score := neonHeaderStyle.Render(fmt.Sprintf("%d - %d", goal.HomeScore, goal.AwayScore))
+
+	scorer := goal.Scorer
+	if scorer == "" {
+		scorer = "Unknown"
+	}
+	if goal.OwnGoal {
+		scorer += " (OG)"
+	}
+
+	var replay string
+	switch goal.Replay {
+	case ReplayFound:
+		replay = neonTeamStyle.Render("▶ replay")
+	case ReplayNotFound:
+		replay = neonDimStyle.Render("no replay")
+	default:
+		replay = neonDimStyle.Render("replay pending")
+	}
+
+	marker := "  "
+	if goal.Unseen {
+		marker = neonYellowCardStyle.Render("●") + " "
+	}
+	return marker + neonDimStyle.Render(fmt.Sprintf("%6s ", goal.Minute)) +
+		home + " " + score + " " + away +
+		neonDimStyle.Render("  ⚽ ") + neonValueStyle.Render(scorer) +
+		"  " + replay +
+		neonDimStyle.Render("  "+goal.League)
+}
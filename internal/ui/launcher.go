@@ -0,0 +1,59 @@
+package ui
+
+import (
+	"fmt"
+	"os/exec"
+	"strings"
+)
+
+// Launcher opens goal replays and highlights outside the TUI.
+// Uses the configured player command (e.g., "mpv %s") when set,
+// otherwise the system default handler (open/xdg-open/start).
+type Launcher struct {
+	command string
+}
+
+// NewLauncher creates a launcher for the given player command.
+// "%s" in the command is replaced with the URL; without it the URL is appended.
+// An empty command uses the system default handler.
+func NewLauncher(command string) *Launcher {
+	return &Launcher{command: strings.TrimSpace(command)}
+}
+
+// HasPlayer reports whether a player command is configured.
+// When true, callers should prefer direct media URLs over web pages.
+func (l *Launcher) HasPlayer() bool {
+	return l != nil && l.command != ""
+}
+
+// Open launches the URL without waiting for the player to exit.
+func (l *Launcher) Open(url string) error {
+	if !IsValidReplayURL(url) {
+		return fmt.Errorf("invalid media URL: %q", url)
+	}
+	if !l.HasPlayer() {
+		return OpenURL(url)
+	}
+
+	fields := strings.Fields(l.command)
+	args := make([]string, 0, len(fields)+1)
+	substituted := false
+	for _, field := range fields[1:] {
+		if strings.Contains(field, "%s") {
+			field = strings.ReplaceAll(field, "%s", url)
+			substituted = true
+		}
+		args = append(args, field)
+	}
+	if !substituted {
+		args = append(args, url)
+	}
+
+	cmd := exec.Command(fields[0], args...)
+	if err := cmd.Start(); err != nil {
+		return fmt.Errorf("start player: %w", err)
+	}
+	// Reap the process in the background so it doesn't linger as a zombie
+	go func() { _ = cmd.Wait() }()
+	return nil
+}
@@ -18,14 +18,70 @@ var (
 	delegateNeonDim   = neonDimGray
 )
 
+// Density controls how much space each match list item takes.
+type Density int
+
+const (
+	DensityComfortable Density = iota // Title + 2-line description (3 lines, spaced)
+	DensityCompact                    // Title + first description line (2 lines, spaced)
+	DensityDense                      // Title only (1 line, no spacing)
+)
+
+// ParseDensity converts a settings value ("comfortable", "compact", "dense") to a Density.
+// Unknown or empty values fall back to comfortable.
+func ParseDensity(s string) Density {
+	switch strings.ToLower(strings.TrimSpace(s)) {
+	case "compact":
+		return DensityCompact
+	case "dense":
+		return DensityDense
+	default:
+		return DensityComfortable
+	}
+}
+
+// String returns the settings name of the density.
+func (d Density) String() string {
+	switch d {
+	case DensityCompact:
+		return "compact"
+	case DensityDense:
+		return "dense"
+	default:
+		return "comfortable"
+	}
+}
+
+// Next cycles comfortable → compact → dense → comfortable.
+func (d Density) Next() Density {
+	return (d + 1) % 3
+}
+
 // NewMatchListDelegate creates a custom list delegate for match items.
 // Height is set to 3 to accommodate title + 2-line description (with KO time).
 // Uses Neon Gradient styling: red title, cyan description on selection.
 func NewMatchListDelegate() list.DefaultDelegate {
+	return NewMatchListDelegateWithDensity(DensityComfortable)
+}
+
+// NewMatchListDelegateWithDensity creates a match list delegate for the given density.
+// Compact drops the KO time line; dense renders only the title with no spacing between items.
+func NewMatchListDelegateWithDensity(density Density) list.DefaultDelegate {
 	d := list.NewDefaultDelegate()
 
-	// Set height to 3 lines: title (1) + description with KO time (2)
-	d.SetHeight(3)
+	switch density {
+	case DensityCompact:
+		// Title (1) + first description line (1)
+		d.SetHeight(2)
+	case DensityDense:
+		// Title only, packed tightly
+		d.SetHeight(1)
+		d.SetSpacing(0)
+		d.ShowDescription = false
+	default:
+		// Set height to 3 lines: title (1) + description with KO time (2)
+		d.SetHeight(3)
+	}
 
 	// Use consolidated neon colors from neon_styles.go
 
@@ -1,6 +1,8 @@
 package ui
 
 import (
+	"strings"
+
 	"github.com/0xjuanma/golazo/internal/api"
 	"github.com/0xjuanma/golazo/internal/data"
 	"github.com/charmbracelet/bubbles/list"
@@ -28,9 +30,14 @@ func (l LeagueListItem) Description() string {
 	return l.League.Country
 }
 
-// FilterValue returns the value used for filtering (league name + country).
+// FilterValue returns the value used for filtering (league name + country), followed
+// by catalogue aliases so "/ucl" finds the UEFA Champions League.
 func (l LeagueListItem) FilterValue() string {
-	return l.League.Name + " " + l.League.Country
+	value := l.League.Name + " " + l.League.Country
+	if league, ok := data.CurrentCatalogue().League(l.League.ID); ok && len(league.Aliases) > 0 {
+		value += " " + strings.Join(league.Aliases, " ")
+	}
+	return value
 }
 
 // Title returns the match title for the list item.
@@ -44,9 +51,17 @@ func (m MatchListItem) Description() string {
 }
 
 // FilterValue returns the value to use for filtering.
-// Returns team names for searching (e.g., "Arsenal vs Chelsea").
+// Returns team names for searching (e.g., "Arsenal vs Chelsea"), followed by catalogue
+// aliases so "/spurs" finds Tottenham Hotspur.
 func (m MatchListItem) FilterValue() string {
-	return m.Title()
+	value := m.Title()
+	catalogue := data.CurrentCatalogue()
+	for _, team := range []api.Team{m.Match.HomeTeam, m.Match.AwayTeam} {
+		if entry, ok := catalogue.Team(team); ok && len(entry.Aliases) > 0 {
+			value += " " + strings.Join(entry.Aliases, " ")
+		}
+	}
+	return value
 }
 
 // ToMatchListItems converts a slice of MatchDisplay to list items.
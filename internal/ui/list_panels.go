@@ -189,7 +189,8 @@ func renderDateRangeSelector(width int, selected int) string {
 }
 
 // RenderMultiPanelViewWithList renders the live matches view with list component.
-func RenderMultiPanelViewWithList(width, height int, listModel list.Model, details *api.MatchDetails, liveUpdates []string, sp spinner.Model, loading bool, randomSpinner *RandomCharSpinner, viewLoading bool, leaguesLoaded int, totalLeagues int, pollingSpinner *RandomCharSpinner, isPolling bool, upcomingMatches []MatchDisplay, goalLinks GoalLinksMap, bannerType constants.StatusBannerType) string {
+// With standings set (split layout), the live league table replaces the details and comments panels.
+func RenderMultiPanelViewWithList(width, height int, listModel list.Model, details *api.MatchDetails, liveUpdates []string, sp spinner.Model, loading bool, randomSpinner *RandomCharSpinner, viewLoading bool, leaguesLoaded int, totalLeagues int, pollingSpinner *RandomCharSpinner, isPolling bool, upcomingMatches []MatchDisplay, goalLinks GoalLinksMap, goalLinksStatus string, feedStatus string, commentary *Commentary, comments []ThreadComment, standings *LiveStandings, bannerType constants.StatusBannerType) string {
 	if width <= 0 {
 		width = 80
 	}
@@ -222,8 +223,18 @@ func RenderMultiPanelViewWithList(width, height int, listModel list.Model, detai
 		spinnerArea = spinnerStyle.Render("")
 	}
 
-	leftWidth := max(width*35/100, 25)
+	// Match Thread comments get a third panel on wide terminals
+	showComments := standings == nil && len(comments) > 0 && width >= minCommentsPanelWidth
+	commentsWidth := 0
+	if showComments {
+		commentsWidth = width * 28 / 100
+	}
+
+	leftWidth := LiveListPanelWidth(width, showComments)
 	rightWidth := width - leftWidth - 1
+	if showComments {
+		rightWidth -= commentsWidth + 1
+	}
 	if rightWidth < 35 {
 		rightWidth = 35
 		leftWidth = width - rightWidth - 1
@@ -232,19 +243,28 @@ func RenderMultiPanelViewWithList(width, height int, listModel list.Model, detai
 	panelHeight := availableHeight - 2
 
 	leftPanel := RenderLiveMatchesListPanel(leftWidth, panelHeight, listModel, upcomingMatches)
-	rightPanel := renderMatchDetailsPanelWithPolling(rightWidth, panelHeight, details, liveUpdates, sp, loading, pollingSpinner, isPolling, goalLinks)
+	var rightPanel string
+	if standings != nil {
+		rightPanel = renderStandingsPanel(rightWidth, panelHeight, standings)
+	} else {
+		rightPanel = renderMatchDetailsPanelWithPolling(rightWidth, panelHeight, details, liveUpdates, sp, loading, pollingSpinner, isPolling, goalLinks, goalLinksStatus, feedStatus, commentary)
+	}
 
 	separatorStyle := neonSeparatorStyle.Height(panelHeight)
 	separator := separatorStyle.Render("┃")
 
 	panels := lipgloss.JoinHorizontal(lipgloss.Top, leftPanel, separator, rightPanel)
+	if showComments {
+		commentsPanel := renderCommentsPanel(commentsWidth, panelHeight, comments)
+		panels = lipgloss.JoinHorizontal(lipgloss.Top, panels, separator, commentsPanel)
+	}
 	statusBanner := renderStatusBanner(bannerType, width)
 
 	return lipgloss.JoinVertical(lipgloss.Left, spinnerArea, statusBanner, panels)
 }
 
 // RenderStatsViewWithList renders the stats view with list component.
-func RenderStatsViewWithList(width, height int, finishedList list.Model, details *api.MatchDetails, randomSpinner *RandomCharSpinner, viewLoading bool, dateRange int, daysLoaded int, totalDays int, goalLinks GoalLinksMap, bannerType constants.StatusBannerType, detailsViewport *viewport.Model, rightPanelFocused bool, scrollOffset int) string {
+func RenderStatsViewWithList(width, height int, finishedList list.Model, details *api.MatchDetails, randomSpinner *RandomCharSpinner, viewLoading bool, dateRange int, daysLoaded int, totalDays int, goalLinks GoalLinksMap, goalLinksStatus string, bannerType constants.StatusBannerType, detailsViewport *viewport.Model, rightPanelFocused bool, scrollOffset int) string {
 	if width <= 0 {
 		width = 80
 	}
@@ -287,7 +307,7 @@ func RenderStatsViewWithList(width, height int, finishedList list.Model, details
 	panelHeight := availableHeight - 2
 
 	leftPanel := RenderStatsListPanel(leftWidth, panelHeight, finishedList, dateRange, rightPanelFocused)
-	headerContent, scrollableContent := renderStatsMatchDetailsPanel(rightWidth, panelHeight, details, goalLinks, rightPanelFocused)
+	headerContent, scrollableContent := renderStatsMatchDetailsPanel(rightWidth, panelHeight, details, goalLinks, goalLinksStatus, rightPanelFocused)
 
 	var rightPanel string
 	scrollableLines := strings.Split(scrollableContent, "\n")
@@ -356,7 +376,7 @@ func RenderStatsViewWithList(width, height int, finishedList list.Model, details
 }
 
 // renderStatsMatchDetailsPanel renders match details using unified rendering.
-func renderStatsMatchDetailsPanel(width, height int, details *api.MatchDetails, goalLinks GoalLinksMap, focused bool) (string, string) {
+func renderStatsMatchDetailsPanel(width, height int, details *api.MatchDetails, goalLinks GoalLinksMap, goalLinksStatus string, focused bool) (string, string) {
 	if details == nil {
 		emptyMessage := neonDimStyle.
 			Align(lipgloss.Center).
@@ -374,13 +394,14 @@ func renderStatsMatchDetailsPanel(width, height int, details *api.MatchDetails,
 	}
 
 	cfg := MatchDetailsConfig{
-		Width:          width,
-		Height:         height,
-		Details:        details,
-		GoalLinks:      goalLinks,
-		ShowStatistics: true,
-		ShowHighlights: true,
-		Focused:        focused,
+		Width:           width,
+		Height:          height,
+		Details:         details,
+		GoalLinks:       goalLinks,
+		GoalLinksStatus: goalLinksStatus,
+		ShowStatistics:  true,
+		ShowHighlights:  true,
+		Focused:         focused,
 	}
 
 	return RenderMatchDetails(cfg)
@@ -388,7 +409,7 @@ func renderStatsMatchDetailsPanel(width, height int, details *api.MatchDetails,
 
 // RenderMatchDetailsPanel is an exported version for debug scripts.
 func RenderMatchDetailsPanel(width, height int, details *api.MatchDetails) string {
-	header, scrollable := renderStatsMatchDetailsPanel(width, height, details, nil, false)
+	header, scrollable := renderStatsMatchDetailsPanel(width, height, details, nil, "", false)
 	content := lipgloss.JoinVertical(lipgloss.Left, header, scrollable)
 	return neonPanelCyanStyle.
 		Width(width).
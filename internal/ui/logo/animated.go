@@ -561,6 +561,11 @@ func (a *AnimatedLogo) IsComplete() bool {
 	return a.complete
 }
 
+// Finish skips to the end of the animation, showing the full logo.
+func (a *AnimatedLogo) Finish() {
+	a.complete = true
+}
+
 // Reset resets the animation state for potential replay.
 func (a *AnimatedLogo) Reset() {
 	a.currentTick = 0
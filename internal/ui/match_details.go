@@ -2,11 +2,13 @@ package ui
 
 import (
 	"fmt"
+	"math"
 	"strconv"
 	"strings"
 
 	"github.com/0xjuanma/golazo/internal/api"
 	"github.com/0xjuanma/golazo/internal/constants"
+	"github.com/0xjuanma/golazo/internal/data"
 	"github.com/0xjuanma/golazo/internal/ui/design"
 	"github.com/charmbracelet/bubbles/progress"
 	"github.com/charmbracelet/lipgloss"
@@ -18,12 +20,19 @@ type MatchDetailsConfig struct {
 	Details       *api.MatchDetails
 	GoalLinks     GoalLinksMap
 
+	// GoalLinksStatus is a short progress note shown while goal links are being
+	// fetched (e.g., "3/8 goal links found"). Empty when idle.
+	GoalLinksStatus string
+	// FeedStatus describes a paused live updates feed (e.g., "⏸ 3/12 · 2 new").
+	FeedStatus string
+
 	// View-specific features
 	ShowStatistics bool // Stats view only
 	ShowHighlights bool // Stats view only
 
 	// Live view state
 	LiveUpdates    []string
+	Commentary     *Commentary // Replaces the updates (or events) section when the tab is open
 	PollingSpinner *RandomCharSpinner
 	IsPolling      bool
 	Loading        bool
@@ -73,6 +82,9 @@ func RenderMatchDetails(cfg MatchDetailsConfig) (headerContent, scrollableConten
 	// Large score
 	if details.HomeScore != nil && details.AwayScore != nil {
 		headerLines = append(headerLines, renderLargeScore(*details.HomeScore, *details.AwayScore, contentWidth))
+		if scorers := renderScorers(details, contentWidth); scorers != "" {
+			headerLines = append(headerLines, scorers)
+		}
 	} else {
 		vsText := lipgloss.NewStyle().
 			Foreground(neonDim).
@@ -86,13 +98,25 @@ func RenderMatchDetails(cfg MatchDetailsConfig) (headerContent, scrollableConten
 	// Match context (detailed info)
 	headerLines = append(headerLines, renderMatchContext(details, contentWidth)...)
 
-	// Penalties (prominent section)
-	if details.Penalties != nil && details.Penalties.Home != nil && details.Penalties.Away != nil {
-		headerLines = append(headerLines, renderPenaltiesSection(details, contentWidth)...)
+	// Group-stage table with qualification implications of the current score
+	if details.Group != nil && len(details.Group.Entries) > 0 {
+		headerLines = append(headerLines, renderGroupSection(details, contentWidth)...)
 	}
 
-	// For live matches, show live updates instead of event details
-	if details.Status == api.MatchStatusLive || details.Status == api.MatchStatusNotStarted {
+	// What each result settles for qualification
+	if len(details.Scenarios) > 0 {
+		headerLines = append(headerLines, renderScenariosSection(details, contentWidth)...)
+	}
+
+	// Penalty shootout (prominent section)
+	if details.Shootout != nil {
+		headerLines = append(headerLines, renderShootoutSection(details, contentWidth)...)
+	}
+
+	// Text commentary tab, or live updates instead of event details for live matches
+	if cfg.Commentary != nil {
+		scrollableLines = append(scrollableLines, renderCommentarySection(cfg, contentWidth))
+	} else if details.Status == api.MatchStatusLive || details.Status == api.MatchStatusNotStarted {
 		liveSection := renderLiveUpdatesSection(cfg, contentWidth)
 		scrollableLines = append(scrollableLines, liveSection)
 	} else {
@@ -122,6 +146,16 @@ func RenderMatchDetails(cfg MatchDetailsConfig) (headerContent, scrollableConten
 			statsSection := renderStatisticsSection(cfg, contentWidth, homeTeam, awayTeam)
 			scrollableLines = append(scrollableLines, statsSection)
 		}
+
+		// Momentum section (stats view only)
+		if cfg.ShowStatistics && len(details.Momentum) > 0 {
+			scrollableLines = append(scrollableLines, renderMomentumSection(details, contentWidth, homeTeam, awayTeam))
+		}
+	}
+
+	// Previous meetings
+	if len(details.HeadToHead) > 0 {
+		scrollableLines = append(scrollableLines, renderHeadToHeadSection(details, contentWidth))
 	}
 
 	return lipgloss.JoinVertical(lipgloss.Left, headerLines...),
@@ -141,7 +175,9 @@ func renderStatusLine(details *api.MatchDetails, contentWidth int) string {
 	switch details.Status {
 	case api.MatchStatusLive:
 		liveTime := constants.StatusLive
-		if details.LiveTime != nil {
+		if details.Clock != nil {
+			liveTime = details.Clock.Detail()
+		} else if details.LiveTime != nil {
 			liveTime = *details.LiveTime
 		}
 		statusText = lipgloss.NewStyle().Foreground(neonRed).Bold(true).Render(liveTime)
@@ -164,17 +200,18 @@ func renderMatchContext(details *api.MatchDetails, contentWidth int) []string {
 	if details.League.Name != "" {
 		lines = append(lines, neonLabelStyle.Render("League:      ")+neonValueStyle.Render(details.League.Name))
 	}
-	if details.Venue != "" {
-		lines = append(lines, neonLabelStyle.Render("Venue:       ")+neonValueStyle.Render(truncateString(details.Venue, contentWidth-14)))
-	}
 	if details.MatchTime != nil {
 		lines = append(lines, neonLabelStyle.Render("Date:        ")+neonValueStyle.Render(details.MatchTime.Format("02 Jan 2006, 15:04")+" UTC"))
 	}
-	if details.Referee != "" {
-		lines = append(lines, neonLabelStyle.Render("Referee:     ")+neonValueStyle.Render(details.Referee))
+	if info := matchInfo(details); info != "" {
+		lines = append(lines, neonLabelStyle.Render("Venue:       ")+neonValueStyle.Render(truncateString(info, contentWidth-14)))
 	}
-	if details.Attendance > 0 {
-		lines = append(lines, neonLabelStyle.Render("Attendance:  ")+neonValueStyle.Render(formatNumber(details.Attendance)))
+	if lineups := lineupStatus(details); lineups != "" {
+		lines = append(lines, neonLabelStyle.Render("Line-ups:    ")+neonValueStyle.Render(lineups))
+	}
+	if len(details.Broadcasts) > 0 {
+		stations := strings.Join(details.Broadcasts, " / ")
+		lines = append(lines, neonLabelStyle.Render("Watch on:    ")+neonValueStyle.Render(truncateString(stations, contentWidth-14)))
 	}
 
 	// Half-time score
@@ -183,6 +220,12 @@ func renderMatchContext(details *api.MatchDetails, contentWidth int) []string {
 		lines = append(lines, neonLabelStyle.Render("Half-time:   ")+neonValueStyle.Render(htText))
 	}
 
+	// Expected goals (cumulative while live)
+	if details.HomeXG != nil && details.AwayXG != nil {
+		xgText := fmt.Sprintf("%.2f - %.2f", *details.HomeXG, *details.AwayXG)
+		lines = append(lines, neonLabelStyle.Render("xG:          ")+neonValueStyle.Render(xgText))
+	}
+
 	// Extra time
 	if details.ExtraTime {
 		lines = append(lines, neonLabelStyle.Render("Duration:    ")+neonValueStyle.Render("After Extra Time"))
@@ -191,7 +234,119 @@ func renderMatchContext(details *api.MatchDetails, contentWidth int) []string {
 	return lines
 }
 
-func renderPenaltiesSection(details *api.MatchDetails, contentWidth int) []string {
+// lineupStatus returns whether an upcoming match's line-ups are predicted or confirmed,
+// or "" when there are none yet or the match has started.
+func lineupStatus(details *api.MatchDetails) string {
+	switch {
+	case details.Status != api.MatchStatusNotStarted || (details.HomeLineup == nil && details.AwayLineup == nil):
+		return ""
+	case details.LineupsPredicted:
+		return "[" + PredictedBadge + "] confirmed about an hour before kick-off"
+	default:
+		return "✓ confirmed"
+	}
+}
+
+// matchInfo returns the venue, referee and attendance in one row, e.g.
+// "Emirates Stadium, London · Ref Michael Oliver · 👥 60,383".
+func matchInfo(details *api.MatchDetails) string {
+	var parts []string
+	if venue := details.VenueText(); venue != "" {
+		parts = append(parts, venue)
+	}
+	if details.Referee != "" {
+		parts = append(parts, "Ref "+details.Referee)
+	}
+	if details.Attendance > 0 {
+		parts = append(parts, "👥 "+formatNumber(details.Attendance))
+	}
+	return strings.Join(parts, " · ")
+}
+
+// renderGroupSection renders the match's group mini-table, highlighting both teams.
+func renderGroupSection(details *api.MatchDetails, contentWidth int) []string {
+	group := details.Group
+	teamWidth := min(contentWidth, 48) - standingsColPos - standingsColStat - standingsColGD - standingsColPts - 3
+	title := group.Name
+	if title == "" {
+		title = "Group"
+	}
+
+	lines := []string{"", neonHeaderStyle.Render(title)}
+	for _, entry := range group.Entries {
+		style := neonDimStyle
+		if entry.Team.ID == details.HomeTeam.ID || entry.Team.ID == details.AwayTeam.ID {
+			style = neonTeamStyle
+		}
+		name := entry.Team.ShortName
+		if name == "" {
+			name = entry.Team.Name
+		}
+		lines = append(lines, lipgloss.JoinHorizontal(lipgloss.Top,
+			style.Width(standingsColPos).Align(lipgloss.Right).Render(strconv.Itoa(entry.Position)),
+			" ",
+			zoneMarker(entry),
+			" ",
+			style.Width(teamWidth).Render(truncateString(name, teamWidth-1)),
+			style.Width(standingsColStat).Align(lipgloss.Right).Render(strconv.Itoa(entry.Played)),
+			style.Width(standingsColGD).Align(lipgloss.Right).Render(formatGoalDifference(entry.GoalDifference)),
+			style.Width(standingsColPts).Align(lipgloss.Right).Render(strconv.Itoa(entry.Points)),
+		))
+	}
+	return lines
+}
+
+// renderScenariosSection renders what each result settles for both teams, merging
+// results with the same effect (e.g. "Arsenal win or a draw: Arsenal secure...").
+func renderScenariosSection(details *api.MatchDetails, contentWidth int) []string {
+	teamName := func(id int) string {
+		team := details.HomeTeam
+		if id == details.AwayTeam.ID {
+			team = details.AwayTeam
+		}
+		if team.ShortName != "" {
+			return team.ShortName
+		}
+		return team.Name
+	}
+	outcomeLabel := map[api.MatchOutcome]string{
+		api.OutcomeHomeWin: teamName(details.HomeTeam.ID) + " win",
+		api.OutcomeDraw:    "a draw",
+		api.OutcomeAwayWin: teamName(details.AwayTeam.ID) + " win",
+	}
+
+	type effect struct {
+		teamID int
+		zone   string
+		out    bool
+	}
+	var effects []effect
+	outcomes := make(map[effect][]string)
+	for _, s := range details.Scenarios {
+		e := effect{teamID: s.TeamID, zone: s.Zone, out: s.Out}
+		if _, seen := outcomes[e]; !seen {
+			effects = append(effects, e)
+		}
+		outcomes[e] = append(outcomes[e], outcomeLabel[s.Outcome])
+	}
+
+	lines := []string{"", neonHeaderStyle.Render("Qualification")}
+	for _, e := range effects {
+		when := strings.Join(outcomes[e], " or ")
+		when = strings.ToUpper(when[:1]) + when[1:]
+		result := neonTeamStyle.Render(teamName(e.teamID)) + neonValueStyle.Render(" secure a "+e.zone+" place")
+		if e.out {
+			result = neonTeamStyle.Render(teamName(e.teamID)) + neonDimStyle.Render(" can no longer qualify")
+		}
+		lines = append(lines, lipgloss.NewStyle().MaxWidth(contentWidth).Render(neonDimStyle.Render(when+": ")+result))
+	}
+	return lines
+}
+
+// renderShootoutSection renders the penalty shootout score and, when the kicks are
+// known, a row of kicks per team (● scored, ✗ missed) with the players who missed.
+func renderShootoutSection(details *api.MatchDetails, contentWidth int) []string {
+	shootout := details.Shootout
 	var lines []string
 	lines = append(lines, "")
 
@@ -203,7 +358,7 @@ func renderPenaltiesSection(details *api.MatchDetails, contentWidth int) []strin
 		Render("PENALTIES")
 	lines = append(lines, penaltyHeader)
 
-	penaltyScoreText := fmt.Sprintf("%d - %d", *details.Penalties.Home, *details.Penalties.Away)
+	penaltyScoreText := fmt.Sprintf("%d - %d", shootout.HomeScore, shootout.AwayScore)
 	penaltyScore := lipgloss.NewStyle().
 		Foreground(neonCyan).
 		Bold(true).
@@ -211,6 +366,41 @@ func renderPenaltiesSection(details *api.MatchDetails, contentWidth int) []strin
 		Align(lipgloss.Center).
 		Render(penaltyScoreText)
 	lines = append(lines, penaltyScore)
+
+	if len(shootout.Kicks) > 0 {
+		home, away := details.HomeTeam.ShortName, details.AwayTeam.ShortName
+		if home == "" {
+			home = details.HomeTeam.Name
+		}
+		if away == "" {
+			away = details.AwayTeam.Name
+		}
+		nameWidth := max(lipgloss.Width(home), lipgloss.Width(away))
+		for _, team := range []struct {
+			name string
+			home bool
+		}{{home, true}, {away, false}} {
+			var marks, missed []string
+			for _, kick := range shootout.Kicks {
+				if kick.Home != team.home {
+					continue
+				}
+				if kick.Scored {
+					marks = append(marks, neonValueStyle.Render("●"))
+					continue
+				}
+				marks = append(marks, neonRedCardStyle.Render("✗"))
+				if kick.Player != "" {
+					missed = append(missed, kick.Player)
+				}
+			}
+			line := neonTeamStyle.Width(nameWidth+2).Render(team.name) + strings.Join(marks, " ")
+			if len(missed) > 0 {
+				line += neonDimStyle.Render("  missed: " + strings.Join(missed, ", "))
+			}
+			lines = append(lines, lipgloss.NewStyle().MaxWidth(contentWidth).Render(line))
+		}
+	}
 	lines = append(lines, "")
 
 	return lines
@@ -231,9 +421,18 @@ func renderGoalsSection(cfg MatchDetailsConfig, contentWidth int) string {
 
 	var lines []string
 	lines = append(lines, "")
-	lines = append(lines, neonHeaderStyle.Render("Goals"))
+	goalsHeader := neonHeaderStyle.Render("Goals")
+	if cfg.GoalLinksStatus != "" {
+		goalsHeader += "  " + neonDimStyle.Render(cfg.GoalLinksStatus)
+	}
+	lines = append(lines, goalsHeader)
 
+	boundaries := 0
 	for _, goal := range goals {
+		var markers []string
+		markers, boundaries = timelineMarkers(details, boundaries, goal.Minute, true, contentWidth)
+		lines = append(lines, markers...)
+
 		player := "Unknown"
 		if goal.Player != nil {
 			player = *goal.Player
@@ -257,6 +456,122 @@ func renderGoalsSection(cfg MatchDetailsConfig, contentWidth int) string {
 		}
 		lines = append(lines, renderCenterAlignedEvent(minuteStr, goalContent, isHome, contentWidth))
 	}
+	// Close the timeline at full time
+	for boundary := boundaries; boundary < boundariesPassed(details); boundary++ {
+		lines = append(lines, renderTimelineMarker(boundary, details, true, contentWidth))
+	}
+
+	return lipgloss.JoinVertical(lipgloss.Left, lines...)
+}
+
+// renderScorers renders the scorers line under the score, home then away, e.g.
+// "Saka 23', Havertz 67' — Salah 80'". Returns "" before the first goal.
+func renderScorers(details *api.MatchDetails, contentWidth int) string {
+	home, away := teamScorers(details, true), teamScorers(details, false)
+	if home == "" && away == "" {
+		return ""
+	}
+	text := strings.TrimSpace(home + " — " + away)
+	return lipgloss.NewStyle().Foreground(neonDim).Width(contentWidth).Align(lipgloss.Center).
+		Render(truncateString(text, contentWidth))
+}
+
+// teamScorers lists a team's scorers in the order they first scored, with each of
+// their goals: "Havertz 12', 67', Saka 23'". Own goals are marked "(OG)".
+func teamScorers(details *api.MatchDetails, home bool) string {
+	var order []string
+	minutes := make(map[string][]string)
+	for _, event := range details.Events {
+		if event.Type != "goal" || (event.Team.ID == details.HomeTeam.ID) != home {
+			continue
+		}
+		name := "Unknown"
+		if event.Player != nil && *event.Player != "" {
+			name = scorerName(*event.Player)
+		}
+		if event.OwnGoal != nil && *event.OwnGoal {
+			name += " (OG)"
+		}
+		minute := event.DisplayMinute
+		if minute == "" {
+			minute = fmt.Sprintf("%d'", event.Minute)
+		}
+		if _, ok := minutes[name]; !ok {
+			order = append(order, name)
+		}
+		minutes[name] = append(minutes[name], minute)
+	}
+	parts := make([]string, len(order))
+	for i, name := range order {
+		parts[i] = name + " " + strings.Join(minutes[name], ", ")
+	}
+	return strings.Join(parts, ", ")
+}
+
+// scorerName shortens a player's name to the surname ("Bukayo Saka" -> "Saka"), keeping
+// suffixes with it ("Vinicius Jr").
+func scorerName(player string) string {
+	words := strings.Fields(player)
+	if len(words) < 2 {
+		return player
+	}
+	last := words[len(words)-1]
+	switch strings.TrimSuffix(strings.ToLower(last), ".") {
+	case "jr", "junior", "sr", "ii", "iii":
+		return words[len(words)-2] + " " + last
+	}
+	return last
+}
+
+// renderHeadToHeadSection renders previous meetings with a W/D/L summary for each side.
+func renderHeadToHeadSection(details *api.MatchDetails, contentWidth int) string {
+	var wins, draws, losses int // From the home team's point of view
+	for _, meeting := range details.HeadToHead {
+		scored, conceded := meeting.HomeScore, meeting.AwayScore
+		if meeting.HomeTeam.ID != details.HomeTeam.ID {
+			scored, conceded = conceded, scored
+		}
+		switch {
+		case scored > conceded:
+			wins++
+		case scored == conceded:
+			draws++
+		default:
+			losses++
+		}
+	}
+
+	homeTeam := details.HomeTeam.ShortName
+	if homeTeam == "" {
+		homeTeam = details.HomeTeam.Name
+	}
+	awayTeam := details.AwayTeam.ShortName
+	if awayTeam == "" {
+		awayTeam = details.AwayTeam.Name
+	}
+
+	lines := []string{
+		"",
+		neonHeaderStyle.Render("Head-to-Head") + "  " + neonDimStyle.Render(fmt.Sprintf("last %d", len(details.HeadToHead))),
+		neonTeamStyle.Render(homeTeam) + " " + neonValueStyle.Render(fmt.Sprintf("%dW %dD %dL", wins, draws, losses)) +
+			neonDimStyle.Render("  ·  ") +
+			neonTeamStyle.Render(awayTeam) + " " + neonValueStyle.Render(fmt.Sprintf("%dW %dD %dL", losses, draws, wins)),
+	}
+
+	for _, meeting := range details.HeadToHead {
+		date := "           "
+		if !meeting.Date.IsZero() {
+			date = meeting.Date.Format("02 Jan 2006")
+		}
+		line := neonDimStyle.Render(date) + "  " +
+			neonValueStyle.Render(meeting.HomeTeam.Name) + " " +
+			neonHeaderStyle.Render(fmt.Sprintf("%d - %d", meeting.HomeScore, meeting.AwayScore)) + " " +
+			neonValueStyle.Render(meeting.AwayTeam.Name)
+		if meeting.League != "" {
+			line += "  " + neonDimStyle.Render(meeting.League)
+		}
+		lines = append(lines, lipgloss.NewStyle().MaxWidth(contentWidth).Render(line))
+	}
 
 	return lipgloss.JoinVertical(lipgloss.Left, lines...)
 }
@@ -278,7 +593,12 @@ func renderCardsSection(cfg MatchDetailsConfig, contentWidth int) string {
 	lines = append(lines, "")
 	lines = append(lines, neonHeaderStyle.Render("Cards"))
 
+	boundaries := 0
 	for _, card := range cardEvents {
+		var markers []string
+		markers, boundaries = timelineMarkers(details, boundaries, card.Minute, false, contentWidth)
+		lines = append(lines, markers...)
+
 		player := "Unknown"
 		if card.Player != nil {
 			player = *card.Player
@@ -317,6 +637,7 @@ func renderStatisticsSection(cfg MatchDetailsConfig, contentWidth int, homeTeam,
 		isProgress bool
 	}{
 		{[]string{"possession", "ball possession", "ballpossesion"}, "Possession", true},
+		{[]string{"expected_goals"}, "Expected Goals (xG)", false},
 		{[]string{"total_shots", "total shots"}, "Total Shots", false},
 		{[]string{"shots_on_target", "on target", "shotsontarget"}, "Shots on Target", false},
 		{[]string{"accurate_passes", "accurate passes"}, "Accurate Passes", false},
@@ -355,6 +676,130 @@ func renderStatisticsSection(cfg MatchDetailsConfig, contentWidth int, homeTeam,
 	return lipgloss.JoinVertical(lipgloss.Left, lines...)
 }
 
+// momentumColors returns the teams' catalogue colors for the momentum chart, or cyan
+// and red when a team isn't in the catalogue or both colors look alike.
+func momentumColors(details *api.MatchDetails) (home, away lipgloss.TerminalColor) {
+	catalogue := data.CurrentCatalogue()
+	homeEntry, homeOK := catalogue.Team(details.HomeTeam)
+	awayEntry, awayOK := catalogue.Team(details.AwayTeam)
+	if !homeOK || !awayOK {
+		return neonCyan, neonRed
+	}
+	homeHex, homeRGB, ok := readableColor(homeEntry.Colors, nil)
+	if !ok {
+		return neonCyan, neonRed
+	}
+	awayHex, _, ok := readableColor(awayEntry.Colors, &homeRGB)
+	if !ok {
+		return neonCyan, neonRed
+	}
+	return lipgloss.Color(homeHex), lipgloss.Color(awayHex)
+}
+
+// readableColor returns the first hex color that is neither near-black nor near-white,
+// so it shows on dark and light terminals, and that stands apart from avoid (if set).
+func readableColor(colors []string, avoid *[3]float64) (string, [3]float64, bool) {
+	for _, hex := range colors {
+		var r, g, b int
+		if _, err := fmt.Sscanf(hex, "#%02x%02x%02x", &r, &g, &b); err != nil {
+			continue
+		}
+		rgb := [3]float64{float64(r), float64(g), float64(b)}
+		luminance := (0.2126*rgb[0] + 0.7152*rgb[1] + 0.0722*rgb[2]) / 255
+		if luminance < 0.08 || luminance > 0.85 {
+			continue
+		}
+		if avoid != nil && math.Hypot(math.Hypot(rgb[0]-avoid[0], rgb[1]-avoid[1]), rgb[2]-avoid[2]) < 100 {
+			continue
+		}
+		return hex, rgb, true
+	}
+	return "", [3]float64{}, false
+}
+
+// momentumHeight is the number of rows of the momentum chart on each side of the axis.
+const momentumHeight = 3
+
+// renderMomentumSection renders the attacking momentum across the match timeline,
+// home pressure above the axis and away pressure below, with goals on the axis.
+func renderMomentumSection(details *api.MatchDetails, contentWidth int, homeTeam, awayTeam string) string {
+	duration := 90.0
+	if details.MatchDuration > 90 {
+		duration = float64(details.MatchDuration)
+	}
+	last := details.Momentum[len(details.Momentum)-1].Minute
+	duration = max(duration, last)
+
+	columns := min(contentWidth-4, 90)
+	column := func(minute float64) int {
+		return min(max(int(minute/duration*float64(columns)), 0), columns-1)
+	}
+
+	// Average each column's minutes; columns between sparse points take the nearest one
+	values := make([]float64, columns)
+	sums := make([]float64, columns)
+	counts := make([]int, columns)
+	for _, p := range details.Momentum {
+		i := column(p.Minute)
+		sums[i] += p.Value
+		counts[i]++
+	}
+	for i := range values {
+		minute := (float64(i) + 0.5) * duration / float64(columns)
+		switch {
+		case counts[i] > 0:
+			values[i] = sums[i] / float64(counts[i]) / 100
+		case minute <= last:
+			nearest := details.Momentum[0]
+			for _, p := range details.Momentum {
+				if math.Abs(p.Minute-minute) < math.Abs(nearest.Minute-minute) {
+					nearest = p
+				}
+			}
+			values[i] = nearest.Value / 100
+		}
+	}
+
+	homeColor, awayColor := momentumColors(details)
+	markers := map[int]string{column(45): lipgloss.NewStyle().Foreground(neonDim).Render("┼")}
+	for _, e := range details.Events {
+		if e.Type != "goal" {
+			continue
+		}
+		color := homeColor
+		if e.Team.ID == details.AwayTeam.ID {
+			color = awayColor
+		}
+		markers[column(float64(e.Minute))] = lipgloss.NewStyle().Foreground(color).Bold(true).Render("●")
+	}
+
+	chart := design.RenderMomentum(design.MomentumChart{
+		Values:    values,
+		Height:    momentumHeight,
+		Markers:   markers,
+		HomeColor: homeColor,
+		AwayColor: awayColor,
+		AxisColor: neonDarkDim,
+	})
+
+	// Minute labels under the axis: kickoff, half-time and full time
+	end := fmt.Sprintf("%d'", int(duration))
+	ht := column(45)
+	labels := "0'" + strings.Repeat(" ", max(ht-3, 1)) + "HT"
+	labels += strings.Repeat(" ", max(columns-lipgloss.Width(labels)-lipgloss.Width(end), 1)) + end
+
+	legend := lipgloss.NewStyle().Foreground(homeColor).Bold(true).Render("▲ "+homeTeam) + "   " + lipgloss.NewStyle().Foreground(awayColor).Bold(true).Render("▼ "+awayTeam)
+
+	centerStyle := lipgloss.NewStyle().Width(contentWidth).Align(lipgloss.Center)
+	return lipgloss.JoinVertical(lipgloss.Left,
+		"",
+		neonHeaderStyle.Render("Momentum"),
+		"",
+		centerStyle.Render(legend),
+		centerStyle.Render(lipgloss.JoinVertical(lipgloss.Left, chart, neonDimStyle.Render(labels))),
+	)
+}
+
 func renderLiveUpdatesSection(cfg MatchDetailsConfig, contentWidth int) string {
 	var lines []string
 
@@ -364,6 +809,12 @@ func renderLiveUpdatesSection(cfg MatchDetailsConfig, contentWidth int) string {
 		titleText = "Updating...  " + pollingView
 	} else {
 		titleText = constants.PanelUpdates
+		if cfg.FeedStatus != "" {
+			titleText += "  " + neonValueStyle.Render(cfg.FeedStatus)
+		}
+		if cfg.GoalLinksStatus != "" {
+			titleText += "  " + neonDimStyle.Render(cfg.GoalLinksStatus)
+		}
 	}
 
 	updatesTitle := lipgloss.NewStyle().
@@ -384,10 +835,7 @@ func renderLiveUpdatesSection(cfg MatchDetailsConfig, contentWidth int) string {
 			Render(constants.EmptyNoUpdates)
 		lines = append(lines, emptyUpdates)
 	} else if len(cfg.LiveUpdates) > 0 {
-		for _, update := range cfg.LiveUpdates {
-			updateLine := renderStyledLiveUpdate(update, contentWidth, cfg.Details, cfg.GoalLinks)
-			lines = append(lines, updateLine)
-		}
+		lines = append(lines, renderLiveUpdatesTimeline(cfg, contentWidth)...)
 	}
 
 	return lipgloss.JoinVertical(lipgloss.Left, lines...)
@@ -443,12 +891,12 @@ func renderStatComparison(label, homeVal, awayVal string, maxWidth int) string {
 		maxVal = 1
 	}
 
-	homeFilled := min((homeNum*halfBar)/maxVal, halfBar)
+	homeFilled := min(int(homeNum*float64(halfBar)/maxVal), halfBar)
 	homeEmpty := halfBar - homeFilled
 	homeBar := strings.Repeat(" ", homeEmpty) + strings.Repeat("▪", homeFilled)
 	homeBarStyled := lipgloss.NewStyle().Foreground(neonCyan).Render(homeBar)
 
-	awayFilled := min((awayNum*halfBar)/maxVal, halfBar)
+	awayFilled := min(int(awayNum*float64(halfBar)/maxVal), halfBar)
 	awayEmpty := halfBar - awayFilled
 	awayBar := strings.Repeat("▪", awayFilled) + strings.Repeat(" ", awayEmpty)
 	awayBarStyled := lipgloss.NewStyle().Foreground(neonGray).Render(awayBar)
@@ -474,7 +922,7 @@ func parsePercent(s string) int {
 	return val
 }
 
-func parseNumber(s string) int {
+func parseNumber(s string) float64 {
 	s = strings.TrimSpace(s)
 	if idx := strings.Index(s, " "); idx > 0 {
 		s = s[:idx]
@@ -484,7 +932,7 @@ func parseNumber(s string) int {
 	}
 	s = strings.TrimSpace(s)
 
-	val, err := strconv.Atoi(s)
+	val, err := strconv.ParseFloat(s, 64)
 	if err != nil {
 		return 0
 	}
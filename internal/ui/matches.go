@@ -3,17 +3,137 @@ package ui
 import (
 	"fmt"
 	"strings"
+	"text/template"
 
 	"github.com/0xjuanma/golazo/internal/api"
+	"github.com/0xjuanma/golazo/internal/constants"
 )
 
+// matchItemTemplate is the user's list item template (nil = built-in layout).
+var matchItemTemplate *template.Template
+
+// MatchTemplateData holds the fields available to list item templates,
+// e.g. "{{.Home}} {{.Score}} {{.Away}} · {{.League}} · {{.Minute}}".
+type MatchTemplateData struct {
+	Home     string // Short team name (falls back to full name)
+	Away     string
+	HomeFull string // Full team name
+	AwayFull string
+	Score    string // "2 - 1" ("1 - 1 (4-2 pens)" after a shootout), or "vs" before kickoff
+	League   string
+	Minute   string // Live minute (e.g., "67'", "HT"), empty when not live
+	Status   string // "live", "finished" or "not_started"
+	Kickoff  string // Local kickoff time (15:04)
+	Round    string
+}
+
+// SetMatchItemTemplate parses and validates a list item template.
+// An empty string restores the built-in layout. Validation executes the template
+// against sample data so unknown fields are reported at startup rather than while rendering.
+// An invalid template leaves the current one in place.
+func SetMatchItemTemplate(text string) error {
+	tmpl, err := parseMatchItemTemplate(text)
+	if err != nil {
+		return err
+	}
+	matchItemTemplate = tmpl
+	return nil
+}
+
+// ValidateMatchItemTemplate reports whether SetMatchItemTemplate would accept text.
+func ValidateMatchItemTemplate(text string) error {
+	_, err := parseMatchItemTemplate(text)
+	return err
+}
+
+// parseMatchItemTemplate parses a list item template and executes it against sample
+// data. Returns nil for an empty template (the built-in layout).
+func parseMatchItemTemplate(text string) (*template.Template, error) {
+	if strings.TrimSpace(text) == "" {
+		return nil, nil
+	}
+
+	tmpl, err := template.New("list_item").Parse(text)
+	if err != nil {
+		return nil, fmt.Errorf("parse template: %w", err)
+	}
+
+	var sb strings.Builder
+	if err := tmpl.Execute(&sb, MatchTemplateData{}); err != nil {
+		return nil, fmt.Errorf("execute template: %w", err)
+	}
+	return tmpl, nil
+}
+
+// templateData builds the template fields for the match.
+func (m MatchDisplay) templateData() MatchTemplateData {
+	d := MatchTemplateData{
+		Home:     m.HomeTeam.ShortName,
+		Away:     m.AwayTeam.ShortName,
+		HomeFull: m.HomeTeam.Name,
+		AwayFull: m.AwayTeam.Name,
+		Score:    constants.StatusNotStarted,
+		League:   m.League.Name,
+		Status:   string(m.Status),
+		Round:    m.Round,
+	}
+	if d.Home == "" {
+		d.Home = d.HomeFull
+	}
+	if d.Away == "" {
+		d.Away = d.AwayFull
+	}
+	if score := scoreText(m.Match); score != "" {
+		d.Score = score
+	}
+	d.Minute = m.ClockText()
+	if m.MatchTime != nil {
+		d.Kickoff = m.MatchTime.Local().Format("15:04")
+	}
+	return d
+}
+
+// scoreText returns a match's score, e.g. "2 - 1", with the shootout score after a
+// penalty shootout ("1 - 1 (4-2 pens)"). Returns "" before kickoff.
+func scoreText(m api.Match) string {
+	if m.HomeScore == nil || m.AwayScore == nil {
+		return ""
+	}
+	score := fmt.Sprintf("%d - %d", *m.HomeScore, *m.AwayScore)
+	if m.Shootout != nil {
+		score += fmt.Sprintf(" (%d-%d pens)", m.Shootout.HomeScore, m.Shootout.AwayScore)
+	}
+	return score
+}
+
+// kickoffLine returns the "KO 15:04" line followed by any extra columns,
+// or "" when neither is available. Columns are listed in configured order, so when the
+// list is too narrow the delegate's line truncation drops the least important ones first.
+func (m MatchDisplay) kickoffLine() string {
+	var parts []string
+	if m.MatchTime != nil {
+		parts = append(parts, "KO "+m.MatchTime.Local().Format("15:04"))
+	}
+	parts = append(parts, m.Columns...)
+	return strings.Join(parts, " • ")
+}
+
 // MatchDisplay wraps a match with display information for rendering.
 type MatchDisplay struct {
 	api.Match
+	Columns []string // Optional extra columns (see FinishedColumnValues)
 }
 
 // Title returns a formatted title for the match.
+// Uses the configured list item template when set.
 func (m MatchDisplay) Title() string {
+	if matchItemTemplate != nil {
+		var sb strings.Builder
+		if err := matchItemTemplate.Execute(&sb, m.templateData()); err == nil {
+			return sb.String()
+		}
+	}
+
 	home := m.HomeTeam.ShortName
 	if home == "" {
 		home = m.HomeTeam.Name
@@ -27,12 +147,17 @@ func (m MatchDisplay) Title() string {
 
 // Description returns a formatted description for the match.
 // Shows score, league, live time on first line; KO time on second line.
+// With a custom list item template, only the KO time line is shown (the template owns the rest).
 func (m MatchDisplay) Description() string {
+	if matchItemTemplate != nil {
+		return m.kickoffLine()
+	}
+
 	var parts []string
 
 	// Add score if available
-	if m.HomeScore != nil && m.AwayScore != nil {
-		parts = append(parts, fmt.Sprintf("%d - %d", *m.HomeScore, *m.AwayScore))
+	if score := scoreText(m.Match); score != "" {
+		parts = append(parts, score)
 	}
 
 	// Add league name
@@ -41,16 +166,63 @@ func (m MatchDisplay) Description() string {
 	}
 
 	// Add live time
-	if m.LiveTime != nil {
-		parts = append(parts, *m.LiveTime)
+	if clock := m.ClockText(); clock != "" {
+		parts = append(parts, clock)
 	}
 
 	line1 := strings.Join(parts, " • ")
 
 	// Add start time (kick-off time) on second line
-	if m.MatchTime != nil {
-		return line1 + "\nKO " + m.MatchTime.Local().Format("15:04")
+	if ko := m.kickoffLine(); ko != "" {
+		return line1 + "\n" + ko
 	}
 
 	return line1
 }
+
+// Finished list column names accepted in the finished_columns setting.
+const (
+	ColumnXG         = "xg"
+	ColumnAttendance = "attendance"
+	ColumnReferee    = "referee"
+	ColumnReplay     = "replay"
+)
+
+// ValidateFinishedColumns reports the first unknown column name, if any.
+func ValidateFinishedColumns(columns []string) error {
+	for _, column := range columns {
+		switch strings.ToLower(column) {
+		case ColumnXG, ColumnAttendance, ColumnReferee, ColumnReplay:
+		default:
+			return fmt.Errorf("unknown finished column %q (expected xg, attendance, referee or replay)", column)
+		}
+	}
+	return nil
+}
+
+// FinishedColumnValues renders the configured extra columns for a finished match.
+// Columns whose data is unavailable (details not loaded yet, no referee, ...) are omitted.
+func FinishedColumnValues(columns []string, details *api.MatchDetails, hasReplay bool) []string {
+	var values []string
+	for _, column := range columns {
+		switch strings.ToLower(column) {
+		case ColumnXG:
+			if details != nil && details.HomeXG != nil && details.AwayXG != nil {
+				values = append(values, fmt.Sprintf("xG %.1f-%.1f", *details.HomeXG, *details.AwayXG))
+			}
+		case ColumnAttendance:
+			if details != nil && details.Attendance > 0 {
+				values = append(values, "👥 "+formatNumber(details.Attendance))
+			}
+		case ColumnReferee:
+			if details != nil && details.Referee != "" {
+				values = append(values, "Ref "+details.Referee)
+			}
+		case ColumnReplay:
+			if hasReplay || (details != nil && details.Highlight != nil && IsValidReplayURL(details.Highlight.URL)) {
+				values = append(values, "▶")
+			}
+		}
+	}
+	return values
+}
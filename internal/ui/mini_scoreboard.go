@@ -0,0 +1,87 @@
+package ui
+
+import (
+	"fmt"
+
+	"github.com/0xjuanma/golazo/internal/api"
+	"github.com/0xjuanma/golazo/internal/constants"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// miniBoxStyle is the single-line bordered box used by the mini scoreboard.
+// Border top + content + border bottom = 3 lines total.
+var miniBoxStyle = lipgloss.NewStyle().
+	Border(lipgloss.RoundedBorder()).
+	BorderForeground(neonRed).
+	Padding(0, 1)
+
+// RenderMiniScoreboard renders a 3-line box with the match score and minute only.
+// Designed for a small floating terminal window (golazo mini).
+func RenderMiniScoreboard(width int, details *api.MatchDetails, loading bool) string {
+	innerWidth := max(width-4, 10) // Border (2) + padding (2)
+
+	var content string
+	switch {
+	case details == nil && loading:
+		content = neonDimStyle.Render(constants.LoadingFetching)
+	case details == nil:
+		content = neonDimStyle.Render(constants.EmptyNoLiveMatches)
+	default:
+		content = renderMiniScoreLine(details, innerWidth)
+	}
+
+	return miniBoxStyle.
+		Width(innerWidth + 2).
+		Align(lipgloss.Center).
+		Render(content)
+}
+
+// renderMiniScoreLine builds the single content line: "HOME 2 - 1 AWAY  67'".
+// Team names are truncated to fit the available width.
+func renderMiniScoreLine(details *api.MatchDetails, width int) string {
+	homeTeam := details.HomeTeam.ShortName
+	if homeTeam == "" {
+		homeTeam = details.HomeTeam.Name
+	}
+	awayTeam := details.AwayTeam.ShortName
+	if awayTeam == "" {
+		awayTeam = details.AwayTeam.Name
+	}
+
+	score := constants.StatusNotStarted
+	if details.HomeScore != nil && details.AwayScore != nil {
+		score = fmt.Sprintf("%d - %d", *details.HomeScore, *details.AwayScore)
+	}
+
+	var status string
+	var statusStyle lipgloss.Style
+	switch details.Status {
+	case api.MatchStatusLive:
+		status = constants.StatusLive
+		if clock := details.ClockText(); clock != "" {
+			status = clock
+		}
+		statusStyle = lipgloss.NewStyle().Foreground(neonRed).Bold(true)
+	case api.MatchStatusFinished:
+		status = constants.StatusFinished
+		statusStyle = lipgloss.NewStyle().Foreground(neonCyan)
+	default:
+		status = constants.StatusNotStartedShort
+		if details.MatchTime != nil {
+			status = details.MatchTime.Local().Format("15:04")
+		}
+		statusStyle = neonDimStyle
+	}
+
+	// Reserve room for score, status and separators; split the rest between teams
+	teamWidth := max((width-len(score)-len(status)-4)/2, 3)
+	homeTeam = truncateString(homeTeam, teamWidth)
+	awayTeam = truncateString(awayTeam, teamWidth)
+
+	return fmt.Sprintf("%s %s %s  %s",
+		neonTeamStyle.Render(homeTeam),
+		neonValueStyle.Bold(true).Render(score),
+		neonTeamStyle.Render(awayTeam),
+		statusStyle.Render(status),
+	)
+}
@@ -73,12 +73,12 @@ func renderCenterAlignedEvent(minuteStr string, eventContent string, isHomeTeam
 }
 
 // renderMatchDetailsPanelWithPolling renders the right panel with polling spinner support.
-func renderMatchDetailsPanelWithPolling(width, height int, details *api.MatchDetails, liveUpdates []string, sp spinner.Model, loading bool, pollingSpinner *RandomCharSpinner, isPolling bool, goalLinks GoalLinksMap) string {
-	return renderMatchDetailsPanelFull(width, height, details, liveUpdates, sp, loading, true, pollingSpinner, isPolling, goalLinks)
+func renderMatchDetailsPanelWithPolling(width, height int, details *api.MatchDetails, liveUpdates []string, sp spinner.Model, loading bool, pollingSpinner *RandomCharSpinner, isPolling bool, goalLinks GoalLinksMap, goalLinksStatus string, feedStatus string, commentary *Commentary) string {
+	return renderMatchDetailsPanelFull(width, height, details, liveUpdates, sp, loading, true, pollingSpinner, isPolling, goalLinks, goalLinksStatus, feedStatus, commentary)
 }
 
 // renderMatchDetailsPanelFull renders the right panel with match details using unified rendering.
-func renderMatchDetailsPanelFull(width, height int, details *api.MatchDetails, liveUpdates []string, sp spinner.Model, loading bool, showTitle bool, pollingSpinner *RandomCharSpinner, isPolling bool, goalLinks GoalLinksMap) string {
+func renderMatchDetailsPanelFull(width, height int, details *api.MatchDetails, liveUpdates []string, sp spinner.Model, loading bool, showTitle bool, pollingSpinner *RandomCharSpinner, isPolling bool, goalLinks GoalLinksMap, goalLinksStatus string, feedStatus string, commentary *Commentary) string {
 	detailsPanelStyle := lipgloss.NewStyle().Padding(0, 1)
 
 	if details == nil {
@@ -104,17 +104,20 @@ func renderMatchDetailsPanelFull(width, height int, details *api.MatchDetails, l
 
 	// Use unified rendering
 	cfg := MatchDetailsConfig{
-		Width:          width,
-		Height:         height,
-		Details:        details,
-		GoalLinks:      goalLinks,
-		ShowStatistics: false,
-		ShowHighlights: false,
-		LiveUpdates:    liveUpdates,
-		PollingSpinner: pollingSpinner,
-		IsPolling:      isPolling,
-		Loading:        loading,
-		Focused:        false,
+		Width:           width,
+		Height:          height,
+		Details:         details,
+		GoalLinks:       goalLinks,
+		GoalLinksStatus: goalLinksStatus,
+		FeedStatus:      feedStatus,
+		ShowStatistics:  false,
+		ShowHighlights:  false,
+		LiveUpdates:     liveUpdates,
+		Commentary:      commentary,
+		PollingSpinner:  pollingSpinner,
+		IsPolling:       isPolling,
+		Loading:         loading,
+		Focused:         false,
 	}
 
 	headerContent, scrollableContent := RenderMatchDetails(cfg)
@@ -170,6 +173,9 @@ func renderStyledLiveUpdate(update string, contentWidth int, details *api.MatchD
 	if len(update) == 0 {
 		return update
 	}
+	if strings.HasPrefix(update, ReactionPrefix+" ") {
+		return renderReaction(update, contentWidth)
+	}
 
 	cleanUpdate, isHome := extractTeamMarker(update)
 	minute, contentWithoutMinute := extractMinuteFromUpdate(cleanUpdate)
@@ -215,6 +221,11 @@ func renderStyledLiveUpdate(update string, contentWidth int, details *api.MatchD
 		styledContent = buildEventContent(whiteStyle.Render(playerDetails), "", symbol, cardStyle.Render("CARD"), isHome)
 	case "↔": // Substitution
 		styledContent = renderSubstitutionWithColorsNoMinute(contentWithoutMinute, isHome)
+	case "✗": // Overturned (e.g., goal disallowed by VAR)
+		dimStyle := lipgloss.NewStyle().Foreground(neonDim).Strikethrough(true)
+		playerDetails, _ := extractPlayerAndType(contentWithoutMinute, "[OVERTURNED]")
+		label := lipgloss.NewStyle().Foreground(neonRed).Bold(true).Render("OVERTURNED")
+		styledContent = buildEventContent(dimStyle.Render(playerDetails), "", symbol, label, isHome)
 	case "·": // Other
 		dimStyle := lipgloss.NewStyle().Foreground(neonDim)
 		playerDetails, _ := extractPlayerAndType(contentWithoutMinute, "")
@@ -0,0 +1,161 @@
+package ui
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/0xjuanma/golazo/internal/api"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/x/ansi"
+)
+
+// PitchTeam is one side of the pitch view: a starting XI laid out by its formation.
+type PitchTeam struct {
+	Name      string
+	Formation string // e.g., "4-2-3-1"
+	Starting  []api.PlayerInfo
+}
+
+// RenderPitch draws both starting XIs on a Unicode pitch, home attacking downwards from
+// the top goal and away upwards from the bottom one. Each player shows their name, rating
+// and, when substituted, the minute they went off (subbedOff maps player name to minute).
+func RenderPitch(home, away PitchTeam, subbedOff map[string]int, width int) string {
+	inner := width - 2
+	if inner < 20 {
+		inner = 20
+	}
+	line := dialogSeparatorStyle
+
+	boxWidth := inner / 3
+	boxLeft := (inner - boxWidth) / 2
+	boxRight := inner - boxWidth - boxLeft
+	side := func(s string) string { return line.Render("│") + s + line.Render("│") }
+
+	var lines []string
+	lines = append(lines, line.Render("┌"+strings.Repeat("─", boxLeft-1)+"┬"+strings.Repeat("─", boxWidth)+"┬"+strings.Repeat("─", boxRight-1)+"┐"))
+
+	homeRows := formationRows(home.Formation, home.Starting)
+	for i, row := range homeRows {
+		lines = append(lines, renderPitchRow(row, subbedOff, inner, dialogTeamStyle, side)...)
+		if i == 0 {
+			// Penalty box closes below the goalkeeper
+			lines = append(lines, side(strings.Repeat(" ", boxLeft-1)+line.Render("└"+strings.Repeat("─", boxWidth)+"┘")+strings.Repeat(" ", boxRight-1)))
+		}
+	}
+	if len(homeRows) == 0 {
+		lines = append(lines, side(pitchCenter(home.Name+": lineup not available", inner, dialogDimStyle)))
+	}
+
+	half := inner / 2
+	lines = append(lines, line.Render("├"+strings.Repeat("─", half-1)+"○"+strings.Repeat("─", inner-half)+"┤"))
+
+	awayRows := formationRows(away.Formation, away.Starting)
+	if len(awayRows) == 0 {
+		lines = append(lines, side(pitchCenter(away.Name+": lineup not available", inner, dialogDimStyle)))
+	}
+	for i := len(awayRows) - 1; i >= 0; i-- {
+		if i == 0 {
+			lines = append(lines, side(strings.Repeat(" ", boxLeft-1)+line.Render("┌"+strings.Repeat("─", boxWidth)+"┐")+strings.Repeat(" ", boxRight-1)))
+		}
+		lines = append(lines, renderPitchRow(awayRows[i], subbedOff, inner, lipgloss.NewStyle().Foreground(neonRed).Bold(true), side)...)
+	}
+
+	lines = append(lines, line.Render("└"+strings.Repeat("─", boxLeft-1)+"┴"+strings.Repeat("─", boxWidth)+"┴"+strings.Repeat("─", boxRight-1)+"┘"))
+	return strings.Join(lines, "\n")
+}
+
+// formationRows splits a starting XI into lines from goalkeeper to attack.
+// Players are taken in lineup order (FotMob lists them goalkeeper first, line by line);
+// without a usable formation they are grouped by position instead.
+func formationRows(formation string, players []api.PlayerInfo) [][]api.PlayerInfo {
+	if len(players) == 0 {
+		return nil
+	}
+
+	sizes := []int{1}
+	total := 1
+	for _, part := range strings.Split(formation, "-") {
+		n, err := strconv.Atoi(strings.TrimSpace(part))
+		if err != nil || n <= 0 {
+			sizes = nil
+			break
+		}
+		sizes = append(sizes, n)
+		total += n
+	}
+
+	if sizes != nil && total == len(players) {
+		rows := make([][]api.PlayerInfo, 0, len(sizes))
+		start := 0
+		for _, n := range sizes {
+			rows = append(rows, players[start:start+n])
+			start += n
+		}
+		return rows
+	}
+
+	// Fallback: one line per position group
+	groups := map[string][]api.PlayerInfo{}
+	for _, p := range players {
+		pos := p.Position
+		if pos != "GK" && pos != "DF" && pos != "MF" && pos != "FW" {
+			pos = "MF"
+		}
+		groups[pos] = append(groups[pos], p)
+	}
+	var rows [][]api.PlayerInfo
+	for _, pos := range []string{"GK", "DF", "MF", "FW"} {
+		if len(groups[pos]) > 0 {
+			rows = append(rows, groups[pos])
+		}
+	}
+	return rows
+}
+
+// renderPitchRow renders a line of players as two text lines: names, then rating and sub marker.
+func renderPitchRow(players []api.PlayerInfo, subbedOff map[string]int, width int, nameStyle lipgloss.Style, side func(string) string) []string {
+	cell := width / len(players)
+	var names, details strings.Builder
+	for i, p := range players {
+		w := cell
+		if i == len(players)-1 {
+			w = width - cell*(len(players)-1)
+		}
+
+		name := pitchName(p, w-1)
+		names.WriteString(pitchCenter(name, w, nameStyle))
+
+		var info []string
+		if p.Rating != "" {
+			info = append(info, p.Rating)
+		} else if p.Number > 0 {
+			info = append(info, fmt.Sprintf("#%d", p.Number))
+		}
+		if minute, ok := subbedOff[p.Name]; ok {
+			info = append(info, fmt.Sprintf("↓%d'", minute))
+		}
+		details.WriteString(pitchCenter(ansi.Truncate(strings.Join(info, " "), w-1, "…"), w, dialogDimStyle))
+	}
+	return []string{side(names.String()), side(details.String())}
+}
+
+// pitchName shortens a player name to fit a pitch cell: full name, then surname, then truncated.
+func pitchName(p api.PlayerInfo, width int) string {
+	name := p.Name
+	suffix := ""
+	if p.Captain {
+		suffix = " (C)"
+	}
+	if ansi.StringWidth(name+suffix) > width {
+		if fields := strings.Fields(name); len(fields) > 1 {
+			name = fields[len(fields)-1]
+		}
+	}
+	return ansi.Truncate(name+suffix, width, "…")
+}
+
+// pitchCenter centers text in a cell of the given width.
+func pitchCenter(text string, width int, style lipgloss.Style) string {
+	return lipgloss.NewStyle().Width(width).Align(lipgloss.Center).Render(style.Render(text))
+}
@@ -144,9 +144,9 @@ func (s *SettingsState) Save() error {
 		}
 	}
 
-	settings := &data.Settings{
-		SelectedLeagues: selectedIDs,
-	}
+	// Preserve settings not managed by this view (followed teams, daily note, ...)
+	settings, _ := data.LoadSettings()
+	settings.SelectedLeagues = selectedIDs
 
 	err := data.SaveSettings(settings)
 	if err == nil {
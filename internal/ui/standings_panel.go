@@ -0,0 +1,121 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/0xjuanma/golazo/internal/api"
+	"github.com/0xjuanma/golazo/internal/constants"
+	"github.com/0xjuanma/golazo/internal/ui/design"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/x/ansi"
+)
+
+// LiveStandings is the league table shown next to the live list in the split layout,
+// recalculated with the scores of matches in progress.
+type LiveStandings struct {
+	League     string
+	Rows       []LiveStandingsRow // Empty while loading or when the league has no table
+	Loading    bool
+	HomeTeamID int // Teams of the selected match, highlighted
+	AwayTeamID int
+}
+
+// LiveStandingsRow is a table entry after applying live results.
+type LiveStandingsRow struct {
+	api.LeagueTableEntry
+	Moved   int  // Positions gained (+) or lost (-) compared to the table before kick-off
+	Playing bool // Team is playing right now
+}
+
+// renderStandingsPanel renders the live league table, scrolled so the selected match's teams are visible.
+func renderStandingsPanel(width, height int, standings *LiveStandings) string {
+	contentWidth := width - 4
+	title := constants.PanelLiveTable
+	if standings.League != "" {
+		title = standings.League + " · " + title
+	}
+	lines := []string{design.RenderHeader(title, contentWidth)}
+
+	switch {
+	case len(standings.Rows) > 0:
+		lines = append(lines, renderStandingsPanelHeader(contentWidth))
+		rows := standings.Rows
+		available := height - 2 // Title and column headers
+		if available > 0 && len(rows) > available {
+			first := len(rows)
+			for i, row := range rows {
+				if row.Team.ID == standings.HomeTeamID || row.Team.ID == standings.AwayTeamID {
+					first = i
+					break
+				}
+			}
+			start := min(max(first-available/3, 0), len(rows)-available)
+			rows = rows[start : start+available]
+		}
+		for _, row := range rows {
+			lines = append(lines, renderStandingsPanelRow(row, contentWidth, standings))
+		}
+	case standings.Loading:
+		lines = append(lines, neonDimStyle.Render("Loading table..."))
+	default:
+		lines = append(lines, neonDimStyle.Render("No standings for this competition"))
+	}
+
+	return neonPanelCyanStyle.
+		Width(width).
+		Height(height).
+		MaxHeight(height).
+		Render(truncateToHeight(strings.Join(lines, "\n"), height))
+}
+
+// renderStandingsPanelHeader renders the column titles of the live table.
+func renderStandingsPanelHeader(width int) string {
+	teamWidth := width - standingsColPos - standingsColStat - standingsColGD - standingsColPts - 4
+	return lipgloss.JoinHorizontal(lipgloss.Top,
+		neonHeaderStyle.Width(standingsColPos).Align(lipgloss.Right).Render("#"),
+		"    ",
+		neonHeaderStyle.Width(teamWidth).Render("Team"),
+		neonHeaderStyle.Width(standingsColStat).Align(lipgloss.Right).Render("P"),
+		neonHeaderStyle.Width(standingsColGD).Align(lipgloss.Right).Render("GD"),
+		neonHeaderStyle.Width(standingsColPts).Align(lipgloss.Right).Render("Pts"),
+	)
+}
+
+// renderStandingsPanelRow renders one team with its movement arrow and a live marker.
+func renderStandingsPanelRow(row LiveStandingsRow, width int, standings *LiveStandings) string {
+	teamWidth := width - standingsColPos - standingsColStat - standingsColGD - standingsColPts - 4
+
+	moved := "  "
+	switch {
+	case row.Moved > 0:
+		moved = lipgloss.NewStyle().Foreground(neonCyan).Render("▲ ")
+	case row.Moved < 0:
+		moved = lipgloss.NewStyle().Foreground(neonRed).Render("▼ ")
+	}
+
+	name := row.Team.ShortName
+	if name == "" {
+		name = row.Team.Name
+	}
+	if row.Playing {
+		name += " ●"
+	}
+	name = ansi.Truncate(name, teamWidth-1, "…")
+
+	style := neonValueStyle
+	if row.Team.ID == standings.HomeTeamID || row.Team.ID == standings.AwayTeamID {
+		style = neonTeamStyle
+	}
+
+	return lipgloss.JoinHorizontal(lipgloss.Top,
+		style.Width(standingsColPos).Align(lipgloss.Right).Render(fmt.Sprintf("%d", row.Position)),
+		" ",
+		zoneMarker(row.LeagueTableEntry),
+		moved,
+		style.Width(teamWidth).Render(name),
+		style.Width(standingsColStat).Align(lipgloss.Right).Render(fmt.Sprintf("%d", row.Played)),
+		style.Width(standingsColGD).Align(lipgloss.Right).Render(formatGoalDifference(row.GoalDifference)),
+		style.Width(standingsColPts).Align(lipgloss.Right).Render(fmt.Sprintf("%d", row.Points)),
+	)
+}
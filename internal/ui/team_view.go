@@ -0,0 +1,204 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/0xjuanma/golazo/internal/api"
+	"github.com/0xjuanma/golazo/internal/constants"
+	"github.com/0xjuanma/golazo/internal/ui/design"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// TeamView is the state of the team page.
+type TeamView struct {
+	Team    api.Team      // Shown in the header while the page loads
+	Page    *api.TeamPage // nil until loaded
+	Loading bool
+	Scroll  int // First squad line shown
+}
+
+// RenderTeamView renders a team's page: league position, recent form and upcoming
+// fixtures on the left, the squad on the right (scrolled by Scroll).
+func RenderTeamView(width, height int, view TeamView, bannerType constants.StatusBannerType) string {
+	if width <= 0 {
+		width = 80
+	}
+	if height <= 0 {
+		height = 24
+	}
+
+	name := view.Team.Name
+	if view.Page != nil && view.Page.Team.Name != "" {
+		name = view.Page.Team.Name
+	}
+
+	lines := []string{}
+	if banner := renderStatusBanner(bannerType, width); banner != "" {
+		lines = append(lines, banner)
+	}
+	lines = append(lines, design.RenderHeader(name, width))
+	help := neonDimStyle.Width(width).Align(lipgloss.Center).Render(constants.HelpTeamView)
+	panelHeight := max(height-len(lines)-3, minPanelHeight)
+
+	switch {
+	case view.Page == nil:
+		message := constants.EmptyNoTeamPage
+		if view.Loading {
+			message = constants.LoadingFetching
+		}
+		lines = append(lines, "", neonDimStyle.Width(width).Height(panelHeight).Align(lipgloss.Center).
+			PaddingTop(panelHeight/3).Render(message))
+	default:
+		leftWidth := max(width*45/100, 30)
+		rightWidth := max(width-leftWidth-3, 20) // Separator is padded
+		left := neonPanelCyanStyle.Width(leftWidth).Height(panelHeight).MaxHeight(panelHeight).
+			Render(renderTeamOverview(view.Page, leftWidth-2))
+		right := neonPanelCyanStyle.Width(rightWidth).Height(panelHeight).MaxHeight(panelHeight).
+			Render(renderSquad(view.Page.Squad, rightWidth-2, panelHeight, view.Scroll))
+		separator := neonSeparatorStyle.Render(strings.TrimSuffix(strings.Repeat("┃\n", panelHeight), "\n"))
+		lines = append(lines, "", lipgloss.JoinHorizontal(lipgloss.Top, left, separator, right))
+	}
+
+	lines = append(lines, "", help)
+	return lipgloss.JoinVertical(lipgloss.Left, lines...)
+}
+
+// renderTeamOverview renders the league position, recent form and upcoming fixtures.
+func renderTeamOverview(page *api.TeamPage, width int) string {
+	clip := lipgloss.NewStyle().MaxWidth(width)
+	var lines []string
+
+	if page.Position > 0 {
+		position := neonHeaderStyle.Render(ordinal(page.Position))
+		if page.League != "" {
+			position += neonDimStyle.Render(" in ") + neonValueStyle.Render(page.League)
+		}
+		lines = append(lines, clip.Render(position), "")
+	}
+
+	lines = append(lines, neonHeaderStyle.Render("Form"))
+	if len(page.Form) == 0 {
+		lines = append(lines, neonDimStyle.Render("No recent results"))
+	} else {
+		boxes := make([]string, len(page.Form))
+		for i, f := range page.Form {
+			boxes[i] = formResultStyle(f.Result).Render(" " + f.Result + " ")
+		}
+		lines = append(lines, strings.Join(boxes, " "), "")
+		// Newest first below the boxes
+		for i := len(page.Form) - 1; i >= 0; i-- {
+			f := page.Form[i]
+			venue := "vs"
+			if !f.Home {
+				venue = " @"
+			}
+			line := formResultStyle(f.Result).Render(f.Result) + " " +
+				neonValueStyle.Render(fmt.Sprintf("%-5s", f.Score)) + " " +
+				neonDimStyle.Render(venue) + " " + neonValueStyle.Render(f.Opponent)
+			lines = append(lines, clip.Render(line))
+		}
+	}
+
+	lines = append(lines, "", neonHeaderStyle.Render("Upcoming"))
+	if len(page.Fixtures) == 0 {
+		lines = append(lines, neonDimStyle.Render("No upcoming fixtures"))
+	}
+	for _, match := range page.Fixtures {
+		venue, opponent := "vs", match.AwayTeam
+		if match.AwayTeam.ID == page.Team.ID {
+			venue, opponent = " @", match.HomeTeam
+		}
+		when := match.MatchTime.Local().Format("Mon 02 Jan 15:04")
+		if match.Status == api.MatchStatusLive {
+			when = lipgloss.NewStyle().Foreground(neonRed).Bold(true).Render(fmt.Sprintf("%-16s", constants.StatusLive))
+		} else {
+			when = neonDimStyle.Render(when)
+		}
+		line := when + "  " + neonDimStyle.Render(venue) + " " + neonValueStyle.Render(opponent.Name)
+		if match.League.Name != "" {
+			line += "  " + neonDimStyle.Render(match.League.Name)
+		}
+		lines = append(lines, clip.Render(line))
+	}
+
+	return lipgloss.JoinVertical(lipgloss.Left, lines...)
+}
+
+// renderSquad renders the squad sections, showing height lines from scroll.
+func renderSquad(squad []api.SquadGroup, width, height, scroll int) string {
+	if len(squad) == 0 {
+		return neonDimStyle.Render("No squad data")
+	}
+
+	clip := lipgloss.NewStyle().MaxWidth(width)
+	var lines []string
+	for _, group := range squad {
+		if len(lines) > 0 {
+			lines = append(lines, "")
+		}
+		lines = append(lines, neonHeaderStyle.Render(group.Title))
+		for _, member := range group.Members {
+			number := "   "
+			if member.Number > 0 {
+				number = fmt.Sprintf("%2d ", member.Number)
+			}
+			line := neonDimStyle.Render(number) + neonValueStyle.Render(member.Name)
+			var info []string
+			if member.Country != "" {
+				info = append(info, member.Country)
+			}
+			if member.Age > 0 {
+				info = append(info, fmt.Sprintf("%d", member.Age))
+			}
+			if len(info) > 0 {
+				line += "  " + neonDimStyle.Render(strings.Join(info, ", "))
+			}
+			lines = append(lines, clip.Render(line))
+		}
+	}
+
+	start := min(max(scroll, 0), max(len(lines)-height, 0))
+	return strings.Join(lines[start:min(start+height, len(lines))], "\n")
+}
+
+// SquadLines returns the number of lines the squad takes, for clamping the scroll.
+func SquadLines(squad []api.SquadGroup) int {
+	lines := 0
+	for i, group := range squad {
+		if i > 0 {
+			lines++ // Blank line between sections
+		}
+		lines += 1 + len(group.Members)
+	}
+	return lines
+}
+
+// formResultStyle colors a result: wins cyan, losses red, draws dim.
+func formResultStyle(result string) lipgloss.Style {
+	style := lipgloss.NewStyle().Bold(true)
+	switch result {
+	case "W":
+		return style.Foreground(neonCyan)
+	case "L":
+		return style.Foreground(neonRed)
+	default:
+		return style.Foreground(neonDim)
+	}
+}
+
+// ordinal formats a table position, e.g. "1st", "12th", "23rd".
+func ordinal(n int) string {
+	suffix := "th"
+	if n%100 < 11 || n%100 > 13 {
+		switch n % 10 {
+		case 1:
+			suffix = "st"
+		case 2:
+			suffix = "nd"
+		case 3:
+			suffix = "rd"
+		}
+	}
+	return fmt.Sprintf("%d%s", n, suffix)
+}
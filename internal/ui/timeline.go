@@ -0,0 +1,183 @@
+package ui
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/0xjuanma/golazo/internal/api"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// boundaryLabels are the separator rows of the events timeline. Boundary i ends the
+// i-th period: the first half, the second half, and the halves of extra time.
+var boundaryLabels = [...]string{"HT", "FT", "ET HT", "AET"}
+
+// boundariesBefore returns how many period boundaries precede an event minute;
+// stoppage time counts for its half (FotMob reports 45+2 as minute 45).
+func boundariesBefore(minute int) int {
+	switch {
+	case minute <= 45:
+		return 0
+	case minute <= 90:
+		return 1
+	case minute <= 105:
+		return 2
+	default:
+		return 3
+	}
+}
+
+// boundariesPassed returns how many period boundaries a match has passed: all of them
+// once finished, else from its live clock. Returns -1 when unknown.
+func boundariesPassed(details *api.MatchDetails) int {
+	if details.Status == api.MatchStatusFinished {
+		if details.ExtraTime {
+			return len(boundaryLabels)
+		}
+		return 2
+	}
+	clock := details.Clock
+	if clock == nil && details.LiveTime != nil {
+		clock = api.ParseClock(*details.LiveTime)
+	}
+	if details.Status != api.MatchStatusLive || clock == nil {
+		return -1
+	}
+	switch clock.Period {
+	case api.PeriodHalfTime:
+		return 1
+	case api.PeriodExtraTimeBreak:
+		return 2
+	case api.PeriodPenalties:
+		return len(boundaryLabels)
+	default:
+		return boundariesBefore(clock.Minute)
+	}
+}
+
+// updateMinute returns the minute of a live update line ("● 67' [GOAL] ...").
+func updateMinute(update string) (int, bool) {
+	clean, _ := extractTeamMarker(update)
+	minute, _ := extractMinuteFromUpdate(clean)
+	n, err := strconv.Atoi(strings.TrimSuffix(minute, "'"))
+	return n, err == nil
+}
+
+// renderLiveUpdatesTimeline renders the live updates, newest first, with separator
+// rows where a period ends.
+func renderLiveUpdatesTimeline(cfg MatchDetailsConfig, contentWidth int) []string {
+	var lines []string
+	newer := -1
+	if cfg.Details != nil {
+		newer = boundariesPassed(cfg.Details)
+	}
+	for _, update := range cfg.LiveUpdates {
+		if minute, ok := updateMinute(update); ok && cfg.Details != nil {
+			older := boundariesBefore(minute)
+			for boundary := newer - 1; newer >= 0 && boundary >= older; boundary-- {
+				lines = append(lines, renderTimelineMarker(boundary, cfg.Details, true, contentWidth))
+			}
+			newer = older
+		}
+		lines = append(lines, renderStyledLiveUpdate(update, contentWidth, cfg.Details, cfg.GoalLinks))
+	}
+	return lines
+}
+
+// timelineMarkers returns the separator rows to show before an event of a chronological
+// list, given the boundaries before the previous event; it returns the event's.
+func timelineMarkers(details *api.MatchDetails, previous, minute int, summary bool, contentWidth int) ([]string, int) {
+	var lines []string
+	current := boundariesBefore(minute)
+	for boundary := previous; boundary < current; boundary++ {
+		lines = append(lines, renderTimelineMarker(boundary, details, summary, contentWidth))
+	}
+	return lines, current
+}
+
+// renderTimelineMarker renders the separator row of a period boundary. With summary, the
+// half-time row has the first half's numbers: "──── HT 1-0 · Poss 58-42 · Shots 7-3 ────".
+func renderTimelineMarker(boundary int, details *api.MatchDetails, summary bool, contentWidth int) string {
+	label := boundaryLabels[boundary]
+	if boundary == 0 && summary {
+		if summary := halfTimeSummary(details); summary != "" {
+			label += " " + summary
+		}
+	}
+	text := " " + truncateString(label, max(contentWidth-6, 2)) + " "
+	fill := max(contentWidth-lipgloss.Width(text), 0)
+	left := fill / 2
+	return neonDimStyle.Render(strings.Repeat("─", left)) +
+		lipgloss.NewStyle().Foreground(neonCyan).Bold(true).Render(text) +
+		neonDimStyle.Render(strings.Repeat("─", fill-left))
+}
+
+// halfTimeSummary returns the half-time score with the first half's possession, shots
+// and xG when FotMob reports them, e.g. "1-0 · Poss 58-42 · Shots 7-3 · xG 0.84-0.21".
+func halfTimeSummary(details *api.MatchDetails) string {
+	var parts []string
+	if details.HalfTimeScore != nil && details.HalfTimeScore.Home != nil && details.HalfTimeScore.Away != nil {
+		parts = append(parts, fmt.Sprintf("%d-%d", *details.HalfTimeScore.Home, *details.HalfTimeScore.Away))
+	} else {
+		home, away := 0, 0
+		for _, event := range details.Events {
+			if strings.ToLower(event.Type) != "goal" || event.Minute > 45 {
+				continue
+			}
+			if event.Team.ID == details.HomeTeam.ID {
+				home++
+			} else {
+				away++
+			}
+		}
+		parts = append(parts, fmt.Sprintf("%d-%d", home, away))
+	}
+
+	wanted := []struct {
+		patterns []string
+		label    string
+	}{
+		{[]string{"possession", "ballpossesion"}, "Poss"},
+		{[]string{"total_shots"}, "Shots"},
+		{[]string{"expected_goals"}, "xG"},
+	}
+	for _, w := range wanted {
+		for _, stat := range details.FirstHalfStatistics {
+			key := strings.ToLower(stat.Key)
+			if !containsAny(key, w.patterns) {
+				continue
+			}
+			home := strings.TrimSuffix(stat.HomeValue, "%")
+			away := strings.TrimSuffix(stat.AwayValue, "%")
+			parts = append(parts, fmt.Sprintf("%s %s-%s", w.label, home, away))
+			break
+		}
+	}
+	return strings.Join(parts, " · ")
+}
+
+// containsAny reports whether s contains any of the substrings.
+func containsAny(s string, substrings []string) bool {
+	for _, sub := range substrings {
+		if strings.Contains(s, sub) {
+			return true
+		}
+	}
+	return false
+}
+
+// ReactionPrefix marks the watch party reaction rows of the live updates feed.
+const ReactionPrefix = "✦"
+
+// ReactionUpdate returns the feed row of a minute's watch party reactions, e.g. "✦ 67' 🔥😱".
+func ReactionUpdate(minute int, emojis string) string {
+	return fmt.Sprintf("%s %d' %s", ReactionPrefix, minute, emojis)
+}
+
+// renderReaction renders a reaction row centered, like the period separators.
+func renderReaction(update string, contentWidth int) string {
+	text := truncateString(strings.TrimPrefix(update, ReactionPrefix+" "), max(contentWidth-2, 2))
+	pad := max(contentWidth-lipgloss.Width(text), 0) / 2
+	return strings.Repeat(" ", pad) + lipgloss.NewStyle().Foreground(neonDim).Render(text)
+}
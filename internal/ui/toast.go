@@ -0,0 +1,30 @@
+package ui
+
+import (
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+// toastStyle is the short confirmation shown after actions like copying a link.
+var toastStyle = lipgloss.NewStyle().
+	Foreground(neonCyan).
+	Bold(true)
+
+// OverlayToast draws a centered toast message over the first line of a rendered view.
+// The first line of the live and stats views is spinner padding, so nothing is hidden.
+func OverlayToast(view string, width int, message string) string {
+	if message == "" {
+		return view
+	}
+
+	toast := lipgloss.NewStyle().
+		Width(width).
+		Align(lipgloss.Center).
+		Render(toastStyle.Render(message))
+
+	if _, rest, ok := strings.Cut(view, "\n"); ok {
+		return toast + "\n" + rest
+	}
+	return toast
+}
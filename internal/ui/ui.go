@@ -1,7 +1,10 @@
 package ui
 
 import (
+	"strings"
+
 	"github.com/0xjuanma/golazo/internal/constants"
+	"github.com/0xjuanma/golazo/internal/httpx"
 	"github.com/0xjuanma/golazo/internal/ui/design"
 	"github.com/charmbracelet/lipgloss"
 )
@@ -28,6 +31,8 @@ func renderStatusBanner(bannerType constants.StatusBannerType, width int) string
 		message = "New Version Available! Run 'golazo --update'"
 	case constants.StatusBannerDev:
 		message = "[DEV BUILD] This is a development version"
+	case constants.StatusBannerDegraded:
+		message = "[OFFLINE] " + strings.Join(httpx.OpenCircuits(), ", ") + " unavailable - showing cached data"
 	case constants.StatusBannerNone:
 		fallthrough
 	default:
@@ -0,0 +1,118 @@
+// generate_catalogue.go - Regenerate the embedded league/team catalogue
+//
+// Leagues are taken from data.AllSupportedLeagues. With -teams, the teams of the given
+// leagues are refreshed from their FotMob tables. Hand-maintained fields (aliases,
+// colors, provider IDs) of existing entries are kept.
+//
+// Usage:
+//   go run scripts/generate_catalogue.go
+//   go run scripts/generate_catalogue.go -teams 47,87,54,55,53,130
+
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/0xjuanma/golazo/internal/data"
+	"github.com/0xjuanma/golazo/internal/fotmob"
+)
+
+const cataloguePath = "internal/data/catalogue.json"
+
+func main() {
+	teams := flag.String("teams", "", "comma-separated FotMob league IDs whose teams are refreshed")
+	flag.Parse()
+
+	var catalogue data.Catalogue
+	if raw, err := os.ReadFile(cataloguePath); err == nil {
+		if err := json.Unmarshal(raw, &catalogue); err != nil {
+			fmt.Fprintf(os.Stderr, "parse %s: %v\n", cataloguePath, err)
+			os.Exit(1)
+		}
+	}
+	catalogue.Version = 1
+
+	catalogue.Leagues = mergeLeagues(catalogue.Leagues)
+
+	if *teams != "" {
+		client := fotmob.NewClient()
+		for _, field := range strings.Split(*teams, ",") {
+			leagueID, err := strconv.Atoi(strings.TrimSpace(field))
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "invalid league ID %q\n", field)
+				os.Exit(1)
+			}
+			league, ok := catalogue.League(leagueID)
+			if !ok {
+				fmt.Fprintf(os.Stderr, "league %d is not supported\n", leagueID)
+				os.Exit(1)
+			}
+
+			ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
+			table, err := client.Standings(ctx, leagueID)
+			cancel()
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "%s: %v\n", league.Name, err)
+				continue
+			}
+			update := &data.Catalogue{}
+			for _, entry := range table {
+				update.Teams = append(update.Teams, data.CatalogueTeam{
+					ID:        entry.Team.ID,
+					Name:      entry.Team.Name,
+					ShortName: entry.Team.ShortName,
+					Country:   league.Country,
+					LeagueID:  leagueID,
+				})
+			}
+			catalogue.Merge(update)
+			fmt.Printf("%s: %d teams\n", league.Name, len(table))
+			time.Sleep(500 * time.Millisecond) // Be polite to FotMob
+		}
+	}
+
+	slices.SortFunc(catalogue.Teams, func(a, b data.CatalogueTeam) int {
+		if c := a.LeagueID - b.LeagueID; c != 0 {
+			return c
+		}
+		return strings.Compare(a.Name, b.Name)
+	})
+
+	var buf bytes.Buffer
+	encoder := json.NewEncoder(&buf)
+	encoder.SetEscapeHTML(false)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(catalogue); err != nil {
+		fmt.Fprintf(os.Stderr, "encode catalogue: %v\n", err)
+		os.Exit(1)
+	}
+	if err := os.WriteFile(cataloguePath, buf.Bytes(), 0644); err != nil {
+		fmt.Fprintf(os.Stderr, "write %s: %v\n", cataloguePath, err)
+		os.Exit(1)
+	}
+	fmt.Printf("Wrote %d leagues and %d teams to %s\n", len(catalogue.Leagues), len(catalogue.Teams), cataloguePath)
+}
+
+// mergeLeagues returns every supported league, keeping the aliases of existing entries.
+func mergeLeagues(existing []data.CatalogueLeague) []data.CatalogueLeague {
+	var leagues []data.CatalogueLeague
+	for _, region := range data.GetAllRegions() {
+		for _, info := range data.GetLeaguesForRegion(region) {
+			league := data.CatalogueLeague{ID: info.ID, Name: info.Name, Country: info.Country, Region: region}
+			if i := slices.IndexFunc(existing, func(l data.CatalogueLeague) bool { return l.ID == info.ID }); i >= 0 {
+				league.Aliases = existing[i].Aliases
+			}
+			leagues = append(leagues, league)
+		}
+	}
+	return leagues
+}
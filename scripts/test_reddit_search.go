@@ -1,6 +1,7 @@
 package main
 
 import (
+	"context"
 	"fmt"
 	"time"
 
@@ -31,7 +32,7 @@ func main() {
 	fmt.Printf("Searching for goal: %+v\n", goal)
 
 	// Test the search
-	link, err := client.GoalLink(goal)
+	link, err := client.GoalLink(context.Background(), goal)
 	if err != nil {
 		fmt.Printf("Error searching for goal: %v\n", err)
 		return